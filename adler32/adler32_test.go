@@ -0,0 +1,45 @@
+package adler32
+
+import (
+	"hash/adler32"
+	"math/rand"
+	"testing"
+)
+
+func TestAdler32(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	data := make([]byte, 20000)
+	rnd.Read(data)
+
+	for _, n := range []int{0, 1, 5551, 5552, 5553, 20000} {
+		h := New()
+		h.Write(data[:n])
+
+		if expected, got := adler32.Checksum(data[:n]), h.Sum32(); expected != got {
+			t.Errorf("checksum of %d bytes is wrong:\n\texpected \"%08x\"\n\tgot \"%08x\"", n, expected, got)
+		}
+	}
+}
+
+func TestRoll(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	data := make([]byte, 200000)
+	rnd.Read(data)
+
+	for _, window := range []int{1, 16, 4096, 70000} {
+		h := New()
+		h.Write(data[:window])
+
+		for i := 0; i+window < len(data); i++ {
+			h.Roll(data[i], data[i+window])
+
+			if i%997 != 0 {
+				continue
+			}
+
+			if expected, got := adler32.Checksum(data[i+1:i+1+window]), h.Sum32(); expected != got {
+				t.Fatalf("rolled checksum of window %d at %d is wrong:\n\texpected \"%08x\"\n\tgot \"%08x\"", window, i+1, expected, got)
+			}
+		}
+	}
+}
@@ -0,0 +1,14 @@
+package adler32
+
+const (
+	// Size is the size of an Adler-32 checksum in bytes.
+	Size = 4
+	// BlockSize the block size of Adler-32 in bytes.
+	BlockSize = 1
+
+	// mod is the largest prime smaller than 65536.
+	mod = 65521
+	// nmax is the largest n such that 255n(n+1)/2 + (n+1)(mod-1) fits in 32 bits,
+	// the number of bytes summed before a reduction.
+	nmax = 5552
+)
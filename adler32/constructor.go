@@ -0,0 +1,20 @@
+// Package adler32 implements the Adler-32 checksum with rolling updates.
+package adler32
+
+import "hash"
+
+// Hash represents an adler32.Hash.
+type Hash interface {
+	hash.Hash32
+	// Roll slides the window of the written data by one byte, removing out from its start and appending in.
+	// The window size is the number of bytes written since the last Reset.
+	Roll(out, in byte)
+}
+
+// New creates a new Adler-32 Hash.
+func New() Hash {
+	h := new(model)
+	h.Reset()
+
+	return h
+}
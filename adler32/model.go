@@ -0,0 +1,71 @@
+package adler32
+
+import "encoding/binary"
+
+// model represents a structure for the Adler-32 Hash.
+type model struct {
+	a, b   uint32
+	window uint32
+}
+
+// implementation of the hash.Hash
+
+// Reset resets the Hash to its initial state.
+func (r *model) Reset() {
+	r.a, r.b = 1, 0
+	r.window = 0
+}
+
+// Size returns the number of bytes Sum will return.
+func (r *model) Size() int { return Size }
+
+// BlockSize returns the hash's underlying block size.
+func (r *model) BlockSize() int { return BlockSize }
+
+// Write appends the data to the digest.
+func (r *model) Write(p []byte) (int, error) {
+	r.window = uint32((uint64(r.window) + uint64(len(p))) % mod)
+	r.update(p)
+
+	return len(p), nil
+}
+
+// Sum appends the current hash to b and returns the resulting slice.
+// It does not change the underlying hash state.
+func (r *model) Sum(b []byte) []byte {
+	return binary.BigEndian.AppendUint32(b, r.Sum32())
+}
+
+// implementation of the adler32.Hash
+
+// Sum32 returns the Hash checksum.
+func (r *model) Sum32() uint32 {
+	return r.b<<16 | r.a
+}
+
+// Roll slides the window of the written data by one byte, removing out from its start and appending in.
+func (r *model) Roll(out, in byte) {
+	r.a = (r.a + mod - uint32(out) + uint32(in)) % mod
+	r.b = (r.b + mod - 1 + r.a + (mod-r.window)*uint32(out)%mod) % mod
+}
+
+// private
+
+// update adds the data to the sums, reducing them every nmax bytes.
+func (r *model) update(p []byte) {
+	a, b := r.a, r.b
+
+	for len(p) > 0 {
+		n := min(len(p), nmax)
+		for _, c := range p[:n] {
+			a += uint32(c)
+			b += a
+		}
+
+		a %= mod
+		b %= mod
+		p = p[n:]
+	}
+
+	r.a, r.b = a, b
+}
@@ -0,0 +1,218 @@
+// Package argon2 implements the Argon2d, Argon2i and Argon2id memory-hard functions of RFC 9106,
+// including the optional secret and associated data of the specification.
+//
+// The memory is filled by the lanes in parallel, one goroutine per lane and slice of a pass.
+package argon2
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"sync"
+
+	"hashed/blake2"
+)
+
+var (
+	// ErrMode is returned when the mode is not one of D, I and ID.
+	ErrMode = errors.New("argon2: invalid mode")
+	// ErrTime is returned when the number of passes is zero.
+	ErrTime = errors.New("argon2: invalid number of passes")
+	// ErrThreads is returned when the degree of parallelism is zero.
+	ErrThreads = errors.New("argon2: invalid degree of parallelism")
+	// ErrMemory is returned when the memory size is less than 8 KiB per lane.
+	ErrMemory = errors.New("argon2: invalid memory size")
+	// ErrSaltSize is returned when the salt is shorter than MinSaltSize.
+	ErrSaltSize = errors.New("argon2: invalid salt size")
+	// ErrKeyLength is returned when the key length is less than MinKeyLength.
+	ErrKeyLength = errors.New("argon2: invalid key length")
+)
+
+// Mode is the variant of Argon2, it selects how the reference blocks are chosen.
+type Mode uint32
+
+const (
+	// D chooses the reference blocks by the memory, it is the fastest variant but prone to side-channel attacks.
+	D Mode = 0
+	// I chooses the reference blocks independently of the password.
+	I Mode = 1
+	// ID is Argon2i for the first half of the first pass and Argon2d for the rest, it is the recommended variant.
+	ID Mode = 2
+)
+
+// String returns the name of the mode, as used in the PHC string format.
+func (r Mode) String() string {
+	switch r {
+	case D:
+		return "argon2d"
+	case I:
+		return "argon2i"
+	case ID:
+		return "argon2id"
+	}
+
+	return fmt.Sprintf("argon2(%d)", uint32(r))
+}
+
+// Params represents the parameters of Argon2.
+type Params struct {
+	Mode Mode
+	// Time is the number of passes over the memory.
+	Time uint32
+	// Memory is the memory size in KiB, it is rounded down to a multiple of 4 KiB per lane.
+	Memory uint32
+	// Threads is the degree of parallelism, the number of lanes of the memory.
+	Threads uint8
+	// KeyLength is the length of the derived key in bytes.
+	KeyLength uint32
+	// Secret is the optional secret value K, also known as pepper.
+	Secret []byte
+	// AssociatedData is the optional associated data X.
+	AssociatedData []byte
+}
+
+// Key derives a key from the password and the salt with the parameters.
+func Key(password, salt []byte, params *Params) ([]byte, error) {
+	if err := params.validate(len(salt)); err != nil {
+		return nil, err
+	}
+
+	h0 := initialHash(password, salt, params)
+
+	lanes := int(params.Threads)
+	columns := int(params.Memory) / (syncPoints * lanes) * syncPoints
+
+	r := &instance{
+		memory:        make([]block, lanes*columns),
+		mode:          params.Mode,
+		passes:        params.Time,
+		lanes:         lanes,
+		columns:       columns,
+		segmentLength: columns / syncPoints,
+	}
+
+	// the first two blocks of every lane are derived from the initial hash
+	var index [8]byte
+	for lane := 0; lane < lanes; lane++ {
+		binary.LittleEndian.PutUint32(index[4:], uint32(lane))
+
+		for i := 0; i < 2; i++ {
+			binary.LittleEndian.PutUint32(index[:4], uint32(i))
+			r.memory[lane*columns+i].decode(hashPrime(BlockSize, h0, index[:]))
+		}
+	}
+
+	for pass := 0; pass < int(params.Time); pass++ {
+		for slice := 0; slice < syncPoints; slice++ {
+			var wg sync.WaitGroup
+
+			for lane := 0; lane < lanes; lane++ {
+				wg.Add(1)
+
+				go func(lane int) {
+					defer wg.Done()
+					r.fillSegment(pass, slice, lane)
+				}(lane)
+			}
+
+			wg.Wait()
+		}
+	}
+
+	// the last blocks of the lanes are combined into the final block
+	final := r.memory[columns-1]
+	for lane := 1; lane < lanes; lane++ {
+		final.xor(&r.memory[lane*columns+columns-1])
+	}
+
+	return hashPrime(params.KeyLength, final.encode()), nil
+}
+
+// private
+
+// validate returns an error if the parameters or the salt size are out of range.
+func (r *Params) validate(saltSize int) error {
+	if r.Mode > ID {
+		return fmt.Errorf("%w: %d", ErrMode, r.Mode)
+	}
+
+	if r.Time < 1 {
+		return fmt.Errorf("%w: %d", ErrTime, r.Time)
+	}
+
+	if r.Threads < 1 {
+		return fmt.Errorf("%w: %d", ErrThreads, r.Threads)
+	}
+
+	if r.Memory < 2*syncPoints*uint32(r.Threads) {
+		return fmt.Errorf("%w: %d", ErrMemory, r.Memory)
+	}
+
+	if saltSize < MinSaltSize {
+		return fmt.Errorf("%w: %d", ErrSaltSize, saltSize)
+	}
+
+	if r.KeyLength < MinKeyLength {
+		return fmt.Errorf("%w: %d", ErrKeyLength, r.KeyLength)
+	}
+
+	return nil
+}
+
+// initialHash returns the hash H0 of the parameters and the inputs, each input is preceded by its length.
+func initialHash(password, salt []byte, params *Params) []byte {
+	h, _ := blake2.NewB(&blake2.Params{})
+
+	var word [4]byte
+	writeWord := func(v uint32) {
+		binary.LittleEndian.PutUint32(word[:], v)
+		_, _ = h.Write(word[:])
+	}
+
+	writeWord(uint32(params.Threads))
+	writeWord(params.KeyLength)
+	writeWord(params.Memory)
+	writeWord(params.Time)
+	writeWord(Version)
+	writeWord(uint32(params.Mode))
+
+	for _, input := range [][]byte{password, salt, params.Secret, params.AssociatedData} {
+		writeWord(uint32(len(input)))
+		_, _ = h.Write(input)
+	}
+
+	return h.Sum(nil)
+}
+
+// hashPrime returns the variable-length hash H' of the inputs, which is BLAKE2b of the length for up to 64 bytes,
+// and a chain of BLAKE2b checksums for longer lengths, of which the first 32 bytes are taken but of the last one.
+func hashPrime(length uint32, inputs ...[]byte) []byte {
+	out := make([]byte, 0, length)
+
+	var prefix [4]byte
+	binary.LittleEndian.PutUint32(prefix[:], length)
+
+	h, _ := blake2.NewB(&blake2.Params{Size: int(min(length, blake2.SizeB))})
+	_, _ = h.Write(prefix[:])
+	for _, input := range inputs {
+		_, _ = h.Write(input)
+	}
+
+	v := h.Sum(nil)
+	if length <= blake2.SizeB {
+		return v
+	}
+
+	out = append(out, v[:blake2.SizeB/2]...)
+	for int(length)-len(out) > blake2.SizeB {
+		h.Reset()
+		_, _ = h.Write(v)
+		v = h.Sum(v[:0])
+		out = append(out, v[:blake2.SizeB/2]...)
+	}
+
+	h, _ = blake2.NewB(&blake2.Params{Size: int(length) - len(out)})
+	_, _ = h.Write(v)
+
+	return h.Sum(out)
+}
@@ -0,0 +1,88 @@
+package argon2
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"testing"
+)
+
+func TestExamples(t *testing.T) {
+	password := bytes.Repeat([]byte{1}, 32)
+	salt := bytes.Repeat([]byte{2}, 16)
+
+	cases := []struct {
+		mode     Mode
+		expected string
+	}{
+		// the test vectors of RFC 9106
+		{D, "512b391b6f1162975371d30919734294f868e3be3984f3c1a13a4db9fabe4acb"},
+		{I, "c814d9d1dc7f37aa13f0d77f2494bda1c8de6b016dd388d29952a4c4672b6ce8"},
+		{ID, "0d640df58d78766c08c037a34a8b53c9d01ef0452d75b65eb52520e96b01e659"},
+	}
+
+	for _, c := range cases {
+		key, err := Key(password, salt, &Params{
+			Mode:           c.mode,
+			Time:           3,
+			Memory:         32,
+			Threads:        4,
+			KeyLength:      32,
+			Secret:         bytes.Repeat([]byte{3}, 8),
+			AssociatedData: bytes.Repeat([]byte{4}, 12),
+		})
+		if err != nil {
+			t.Fatalf("%s failed: %s", c.mode, err)
+		}
+
+		if got := hex.EncodeToString(key); got != c.expected {
+			t.Errorf("%s is wrong:\n\texpected \"%s\"\n\tgot \"%s\"", c.mode, c.expected, got)
+		}
+	}
+}
+
+func TestHashPrime(t *testing.T) {
+	// the lengths around the switch to the chain of checksums produce the requested number of bytes
+	for _, length := range []uint32{4, 64, 65, 96, 97, 128, 1024} {
+		if got := len(hashPrime(length, []byte("input"))); got != int(length) {
+			t.Errorf("length of H' of %d bytes is %d", length, got)
+		}
+	}
+}
+
+func TestErrors(t *testing.T) {
+	valid := Params{Mode: ID, Time: 1, Memory: 64, Threads: 2, KeyLength: 32}
+	salt := []byte("somesalt")
+
+	cases := []struct {
+		name     string
+		change   func(p *Params)
+		salt     []byte
+		expected error
+	}{
+		{"mode", func(p *Params) { p.Mode = 3 }, salt, ErrMode},
+		{"time", func(p *Params) { p.Time = 0 }, salt, ErrTime},
+		{"threads", func(p *Params) { p.Threads = 0 }, salt, ErrThreads},
+		{"memory", func(p *Params) { p.Memory = 15 }, salt, ErrMemory},
+		{"salt size", func(p *Params) {}, salt[:MinSaltSize-1], ErrSaltSize},
+		{"key length", func(p *Params) { p.KeyLength = MinKeyLength - 1 }, salt, ErrKeyLength},
+	}
+
+	for _, c := range cases {
+		p := valid
+		c.change(&p)
+
+		if _, err := Key([]byte("password"), c.salt, &p); !errors.Is(err, c.expected) {
+			t.Errorf("invalid %s returned %v, expected %v", c.name, err, c.expected)
+		}
+	}
+}
+
+func BenchmarkArgon2ID(b *testing.B) {
+	params := &Params{Mode: ID, Time: 1, Memory: 64 * 1024, Threads: 4, KeyLength: 32}
+
+	b.SetBytes(int64(params.Memory) * 1024)
+	for i := 0; i < b.N; i++ {
+		_, _ = Key([]byte("password"), []byte("somesalt"), params)
+	}
+}
@@ -0,0 +1,20 @@
+package argon2
+
+// Version is the version of Argon2, 1.3.
+const Version = 0x13
+
+// BlockSize is the size of a memory block of Argon2 in bytes.
+const BlockSize = 1024
+
+// MinSaltSize is the minimum size of the salt in bytes.
+const MinSaltSize = 8
+
+// MinKeyLength is the minimum length of the derived key in bytes.
+const MinKeyLength = 4
+
+const (
+	// blockWords is the number of 64-bit words of a block.
+	blockWords = BlockSize / 8
+	// syncPoints is the number of slices of a pass, the lanes are synchronized at the end of every slice.
+	syncPoints = 4
+)
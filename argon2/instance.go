@@ -0,0 +1,189 @@
+package argon2
+
+import (
+	"encoding/binary"
+	"math/bits"
+)
+
+// block represents a memory block of Argon2 as little-endian words.
+type block [blockWords]uint64
+
+// instance represents the memory of Argon2, the lanes are the rows and each lane is split into four segments,
+// one per slice.
+type instance struct {
+	memory        []block
+	mode          Mode
+	passes        uint32
+	lanes         int
+	columns       int
+	segmentLength int
+}
+
+// fillSegment computes the blocks of the segment of the lane in the slice of the pass.
+func (r *instance) fillSegment(pass, slice, lane int) {
+	dataIndependent := r.mode == I || r.mode == ID && pass == 0 && slice < syncPoints/2
+
+	// the pseudo-random values of the data-independent indexing are the blocks of a counter mode
+	var address, input, zero block
+	if dataIndependent {
+		input[0] = uint64(pass)
+		input[1] = uint64(lane)
+		input[2] = uint64(slice)
+		input[3] = uint64(len(r.memory))
+		input[4] = uint64(r.passes)
+		input[5] = uint64(r.mode)
+	}
+
+	start := 0
+	if pass == 0 && slice == 0 {
+		// the first two blocks are already filled
+		start = 2
+
+		if dataIndependent {
+			nextAddresses(&address, &input, &zero)
+		}
+	}
+
+	offset := lane*r.columns + slice*r.segmentLength + start
+	for i := start; i < r.segmentLength; i++ {
+		prev := offset - 1
+		if slice == 0 && i == 0 {
+			prev += r.columns
+		}
+
+		var random uint64
+		if dataIndependent {
+			if i%blockWords == 0 {
+				nextAddresses(&address, &input, &zero)
+			}
+
+			random = address[i%blockWords]
+		} else {
+			random = r.memory[prev][0]
+		}
+
+		ref := r.referenceIndex(pass, slice, lane, i, random)
+		compress(&r.memory[offset], &r.memory[prev], &r.memory[ref], pass > 0)
+
+		offset++
+	}
+}
+
+// referenceIndex returns the index of the reference block of the block i of the segment, chosen by the random value
+// from the blocks which are already computed and not in the same slice of the other lanes.
+func (r *instance) referenceIndex(pass, slice, lane, i int, random uint64) int {
+	refLane := int(random>>32) % r.lanes
+	if pass == 0 && slice == 0 {
+		refLane = lane
+	}
+
+	// the size of the reference area, the previous block is excluded
+	area := r.columns - r.segmentLength
+	if pass == 0 {
+		area = slice * r.segmentLength
+	}
+
+	if refLane == lane {
+		area += i - 1
+	} else if i == 0 {
+		area--
+	}
+
+	// the distribution of the position favors the recent blocks
+	x := random & 0xffffffff
+	x = x * x >> 32
+	position := area - 1 - int(uint64(area)*x>>32)
+
+	start := 0
+	if pass > 0 && slice < syncPoints-1 {
+		start = (slice + 1) * r.segmentLength
+	}
+
+	return refLane*r.columns + (start+position)%r.columns
+}
+
+// nextAddresses increments the counter of the input block and computes the next block of addresses from it.
+func nextAddresses(address, input, zero *block) {
+	input[6]++
+	compress(address, zero, input, false)
+	compress(address, zero, address, false)
+}
+
+// compress sets out to the compression G of x and y, or xors it into out for the passes after the first.
+func compress(out, x, y *block, xor bool) {
+	var t, z block
+	for i := range t {
+		t[i] = x[i] ^ y[i]
+	}
+
+	z = t
+
+	// the rows are eight consecutive pairs of words and the columns are eight pairs of words 16 words apart
+	for i := 0; i < blockWords; i += 16 {
+		permute(&z[i], &z[i+1], &z[i+2], &z[i+3], &z[i+4], &z[i+5], &z[i+6], &z[i+7],
+			&z[i+8], &z[i+9], &z[i+10], &z[i+11], &z[i+12], &z[i+13], &z[i+14], &z[i+15])
+	}
+
+	for i := 0; i < 16; i += 2 {
+		permute(&z[i], &z[i+1], &z[i+16], &z[i+17], &z[i+32], &z[i+33], &z[i+48], &z[i+49],
+			&z[i+64], &z[i+65], &z[i+80], &z[i+81], &z[i+96], &z[i+97], &z[i+112], &z[i+113])
+	}
+
+	if xor {
+		for i := range out {
+			out[i] ^= z[i] ^ t[i]
+		}
+	} else {
+		for i := range out {
+			out[i] = z[i] ^ t[i]
+		}
+	}
+}
+
+// permute is the permutation P, the round of BLAKE2b with the multiplications of BlaMka.
+func permute(v0, v1, v2, v3, v4, v5, v6, v7, v8, v9, v10, v11, v12, v13, v14, v15 *uint64) {
+	g(v0, v4, v8, v12)
+	g(v1, v5, v9, v13)
+	g(v2, v6, v10, v14)
+	g(v3, v7, v11, v15)
+	g(v0, v5, v10, v15)
+	g(v1, v6, v11, v12)
+	g(v2, v7, v8, v13)
+	g(v3, v4, v9, v14)
+}
+
+// g is the mixing function GB, the additions of BLAKE2b with the products of the lower halves of the words.
+func g(a, b, c, d *uint64) {
+	*a += *b + 2*uint64(uint32(*a))*uint64(uint32(*b))
+	*d = bits.RotateLeft64(*d^*a, -32)
+	*c += *d + 2*uint64(uint32(*c))*uint64(uint32(*d))
+	*b = bits.RotateLeft64(*b^*c, -24)
+	*a += *b + 2*uint64(uint32(*a))*uint64(uint32(*b))
+	*d = bits.RotateLeft64(*d^*a, -16)
+	*c += *d + 2*uint64(uint32(*c))*uint64(uint32(*d))
+	*b = bits.RotateLeft64(*b^*c, -63)
+}
+
+// xor xors the block x into the block.
+func (r *block) xor(x *block) {
+	for i := range r {
+		r[i] ^= x[i]
+	}
+}
+
+// decode sets the block to the little-endian words of b.
+func (r *block) decode(b []byte) {
+	for i := range r {
+		r[i] = binary.LittleEndian.Uint64(b[8*i:])
+	}
+}
+
+// encode returns the block as little-endian bytes.
+func (r *block) encode() []byte {
+	b := make([]byte, BlockSize)
+	for i, w := range r {
+		binary.LittleEndian.PutUint64(b[8*i:], w)
+	}
+
+	return b
+}
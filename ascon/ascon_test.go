@@ -0,0 +1,118 @@
+package ascon
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"hash"
+	"testing"
+)
+
+func TestExamples(t *testing.T) {
+	newXOF128 := func() hash.Hash {
+		h, _ := NewXOF128(Size)
+		return h
+	}
+
+	newCXOF128 := func() hash.Hash {
+		h, _ := NewCXOF128(Size, nil)
+		return h
+	}
+
+	cases := []struct {
+		name     string
+		newHash  func() hash.Hash
+		input    string
+		expected string
+	}{
+		// the first messages of the known answer tests of the reference implementation
+		{"Ascon-Hash256", NewHash256, "", "0b3be5850f2f6b98caf29f8fdea89b64a1fa70aa249b8f839bd53baa304d92b2"},
+		{"Ascon-Hash256", NewHash256, "00", "0728621035af3ed2bca03bf6fde900f9456f5330e4b5ee23e7f6a1e70291bc80"},
+		{"Ascon-XOF128", newXOF128, "", "473d5e6164f58b39dfd84aacdb8ae42ec2d91fed33388ee0d960d9b3993295c6"},
+		{"Ascon-CXOF128", newCXOF128, "", "4f50159ef70bb3dad8807e034eaebd44c4fa2cbbc8cf1f05511ab66cdcc52990"},
+	}
+
+	for _, c := range cases {
+		input, _ := hex.DecodeString(c.input)
+
+		h := c.newHash()
+		h.Write(input)
+
+		if got := hex.EncodeToString(h.Sum(nil)); got != c.expected {
+			t.Errorf("%s of \"%s\" is wrong:\n\texpected \"%s\"\n\tgot \"%s\"", c.name, c.input, c.expected, got)
+		}
+	}
+}
+
+func TestInitialState(t *testing.T) {
+	// the precomputed initial states of the reference implementation
+	cases := []struct {
+		iv       uint64
+		expected [5]uint64
+	}{
+		{ivHash256, [5]uint64{0x9b1e5494e934d681, 0x4bc3a01e333751d2, 0xae65396c6b34b81a, 0x3c7fd4a4d56a4db3, 0x1a5c464906c5976d}},
+		{ivXOF128, [5]uint64{0xda82ce768d9447eb, 0xcc7ce6c75f1ef969, 0xe7508fd780085631, 0x0ee0ea53416b58cc, 0xe0547524db6f0bde}},
+		{ivCXOF128, [5]uint64{0x675527c2a0e8de03, 0x43d12d7dc0377bbc, 0xe9901dec426e81b5, 0x2ab14907720780b6, 0x8f3f1d02d432bc46}},
+	}
+
+	for _, c := range cases {
+		if got := newModel(c.iv, Size, nil).initial; got != c.expected {
+			t.Errorf("initial state of iv %016x is wrong:\n\texpected %016x\n\tgot %016x", c.iv, c.expected, got)
+		}
+	}
+}
+
+func TestRead(t *testing.T) {
+	data := []byte("The quick brown fox jumps over the lazy dog")
+
+	h, _ := NewCXOF128(100, []byte("customization"))
+	h.Write(data)
+	expected := h.Sum(nil)
+
+	// the output read in pieces is the same as the sum of the whole size
+	for n := 1; n < 2*BlockSize; n++ {
+		h.Reset()
+		h.Write(data)
+
+		var output []byte
+		for len(output) < len(expected) {
+			piece := make([]byte, min(n, len(expected)-len(output)))
+			h.Read(piece)
+			output = append(output, piece...)
+		}
+
+		if !bytes.Equal(expected, output) {
+			t.Errorf("output read in pieces of %d bytes is wrong:\n\texpected \"%x\"\n\tgot \"%x\"", n, expected, output)
+		}
+	}
+
+	if _, err := h.Write(data); !errors.Is(err, ErrWriteAfterRead) {
+		t.Errorf("write after read returned %v, expected %v", err, ErrWriteAfterRead)
+	}
+}
+
+func TestErrors(t *testing.T) {
+	if _, err := NewXOF128(0); !errors.Is(err, ErrSize) {
+		t.Errorf("size 0 returned %v, expected %v", err, ErrSize)
+	}
+
+	if _, err := NewCXOF128(Size, make([]byte, MaxCustomizationSize+1)); !errors.Is(err, ErrCustomization) {
+		t.Errorf("customization of %d bytes returned %v, expected %v", MaxCustomizationSize+1, err, ErrCustomization)
+	}
+
+	if _, err := NewCXOF128(Size, make([]byte, MaxCustomizationSize)); err != nil {
+		t.Errorf("customization of %d bytes returned %v", MaxCustomizationSize, err)
+	}
+}
+
+func BenchmarkHash256(b *testing.B) {
+	h := NewHash256()
+	data := make([]byte, 8192)
+
+	b.SetBytes(int64(len(data)))
+	for i := 0; i < b.N; i++ {
+		h.Reset()
+		h.Write(data)
+		h.Sum(nil)
+	}
+}
@@ -0,0 +1,22 @@
+package ascon
+
+const (
+	// Size is the size of an Ascon-Hash256 checksum in bytes, which is also the default output size of the XOFs.
+	Size = 32
+	// BlockSize the block size of Ascon-Hash256, Ascon-XOF128 and Ascon-CXOF128 in bytes.
+	BlockSize = 8
+	// MaxCustomizationSize is the maximum size of the customization string of Ascon-CXOF128 in bytes.
+	MaxCustomizationSize = 256
+
+	// rounds is the number of rounds of the permutation Ascon-p[12].
+	rounds = 12
+
+	// ivHash256, ivXOF128 and ivCXOF128 are the initial values of the first word of the state,
+	// which encode the algorithm, the number of rounds, the rate and the output size.
+	ivHash256 = 0x0000080100cc0002
+	ivXOF128  = 0x0000080000cc0003
+	ivCXOF128 = 0x0000080000cc0004
+)
+
+// roundConstants are the constants of the rounds.
+var roundConstants = [rounds]uint64{0xf0, 0xe1, 0xd2, 0xc3, 0xb4, 0xa5, 0x96, 0x87, 0x78, 0x69, 0x5a, 0x4b}
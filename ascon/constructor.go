@@ -0,0 +1,74 @@
+// Package ascon implements the Ascon-Hash256 hash algorithm and the Ascon-XOF128 and Ascon-CXOF128
+// extendable-output functions of NIST SP 800-232, the lightweight cryptography standard.
+//
+// The words of the state are little-endian, as in the standard, unlike the big-endian words of Ascon v1.2.
+package ascon
+
+import (
+	"encoding/binary"
+	"errors"
+	"hash"
+	"io"
+)
+
+var (
+	// ErrSize is returned when the output size of an XOF is not positive.
+	ErrSize = errors.New("ascon: size must be at least 1 byte")
+	// ErrCustomization is returned when the customization string is longer than MaxCustomizationSize.
+	ErrCustomization = errors.New("ascon: customization is longer than 256 bytes")
+	// ErrWriteAfterRead is returned when an XOF is written to after it started squeezing.
+	ErrWriteAfterRead = errors.New("ascon: write after read")
+)
+
+// XOF represents an extendable-output function, Sum returns Size bytes and Read squeezes any amount of output.
+type XOF interface {
+	hash.Hash
+	io.Reader
+}
+
+// NewHash256 creates a new Ascon-Hash256 hash.Hash.
+func NewHash256() hash.Hash { return newModel(ivHash256, Size, nil) }
+
+// NewXOF128 creates a new Ascon-XOF128 XOF, whose Sum returns size bytes.
+func NewXOF128(size int) (XOF, error) {
+	if size < 1 {
+		return nil, ErrSize
+	}
+
+	return newModel(ivXOF128, size, nil), nil
+}
+
+// NewCXOF128 creates a new Ascon-CXOF128 XOF with the customization string, whose Sum returns size bytes.
+func NewCXOF128(size int, customization []byte) (XOF, error) {
+	if size < 1 {
+		return nil, ErrSize
+	}
+
+	if len(customization) > MaxCustomizationSize {
+		return nil, ErrCustomization
+	}
+
+	// the customization is absorbed after its length in bits, both padded
+	var length [BlockSize]byte
+	binary.LittleEndian.PutUint64(length[:], uint64(len(customization))<<3)
+
+	return newModel(ivCXOF128, size, append(length[:], customization...)), nil
+}
+
+// newModel creates a new Ascon model with the initial value and the size, prefix is absorbed by the initial state.
+func newModel(iv uint64, size int, prefix []byte) *model {
+	h := &model{size: size}
+	h.initial[0] = iv
+	permute(&h.initial)
+
+	if prefix != nil {
+		h.Reset()
+		_, _ = h.Write(prefix)
+		h.pad()
+		h.initial = h.state
+	}
+
+	h.Reset()
+
+	return h
+}
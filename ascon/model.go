@@ -0,0 +1,159 @@
+package ascon
+
+import (
+	"encoding/binary"
+	"math/bits"
+)
+
+// model represents a structure for the Ascon hash.Hash and XOFs.
+// The first word of the state is the rate, the buffer holds a partial block while absorbing
+// and the output block while squeezing.
+type model struct {
+	size        int
+	initial     [5]uint64
+	state       [5]uint64
+	buffer      [BlockSize]byte
+	bufferIndex int
+	squeezing   bool
+}
+
+// implementation of the hash.Hash
+
+// Reset resets the hash.Hash to its initial state.
+func (r *model) Reset() {
+	r.state = r.initial
+	r.bufferIndex = 0
+	r.squeezing = false
+}
+
+// Size returns the number of bytes Sum will return.
+func (r *model) Size() int { return r.size }
+
+// BlockSize returns the hash's underlying block size.
+// The Write method must be able to accept any amount of data,
+// but it may operate more efficiently if all writes are a multiple of the block size.
+func (r *model) BlockSize() int { return BlockSize }
+
+// Write appends the data to the digest.
+// It returns ErrWriteAfterRead if the output has already been read.
+func (r *model) Write(p []byte) (int, error) {
+	if r.squeezing {
+		return 0, ErrWriteAfterRead
+	}
+
+	n := len(p)
+
+	if r.bufferIndex > 0 {
+		x := copy(r.buffer[r.bufferIndex:], p)
+		r.bufferIndex += x
+		p = p[x:]
+
+		if r.bufferIndex < BlockSize {
+			return n, nil
+		}
+
+		r.absorb(r.buffer[:])
+		r.bufferIndex = 0
+	}
+
+	for ; len(p) >= BlockSize; p = p[BlockSize:] {
+		r.absorb(p)
+	}
+
+	r.bufferIndex = copy(r.buffer[:], p)
+
+	return n, nil
+}
+
+// Sum appends the current hash to b and returns the resulting slice.
+// It does not change the underlying hash state.
+func (r *model) Sum(b []byte) []byte {
+	// copy the model to allow other writes to continue and to prevent change of the state
+	s := *r
+
+	n := len(b)
+	b = append(b, make([]byte, s.size)...)
+	_, _ = s.Read(b[n:])
+
+	return b
+}
+
+// implementation of the io.Reader
+
+// Read squeezes output from the XOF, the first call pads and completes the absorbing.
+// It always fills p and never returns an error.
+func (r *model) Read(p []byte) (int, error) {
+	if !r.squeezing {
+		r.pad()
+		r.squeezing = true
+		binary.LittleEndian.PutUint64(r.buffer[:], r.state[0])
+	}
+
+	n := len(p)
+	for len(p) > 0 {
+		// the permutation is applied between the output blocks
+		if r.bufferIndex == BlockSize {
+			permute(&r.state)
+			binary.LittleEndian.PutUint64(r.buffer[:], r.state[0])
+			r.bufferIndex = 0
+		}
+
+		x := copy(p, r.buffer[r.bufferIndex:])
+		r.bufferIndex += x
+		p = p[x:]
+	}
+
+	return n, nil
+}
+
+// private
+
+// absorb adds a block to the rate and permutes the state.
+func (r *model) absorb(block []byte) {
+	r.state[0] ^= binary.LittleEndian.Uint64(block)
+	permute(&r.state)
+}
+
+// pad absorbs the last partial block, which may be empty, padded by a one bit and zeros.
+func (r *model) pad() {
+	var block [BlockSize]byte
+	copy(block[:], r.buffer[:r.bufferIndex])
+	block[r.bufferIndex] = 0x01
+
+	r.absorb(block[:])
+	r.bufferIndex = 0
+}
+
+// permute applies the permutation Ascon-p[12] to the state.
+func permute(state *[5]uint64) {
+	x0, x1, x2, x3, x4 := state[0], state[1], state[2], state[3], state[4]
+
+	for _, c := range roundConstants {
+		// the constant addition
+		x2 ^= c
+
+		// the substitution layer, the 5-bit S-box applied to the bits of the same position of the words
+		x0 ^= x4
+		x4 ^= x3
+		x2 ^= x1
+		t0, t1, t2, t3, t4 := ^x0&x1, ^x1&x2, ^x2&x3, ^x3&x4, ^x4&x0
+		x0 ^= t1
+		x1 ^= t2
+		x2 ^= t3
+		x3 ^= t4
+		x4 ^= t0
+		x1 ^= x0
+		x0 ^= x4
+		x3 ^= x2
+		x2 = ^x2
+
+		// the linear diffusion layer
+		x0 ^= bits.RotateLeft64(x0, -19) ^ bits.RotateLeft64(x0, -28)
+		x1 ^= bits.RotateLeft64(x1, -61) ^ bits.RotateLeft64(x1, -39)
+		x2 ^= bits.RotateLeft64(x2, -1) ^ bits.RotateLeft64(x2, -6)
+		x3 ^= bits.RotateLeft64(x3, -10) ^ bits.RotateLeft64(x3, -17)
+		x4 ^= bits.RotateLeft64(x4, -7) ^ bits.RotateLeft64(x4, -41)
+	}
+
+	state[0], state[1], state[2], state[3], state[4] = x0, x1, x2, x3, x4
+}
@@ -0,0 +1,80 @@
+// Package base58 implements the Base58 encoding of Bitcoin and the Base58Check encoding with a 4-byte checksum.
+package base58
+
+import (
+	"errors"
+	"math/big"
+)
+
+// alphabet is the Bitcoin alphabet, which leaves out 0, O, I and l.
+const alphabet = "123456789ABCDEFGHJKLMNPQRSTUVWXYZabcdefghijkmnopqrstuvwxyz"
+
+// ErrCharacter is returned when the encoded string has a character out of the alphabet.
+var ErrCharacter = errors.New("base58: invalid character")
+
+// indices maps the characters of the alphabet to their values, -1 for the others.
+var indices = func() [256]int {
+	var r [256]int
+	for i := range r {
+		r[i] = -1
+	}
+
+	for i := 0; i < len(alphabet); i++ {
+		r[alphabet[i]] = i
+	}
+
+	return r
+}()
+
+// Encode returns the Base58 encoding of data, every leading zero byte is encoded as '1'.
+func Encode(data []byte) string {
+	zeros := 0
+	for zeros < len(data) && data[zeros] == 0 {
+		zeros++
+	}
+
+	// log(256) / log(58) is about 1.37
+	digits := make([]byte, 0, len(data)*138/100+1)
+
+	n := new(big.Int).SetBytes(data)
+	radix := big.NewInt(58)
+	mod := new(big.Int)
+
+	for n.Sign() > 0 {
+		n.DivMod(n, radix, mod)
+		digits = append(digits, alphabet[mod.Int64()])
+	}
+
+	for i := 0; i < zeros; i++ {
+		digits = append(digits, alphabet[0])
+	}
+
+	for i, j := 0, len(digits)-1; i < j; i, j = i+1, j-1 {
+		digits[i], digits[j] = digits[j], digits[i]
+	}
+
+	return string(digits)
+}
+
+// Decode returns the data of the Base58 encoded string, every leading '1' is decoded as a zero byte.
+func Decode(s string) ([]byte, error) {
+	zeros := 0
+	for zeros < len(s) && s[zeros] == alphabet[0] {
+		zeros++
+	}
+
+	n := new(big.Int)
+	radix := big.NewInt(58)
+
+	for i := zeros; i < len(s); i++ {
+		value := indices[s[i]]
+		if value < 0 {
+			return nil, ErrCharacter
+		}
+
+		n.Mul(n, radix)
+		n.Add(n, big.NewInt(int64(value)))
+	}
+
+	return append(make([]byte, zeros), n.Bytes()...), nil
+}
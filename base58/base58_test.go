@@ -0,0 +1,67 @@
+package base58
+
+import (
+	"encoding/hex"
+	"testing"
+)
+
+func TestBase58(t *testing.T) {
+	// the encode and decode vectors of Bitcoin Core
+	vectors := []struct {
+		data    string
+		encoded string
+	}{
+		{"", ""},
+		{"61", "2g"},
+		{"626262", "a3gV"},
+		{"636363", "aPEr"},
+		{"73696d706c792061206c6f6e6720737472696e67", "2cFupjhnEsSn59qHXstmK2ffpLv2"},
+		{"00eb15231dfceb60925886b67d065299925915aeb172c06647", "1NS17iag9jJgTHD1VXjvLCEnZuQ3rJDE9L"},
+		{"516b6fcd0f", "ABnLTmg"},
+		{"bf4f89001e670274dd", "3SEo3LWLoPntC"},
+		{"572e4794", "3EFU7m"},
+		{"ecac89cad93923c02321", "EJDM8drfXA6uyA"},
+		{"10c8511e", "Rt5zm"},
+		{"00000000000000000000", "1111111111"},
+	}
+
+	for _, v := range vectors {
+		data, _ := hex.DecodeString(v.data)
+
+		if encoded := Encode(data); encoded != v.encoded {
+			t.Errorf("encoding of '%s' is wrong:\n\texpected \"%s\"\n\tgot \"%s\"", v.data, v.encoded, encoded)
+		}
+
+		decoded, err := Decode(v.encoded)
+		if err != nil || hex.EncodeToString(decoded) != v.data {
+			t.Errorf("decoding of '%s' is wrong:\n\texpected \"%s\"\n\tgot \"%x\" (%v)", v.encoded, v.data, decoded, err)
+		}
+	}
+
+	if _, err := Decode("0OIl"); err != ErrCharacter {
+		t.Errorf("invalid character error is wrong:\n\texpected \"%v\"\n\tgot \"%v\"", ErrCharacter, err)
+	}
+}
+
+func TestCheck(t *testing.T) {
+	// the HASH160 of the compressed public key of the private key 1
+	payload, _ := hex.DecodeString("751e76e8199196d454941c45d1b3a323f1433bd6")
+	expected := "1BgGZ9tcN4rm9KBzDn7KprQz87SZ26SAMH"
+
+	if encoded := CheckEncode(0x00, payload); encoded != expected {
+		t.Errorf("check encoding is wrong:\n\texpected \"%s\"\n\tgot \"%s\"", expected, encoded)
+	}
+
+	version, decoded, err := CheckDecode(expected)
+	if err != nil || version != 0x00 || hex.EncodeToString(decoded) != hex.EncodeToString(payload) {
+		t.Errorf("check decoding is wrong:\n\texpected \"%x\"\n\tgot \"%x\" with version %d (%v)", payload, decoded, version, err)
+	}
+
+	if _, _, err = CheckDecode("1BgGZ9tcN4rm9KBzDn7KprQz87SZ26SAMJ"); err != ErrChecksum {
+		t.Errorf("checksum error is wrong:\n\texpected \"%v\"\n\tgot \"%v\"", ErrChecksum, err)
+	}
+
+	if _, _, err = CheckDecode("2g"); err != ErrLength {
+		t.Errorf("length error is wrong:\n\texpected \"%v\"\n\tgot \"%v\"", ErrLength, err)
+	}
+}
@@ -0,0 +1,59 @@
+package base58
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"errors"
+)
+
+// ChecksumSize is the size of the Base58Check checksum in bytes.
+const ChecksumSize = 4
+
+var (
+	// ErrChecksum is returned when the checksum of a Base58Check string does not match its data.
+	ErrChecksum = errors.New("base58: invalid checksum")
+	// ErrLength is returned when a Base58Check string is too short to hold a version and a checksum.
+	ErrLength = errors.New("base58: too short for a version and a checksum")
+)
+
+// Checksum returns the first 4 bytes of the double SHA-256 of data.
+func Checksum(data []byte) [ChecksumSize]byte {
+	first := sha256.Sum256(data)
+	second := sha256.Sum256(first[:])
+
+	var r [ChecksumSize]byte
+	copy(r[:], second[:])
+
+	return r
+}
+
+// CheckEncode returns the Base58Check encoding of the version byte and the payload,
+// like a Bitcoin address with version 0x00 and the HASH160 of a public key.
+func CheckEncode(version byte, payload []byte) string {
+	data := make([]byte, 0, 1+len(payload)+ChecksumSize)
+	data = append(data, version)
+	data = append(data, payload...)
+
+	checksum := Checksum(data)
+
+	return Encode(append(data, checksum[:]...))
+}
+
+// CheckDecode returns the version byte and the payload of the Base58Check encoded string after verifying its checksum.
+func CheckDecode(s string) (version byte, payload []byte, err error) {
+	data, err := Decode(s)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	if len(data) < 1+ChecksumSize {
+		return 0, nil, ErrLength
+	}
+
+	data, checksum := data[:len(data)-ChecksumSize], data[len(data)-ChecksumSize:]
+	if expected := Checksum(data); !bytes.Equal(expected[:], checksum) {
+		return 0, nil, ErrChecksum
+	}
+
+	return data[0], data[1:], nil
+}
@@ -0,0 +1,84 @@
+package hashed
+
+import (
+	"fmt"
+	"hash"
+	"testing"
+
+	"golang.org/x/crypto/ripemd160"
+	"golang.org/x/crypto/sha3"
+
+	"hashed/keccak"
+	"hashed/ripemd"
+)
+
+// benchmarkSizes are the message sizes used in the benchmarks, from 16 B to 64 MiB.
+var benchmarkSizes = []int{16, 64, 256, 1 << 10, 8 << 10, 1 << 20, 64 << 20}
+
+// benchmarkHash benchmarks writing size bytes to a new hash.Hash and computing the sum.
+func benchmarkHash(b *testing.B, newHash func() hash.Hash, size int) {
+	h := newHash()
+	data := make([]byte, size)
+	sum := make([]byte, 0, h.Size())
+
+	b.SetBytes(int64(size))
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		h.Reset()
+		_, _ = h.Write(data)
+		sum = h.Sum(sum[:0])
+	}
+}
+
+// sizeName returns the human-readable name of the size for sub-benchmarks.
+func sizeName(size int) string {
+	switch {
+	case size >= 1<<20:
+		return fmt.Sprintf("%dMiB", size>>20)
+	case size >= 1<<10:
+		return fmt.Sprintf("%dKiB", size>>10)
+	default:
+		return fmt.Sprintf("%dB", size)
+	}
+}
+
+func BenchmarkHashTypes(b *testing.B) {
+	for _, hashType := range HashTypes() {
+		newHash := getHashFunc(DefaultOptions(hashType).
+			SetKey(testKey(hashType)).
+			SetFunctionName([]byte("b61f4c9980370150e1dcf7aa770c58dc")).
+			SetCustomization([]byte("8df75ae53e4bdf7b5ae9c09bd0baffb1")).
+			SetNonce(testNonce))
+
+		for _, size := range benchmarkSizes {
+			b.Run(hashType+"/"+sizeName(size), func(b *testing.B) {
+				benchmarkHash(b, newHash, size)
+			})
+		}
+	}
+}
+
+func BenchmarkReference(b *testing.B) {
+	references := []struct {
+		name      string
+		model     func() hash.Hash
+		reference func() hash.Hash
+	}{
+		{"keccak-256", keccak.New256, sha3.NewLegacyKeccak256},
+		{"keccak-512", keccak.New512, sha3.NewLegacyKeccak512},
+		{"ripemd-160", ripemd.New160, ripemd160.New},
+	}
+
+	for _, r := range references {
+		for _, size := range benchmarkSizes {
+			b.Run(r.name+"/hashed/"+sizeName(size), func(b *testing.B) {
+				benchmarkHash(b, r.model, size)
+			})
+			b.Run(r.name+"/reference/"+sizeName(size), func(b *testing.B) {
+				benchmarkHash(b, r.reference, size)
+			})
+		}
+	}
+}
@@ -0,0 +1,48 @@
+package blake
+
+import (
+	"encoding/hex"
+	"hash"
+	"testing"
+)
+
+func TestExamples(t *testing.T) {
+	cases := []struct {
+		newHash  func() hash.Hash
+		input    []byte
+		expected string
+	}{
+		// the examples of the appendix of the BLAKE submission, with one and two blocks
+		{New256, make([]byte, 1), "0ce8d4ef4dd7cd8d62dfded9d4edb0a774ae6a41929a74da23109e8f11139c87"},
+		{New256, make([]byte, 72), "d419bad32d504fb7d44d460c42c5593fe544fa4c135dec31e21bd9abdcc22d41"},
+		{New512, make([]byte, 1), "97961587f6d970faba6d2478045de6d1fabd09b61ae50932054d52bc29d31be4ff9102b9f69e2bbdb83be13d4b9c06091e5fa0b48bd081b634058be0ec49beb3"},
+		{New512, make([]byte, 144), "313717d608e9cf758dcb1eb0f0c3cf9fc150b2d500fb33f51c52afc99d358a2f1374b8a38bba7974e7f6ef79cab16f22ce1e649d6e01ad9589c213045d545dde"},
+		// the empty message
+		{New256, nil, "716f6e863f744b9ac22c97ec7b76ea5f5908bc5b2f67c61510bfc4751384ea7a"},
+		{New512, nil, "a8cfbbd73726062df0c6864dda65defe58ef0cc52a5625090fa17601e1eecd1b628e94f396ae402a00acc9eab77b4d4c2e852aaaa25a636d80af3fc7913ef5b8"},
+	}
+
+	for _, c := range cases {
+		h := c.newHash()
+		h.Write(c.input)
+
+		if got := hex.EncodeToString(h.Sum(nil)); got != c.expected {
+			t.Errorf("sum of %d bytes of size %d is wrong:\n\texpected \"%s\"\n\tgot \"%s\"", len(c.input), h.Size(), c.expected, got)
+		}
+	}
+}
+
+func benchmarkBlake(b *testing.B, h hash.Hash) {
+	data := make([]byte, 8192)
+
+	b.SetBytes(int64(len(data)))
+	for i := 0; i < b.N; i++ {
+		h.Reset()
+		h.Write(data)
+		h.Sum(nil)
+	}
+}
+
+func BenchmarkBlake256(b *testing.B) { benchmarkBlake(b, New256()) }
+
+func BenchmarkBlake512(b *testing.B) { benchmarkBlake(b, New512()) }
@@ -0,0 +1,23 @@
+// Package blake implements the BLAKE-256 and BLAKE-512 hash algorithms, the final round versions
+// of the SHA-3 competition with 14 and 16 rounds, without salt.
+//
+// BLAKE2 is not the subject of this package, it is provided by golang.org/x/crypto.
+package blake
+
+import "hash"
+
+// New256 creates a new BLAKE-256 hash.Hash.
+func New256() hash.Hash {
+	h := new(model256)
+	h.Reset()
+
+	return h
+}
+
+// New512 creates a new BLAKE-512 hash.Hash.
+func New512() hash.Hash {
+	h := new(model512)
+	h.Reset()
+
+	return h
+}
@@ -0,0 +1,146 @@
+package blake
+
+import (
+	"encoding/binary"
+	"math/bits"
+)
+
+// model256 represents a structure for the BLAKE-256 hash.Hash.
+type model256 struct {
+	state          [8]uint32
+	buffer         [BlockSize256]byte
+	bufferIndex    int
+	processedBytes uint64
+}
+
+// implementation of the hash.Hash
+
+// Reset resets the hash.Hash to its initial state.
+func (r *model256) Reset() {
+	r.state = iv256
+	r.bufferIndex = 0
+	r.processedBytes = 0
+}
+
+// Size returns the number of bytes Sum will return.
+func (r *model256) Size() int { return Size256 }
+
+// BlockSize returns the hash's underlying block size.
+// The Write method must be able to accept any amount of data,
+// but it may operate more efficiently if all writes are a multiple of the block size.
+func (r *model256) BlockSize() int { return BlockSize256 }
+
+// Write appends the data to the digest.
+func (r *model256) Write(p []byte) (int, error) {
+	n := len(p)
+
+	if r.bufferIndex > 0 {
+		x := copy(r.buffer[r.bufferIndex:], p)
+		r.bufferIndex += x
+		p = p[x:]
+
+		if r.bufferIndex < BlockSize256 {
+			return n, nil
+		}
+
+		r.processedBytes += BlockSize256
+		compress256(&r.state, r.buffer[:], r.processedBytes<<3)
+		r.bufferIndex = 0
+	}
+
+	for ; len(p) >= BlockSize256; p = p[BlockSize256:] {
+		r.processedBytes += BlockSize256
+		compress256(&r.state, p, r.processedBytes<<3)
+	}
+
+	r.bufferIndex = copy(r.buffer[:], p)
+
+	return n, nil
+}
+
+// Sum appends the current hash to b and returns the resulting slice.
+// It does not change the underlying hash state.
+func (r *model256) Sum(b []byte) []byte {
+	// copy the model to allow other writes to continue and to prevent change of the state
+	s := *r
+
+	length := (s.processedBytes + uint64(s.bufferIndex)) << 3
+
+	// the padding ends with a one bit before the length, which marks the full length digest
+	var last [2 * BlockSize256]byte
+	n := copy(last[:], s.buffer[:s.bufferIndex])
+	last[n] = 0x80
+
+	// the counter is the number of message bits up to the block, zero if the block has none
+	if n+1+8 > BlockSize256 {
+		last[2*BlockSize256-9] |= 0x01
+		binary.BigEndian.PutUint64(last[2*BlockSize256-8:], length)
+
+		compress256(&s.state, last[:BlockSize256], length)
+		compress256(&s.state, last[BlockSize256:], 0)
+	} else {
+		last[BlockSize256-9] |= 0x01
+		binary.BigEndian.PutUint64(last[BlockSize256-8:], length)
+
+		counter := length
+		if n == 0 {
+			counter = 0
+		}
+
+		compress256(&s.state, last[:BlockSize256], counter)
+	}
+
+	var digest [Size256]byte
+	for i, v := range s.state {
+		binary.BigEndian.PutUint32(digest[4*i:], v)
+	}
+
+	return append(b, digest[:]...)
+}
+
+// private
+
+// g256 is the quarter round of BLAKE-256.
+func g256(v *[16]uint32, m *[16]uint32, s *[16]byte, i, a, b, c, d int) {
+	v[a] += v[b] + (m[s[2*i]] ^ constants256[s[2*i+1]])
+	v[d] = bits.RotateLeft32(v[d]^v[a], -16)
+	v[c] += v[d]
+	v[b] = bits.RotateLeft32(v[b]^v[c], -12)
+	v[a] += v[b] + (m[s[2*i+1]] ^ constants256[s[2*i]])
+	v[d] = bits.RotateLeft32(v[d]^v[a], -8)
+	v[c] += v[d]
+	v[b] = bits.RotateLeft32(v[b]^v[c], -7)
+}
+
+// compress256 compresses a block into the state with the counter of the message bits.
+func compress256(state *[8]uint32, block []byte, counter uint64) {
+	var m [16]uint32
+	for i := range m {
+		m[i] = binary.BigEndian.Uint32(block[4*i:])
+	}
+
+	var v [16]uint32
+	copy(v[:8], state[:])
+	copy(v[8:], constants256[:8])
+	v[12] ^= uint32(counter)
+	v[13] ^= uint32(counter)
+	v[14] ^= uint32(counter >> 32)
+	v[15] ^= uint32(counter >> 32)
+
+	for round := 0; round < rounds256; round++ {
+		s := &sigma[round%10]
+
+		g256(&v, &m, s, 0, 0, 4, 8, 12)
+		g256(&v, &m, s, 1, 1, 5, 9, 13)
+		g256(&v, &m, s, 2, 2, 6, 10, 14)
+		g256(&v, &m, s, 3, 3, 7, 11, 15)
+		g256(&v, &m, s, 4, 0, 5, 10, 15)
+		g256(&v, &m, s, 5, 1, 6, 11, 12)
+		g256(&v, &m, s, 6, 2, 7, 8, 13)
+		g256(&v, &m, s, 7, 3, 4, 9, 14)
+	}
+
+	for i := range state {
+		state[i] ^= v[i] ^ v[i+8]
+	}
+}
@@ -0,0 +1,146 @@
+package blake
+
+import (
+	"encoding/binary"
+	"math/bits"
+)
+
+// model512 represents a structure for the BLAKE-512 hash.Hash.
+type model512 struct {
+	state          [8]uint64
+	buffer         [BlockSize512]byte
+	bufferIndex    int
+	processedBytes uint64
+}
+
+// implementation of the hash.Hash
+
+// Reset resets the hash.Hash to its initial state.
+func (r *model512) Reset() {
+	r.state = iv512
+	r.bufferIndex = 0
+	r.processedBytes = 0
+}
+
+// Size returns the number of bytes Sum will return.
+func (r *model512) Size() int { return Size512 }
+
+// BlockSize returns the hash's underlying block size.
+// The Write method must be able to accept any amount of data,
+// but it may operate more efficiently if all writes are a multiple of the block size.
+func (r *model512) BlockSize() int { return BlockSize512 }
+
+// Write appends the data to the digest.
+func (r *model512) Write(p []byte) (int, error) {
+	n := len(p)
+
+	if r.bufferIndex > 0 {
+		x := copy(r.buffer[r.bufferIndex:], p)
+		r.bufferIndex += x
+		p = p[x:]
+
+		if r.bufferIndex < BlockSize512 {
+			return n, nil
+		}
+
+		r.processedBytes += BlockSize512
+		compress512(&r.state, r.buffer[:], r.processedBytes<<3)
+		r.bufferIndex = 0
+	}
+
+	for ; len(p) >= BlockSize512; p = p[BlockSize512:] {
+		r.processedBytes += BlockSize512
+		compress512(&r.state, p, r.processedBytes<<3)
+	}
+
+	r.bufferIndex = copy(r.buffer[:], p)
+
+	return n, nil
+}
+
+// Sum appends the current hash to b and returns the resulting slice.
+// It does not change the underlying hash state.
+func (r *model512) Sum(b []byte) []byte {
+	// copy the model to allow other writes to continue and to prevent change of the state
+	s := *r
+
+	length := (s.processedBytes + uint64(s.bufferIndex)) << 3
+
+	// the padding ends with a one bit before the length, which marks the full length digest
+	var last [2 * BlockSize512]byte
+	n := copy(last[:], s.buffer[:s.bufferIndex])
+	last[n] = 0x80
+
+	// the counter is the number of message bits up to the block, zero if the block has none
+	// the length is 128 bits, the most significant half of it is zero
+	if n+1+16 > BlockSize512 {
+		last[2*BlockSize512-17] |= 0x01
+		binary.BigEndian.PutUint64(last[2*BlockSize512-8:], length)
+
+		compress512(&s.state, last[:BlockSize512], length)
+		compress512(&s.state, last[BlockSize512:], 0)
+	} else {
+		last[BlockSize512-17] |= 0x01
+		binary.BigEndian.PutUint64(last[BlockSize512-8:], length)
+
+		counter := length
+		if n == 0 {
+			counter = 0
+		}
+
+		compress512(&s.state, last[:BlockSize512], counter)
+	}
+
+	var digest [Size512]byte
+	for i, v := range s.state {
+		binary.BigEndian.PutUint64(digest[8*i:], v)
+	}
+
+	return append(b, digest[:]...)
+}
+
+// private
+
+// g512 is the quarter round of BLAKE-512.
+func g512(v *[16]uint64, m *[16]uint64, s *[16]byte, i, a, b, c, d int) {
+	v[a] += v[b] + (m[s[2*i]] ^ constants512[s[2*i+1]])
+	v[d] = bits.RotateLeft64(v[d]^v[a], -32)
+	v[c] += v[d]
+	v[b] = bits.RotateLeft64(v[b]^v[c], -25)
+	v[a] += v[b] + (m[s[2*i+1]] ^ constants512[s[2*i]])
+	v[d] = bits.RotateLeft64(v[d]^v[a], -16)
+	v[c] += v[d]
+	v[b] = bits.RotateLeft64(v[b]^v[c], -11)
+}
+
+// compress512 compresses a block into the state with the counter of the message bits.
+func compress512(state *[8]uint64, block []byte, counter uint64) {
+	var m [16]uint64
+	for i := range m {
+		m[i] = binary.BigEndian.Uint64(block[8*i:])
+	}
+
+	// the most significant half of the 128 bits counter is zero
+	var v [16]uint64
+	copy(v[:8], state[:])
+	copy(v[8:], constants512[:8])
+	v[12] ^= counter
+	v[13] ^= counter
+
+	for round := 0; round < rounds512; round++ {
+		s := &sigma[round%10]
+
+		g512(&v, &m, s, 0, 0, 4, 8, 12)
+		g512(&v, &m, s, 1, 1, 5, 9, 13)
+		g512(&v, &m, s, 2, 2, 6, 10, 14)
+		g512(&v, &m, s, 3, 3, 7, 11, 15)
+		g512(&v, &m, s, 4, 0, 5, 10, 15)
+		g512(&v, &m, s, 5, 1, 6, 11, 12)
+		g512(&v, &m, s, 6, 2, 7, 8, 13)
+		g512(&v, &m, s, 7, 3, 4, 9, 14)
+	}
+
+	for i := range state {
+		state[i] ^= v[i] ^ v[i+8]
+	}
+}
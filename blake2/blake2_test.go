@@ -0,0 +1,183 @@
+package blake2
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"hash"
+	"testing"
+)
+
+func TestExamples(t *testing.T) {
+	newHash := func(newHash func(*Params) (hash.Hash, error), params Params) func() hash.Hash {
+		return func() hash.Hash {
+			h, _ := newHash(&params)
+			return h
+		}
+	}
+
+	newXOF := func(newXOF func(*Params) (XOF, error), params Params) func() hash.Hash {
+		return func() hash.Hash {
+			h, _ := newXOF(&params)
+			return h
+		}
+	}
+
+	newParallel := func(newParallel func([]byte) (hash.Hash, error), key []byte) func() hash.Hash {
+		return func() hash.Hash {
+			h, _ := newParallel(key)
+			return h
+		}
+	}
+
+	fox := "The quick brown fox jumps over the lazy dog"
+	tree := Tree{Fanout: 2, MaxDepth: 3, LeafLength: 4096, NodeOffset: 5, NodeDepth: 1, InnerLength: 32, LastNode: true}
+	key64 := make([]byte, 64)
+	for i := range key64 {
+		key64[i] = byte(i)
+	}
+
+	cases := []struct {
+		name     string
+		newHash  func() hash.Hash
+		input    string
+		expected string
+	}{
+		{"BLAKE2b", newHash(NewB, Params{}), fox, "a8add4bdddfd93e4877d2746e62817b116364a1fa7bc148d95090bc7333b3673f82401cf7aa2e4cb1ecd90296e3f14cb5413f8ed77be73045b13914cdcd6a918"},
+		{"BLAKE2s", newHash(NewS, Params{}), fox, "606beeec743ccbeff6cbcdf5d5302aa855c256c29b88c8ed331ea1a6bf3c8812"},
+		{"BLAKE2b", newHash(NewB, Params{Size: 20, Salt: []byte("salt"), Personalization: []byte("person")}), fox, "573a3c21a4e7d0defa1486df85af10a14e4603d4"},
+		{"BLAKE2s", newHash(NewS, Params{Size: 20, Salt: []byte("salt"), Personalization: []byte("person")}), fox, "f26c33bccb262c41e70a56c8cb657bfb864e5f27"},
+		{"BLAKE2b", newHash(NewB, Params{Size: 40, Key: []byte("key"), Salt: []byte("salty"), Personalization: []byte("me"), Tree: tree}), fox, "a82a2da540ec0ab5c9672e59bde1f6037c1e81475f617af3a56dd0c69c037afc358096b439a21a2c"},
+		{"BLAKE2s", newHash(NewS, Params{Salt: []byte("abcdefgh"), Personalization: []byte("12345678"), Tree: Tree{Fanout: 1, MaxDepth: 1, NodeOffset: 1<<48 - 1}}), "abc", "02f88d3b5cb1b3517e7c153789be474220b3a76177bd32dc364be9ffa1d802fc"},
+		// the empty message of the known answer tests of the reference implementation
+		{"BLAKE2bp", newParallel(NewBP, nil), "", "b5ef811a8038f70b628fa8b294daae7492b1ebe343a80eaabbf1f6ae664dd67b9d90b0120791eab81dc96985f28849f6a305186a85501b405114bfa678df9380"},
+		{"BLAKE2bp", newParallel(NewBP, key64), "", "9d9461073e4eb640a255357b839f394b838c6ff57c9b686a3f76107c1066728f3c9956bd785cbc3bf79dc2ab578c5a0c063b9d9c405848de1dbe821cd05c940a"},
+		{"BLAKE2sp", newParallel(NewSP, key64[:32]), "", "715cb13895aeb678f6124160bff21465b30f4f6874193fc851b4621043f09cc6"},
+		{"BLAKE2Xb", newXOF(NewXB, Params{Size: 16, Key: []byte("key")}), fox, "2ea6b64258494d6bb45545906a5d6410"},
+		{"BLAKE2Xs", newXOF(NewXS, Params{Size: 16, Key: []byte("key")}), fox, "43705e2e0bfbd350b4f52fa622e74a61"},
+		{"BLAKE2Xb", newXOF(NewXB, Params{Size: 100, Key: []byte("key")}), fox, "252414517d1f00c311e2535d01f8ad80ad21833a99c2bb2916026da721ecba33cec13ccacc5f9d2f4af79571b9c953ea81eff14120fc431bb80284ab7037e0d075a7ed9ca793f6e394ffd1624728f4882ac6e237f994e4809b2c55d6c44d84004015bce1"},
+		{"BLAKE2Xs", newXOF(NewXS, Params{Size: 100, Key: []byte("key")}), fox, "4ff1c45814729bf16b28766c894ce350c9cba468acba7618abc28840c11cc2566f6fbf412b01669989aa0a5e810e51798ce329786f5c651a5511b3466c806ee1cdfed6516c962dd2c06464dd42b67d00b37d95c9ab766143624ef4711491c389694ce029"},
+	}
+
+	for _, c := range cases {
+		h := c.newHash()
+		h.Write([]byte(c.input))
+
+		if got := hex.EncodeToString(h.Sum(nil)); got != c.expected {
+			t.Errorf("%s of \"%s\" of size %d is wrong:\n\texpected \"%s\"\n\tgot \"%s\"", c.name, c.input, h.Size(), c.expected, got)
+		}
+	}
+}
+
+func TestWrite(t *testing.T) {
+	data := make([]byte, 1000)
+	for i := range data {
+		data[i] = byte(i)
+	}
+
+	newHashes := []func() hash.Hash{
+		func() hash.Hash { h, _ := NewB(&Params{Key: []byte("key")}); return h },
+		func() hash.Hash { h, _ := NewS(&Params{Key: []byte("key")}); return h },
+		func() hash.Hash { h, _ := NewBP([]byte("key")); return h },
+		func() hash.Hash { h, _ := NewSP([]byte("key")); return h },
+	}
+
+	// the sum of the data written in two pieces is the same as the sum of the data written at once
+	for _, newHash := range newHashes {
+		h := newHash()
+		h.Write(data)
+		expected := h.Sum(nil)
+
+		for n := 0; n <= len(data); n += 7 {
+			h.Reset()
+			h.Write(data[:n])
+			h.Write(data[n:])
+
+			if got := h.Sum(nil); !bytes.Equal(expected, got) {
+				t.Errorf("sum of data split at %d is wrong:\n\texpected \"%x\"\n\tgot \"%x\"", n, expected, got)
+			}
+		}
+	}
+}
+
+func TestRead(t *testing.T) {
+	data := []byte("The quick brown fox jumps over the lazy dog")
+
+	h, _ := NewXB(&Params{Size: 300, Salt: []byte("salt")})
+	h.Write(data)
+	expected := h.Sum(nil)
+
+	// the output read in pieces is the same as the sum of the whole size
+	for n := 1; n < 2*SizeB; n++ {
+		h.Reset()
+		h.Write(data)
+
+		var output []byte
+		for len(output) < len(expected) {
+			piece := make([]byte, min(n, len(expected)-len(output)))
+			h.Read(piece)
+			output = append(output, piece...)
+		}
+
+		if !bytes.Equal(expected, output) {
+			t.Errorf("output read in pieces of %d bytes is wrong:\n\texpected \"%x\"\n\tgot \"%x\"", n, expected, output)
+		}
+	}
+
+	if _, err := h.Write(data); !errors.Is(err, ErrWriteAfterRead) {
+		t.Errorf("write after read returned %v, expected %v", err, ErrWriteAfterRead)
+	}
+}
+
+func TestErrors(t *testing.T) {
+	cases := []struct {
+		name     string
+		err      error
+		expected error
+	}{
+		{"size", second(NewB(&Params{Size: SizeB + 1})), ErrSize},
+		{"key size", second(NewS(&Params{Key: make([]byte, SizeS+1)})), ErrKeySize},
+		{"salt size", second(NewS(&Params{Salt: make([]byte, SaltSizeS+1)})), ErrSaltSize},
+		{"personalization size", second(NewB(&Params{Personalization: make([]byte, PersonalizationSizeB+1)})), ErrPersonalizationSize},
+		{"depth", second(NewB(&Params{Tree: Tree{Fanout: 2}})), ErrTree},
+		{"node offset", second(NewS(&Params{Tree: Tree{MaxDepth: 1, NodeOffset: 1 << 48}})), ErrTree},
+		{"parallel key size", second(NewSP(make([]byte, SizeS+1))), ErrKeySize},
+		{"XOF size", second(NewXS(&Params{Size: MaxXOFSizeS + 1})), ErrSize},
+		{"XOF tree", second(NewXB(&Params{Tree: sequential})), ErrTree},
+	}
+
+	for _, c := range cases {
+		if !errors.Is(c.err, c.expected) {
+			t.Errorf("invalid %s returned %v, expected %v", c.name, c.err, c.expected)
+		}
+	}
+}
+
+// second returns the error of a constructor.
+func second[T any](_ T, err error) error { return err }
+
+func benchmarkBlake2(b *testing.B, h hash.Hash) {
+	data := make([]byte, 8192)
+
+	b.SetBytes(int64(len(data)))
+	for i := 0; i < b.N; i++ {
+		h.Reset()
+		h.Write(data)
+		h.Sum(nil)
+	}
+}
+
+func BenchmarkBlake2B(b *testing.B) {
+	h, _ := NewB(&Params{})
+	benchmarkBlake2(b, h)
+}
+
+func BenchmarkBlake2S(b *testing.B) {
+	h, _ := NewS(&Params{})
+	benchmarkBlake2(b, h)
+}
+
+func BenchmarkBlake2BP(b *testing.B) {
+	h, _ := NewBP(nil)
+	benchmarkBlake2(b, h)
+}
@@ -0,0 +1,58 @@
+package blake2
+
+const (
+	// SizeB is the maximum size of a BLAKE2b checksum in bytes, which is also the maximum size of its key.
+	SizeB = 64
+	// SizeS is the maximum size of a BLAKE2s checksum in bytes, which is also the maximum size of its key.
+	SizeS = 32
+	// BlockSizeB the block size of BLAKE2b in bytes.
+	BlockSizeB = 128
+	// BlockSizeS the block size of BLAKE2s in bytes.
+	BlockSizeS = 64
+	// SaltSizeB is the size of the salt of BLAKE2b in bytes.
+	SaltSizeB = 16
+	// SaltSizeS is the size of the salt of BLAKE2s in bytes.
+	SaltSizeS = 8
+	// PersonalizationSizeB is the size of the personalization of BLAKE2b in bytes.
+	PersonalizationSizeB = 16
+	// PersonalizationSizeS is the size of the personalization of BLAKE2s in bytes.
+	PersonalizationSizeS = 8
+	// MaxXOFSizeB is the maximum output size of BLAKE2Xb in bytes, the next value means an unknown length.
+	MaxXOFSizeB = 1<<32 - 2
+	// MaxXOFSizeS is the maximum output size of BLAKE2Xs in bytes, the next value means an unknown length.
+	MaxXOFSizeS = 1<<16 - 2
+
+	// roundsB and roundsS are the numbers of rounds of the compression functions.
+	roundsB = 12
+	roundsS = 10
+	// parallelismB and parallelismS are the numbers of leaves of BLAKE2bp and BLAKE2sp.
+	parallelismB = 4
+	parallelismS = 8
+	// maxNodeOffsetS is the limit of the 48 bits node offset of BLAKE2s.
+	maxNodeOffsetS = 1 << 48
+)
+
+var (
+	// ivB and ivS are the initial values, the ones of SHA-512 and SHA-256.
+	ivB = [8]uint64{
+		0x6a09e667f3bcc908, 0xbb67ae8584caa73b, 0x3c6ef372fe94f82b, 0xa54ff53a5f1d36f1,
+		0x510e527fade682d1, 0x9b05688c2b3e6c1f, 0x1f83d9abfb41bd6b, 0x5be0cd19137e2179,
+	}
+	ivS = [8]uint32{
+		0x6a09e667, 0xbb67ae85, 0x3c6ef372, 0xa54ff53a, 0x510e527f, 0x9b05688c, 0x1f83d9ab, 0x5be0cd19,
+	}
+
+	// sigma are the permutations of the message words of the rounds, BLAKE2b repeats the first two.
+	sigma = [10][16]byte{
+		{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15},
+		{14, 10, 4, 8, 9, 15, 13, 6, 1, 12, 0, 2, 11, 7, 5, 3},
+		{11, 8, 12, 0, 5, 2, 15, 13, 10, 14, 3, 6, 7, 1, 9, 4},
+		{7, 9, 3, 1, 13, 12, 11, 14, 2, 6, 5, 10, 4, 0, 15, 8},
+		{9, 0, 5, 7, 2, 4, 10, 15, 14, 1, 11, 12, 6, 8, 3, 13},
+		{2, 12, 6, 10, 0, 11, 8, 3, 4, 13, 7, 5, 15, 14, 1, 9},
+		{12, 5, 1, 15, 14, 13, 4, 10, 0, 7, 6, 3, 9, 2, 8, 11},
+		{13, 11, 7, 14, 12, 1, 3, 9, 5, 0, 15, 4, 8, 6, 2, 10},
+		{6, 15, 14, 9, 11, 3, 0, 8, 12, 2, 13, 7, 1, 4, 10, 5},
+		{10, 2, 8, 4, 7, 6, 1, 5, 15, 11, 9, 14, 3, 12, 13, 0},
+	}
+)
@@ -0,0 +1,110 @@
+// Package blake2 implements the BLAKE2b and BLAKE2s hash algorithms with the whole parameter block,
+// the size of the checksum, the key, the salt, the personalization and the parameters of tree hashing,
+// and the BLAKE2bp and BLAKE2sp parallel variants and the BLAKE2Xb and BLAKE2Xs extendable-output functions.
+package blake2
+
+import (
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+)
+
+var (
+	// ErrSize is returned when the size of the checksum or the output size of BLAKE2X is out of range.
+	ErrSize = errors.New("blake2: invalid size")
+	// ErrKeySize is returned when the key is longer than the maximum size of the checksum.
+	ErrKeySize = errors.New("blake2: invalid key size")
+	// ErrSaltSize is returned when the salt is longer than the salt size.
+	ErrSaltSize = errors.New("blake2: invalid salt size")
+	// ErrPersonalizationSize is returned when the personalization is longer than the personalization size.
+	ErrPersonalizationSize = errors.New("blake2: invalid personalization size")
+	// ErrTree is returned when the tree parameters are invalid, or given for a variant which defines its own.
+	ErrTree = errors.New("blake2: invalid tree parameters")
+	// ErrWriteAfterRead is returned when BLAKE2X is written to after it started squeezing.
+	ErrWriteAfterRead = errors.New("blake2: write after read")
+)
+
+// XOF represents an extendable-output function, Sum returns Size bytes and Read squeezes up to Size bytes.
+type XOF interface {
+	hash.Hash
+	io.Reader
+}
+
+// NewB creates a new BLAKE2b hash.Hash of the parameters.
+func NewB(params *Params) (hash.Hash, error) {
+	if err := params.validate(SizeB, SaltSizeB); err != nil {
+		return nil, err
+	}
+
+	c := params.config(SizeB)
+
+	return newModel2b(&c, params.Key), nil
+}
+
+// NewS creates a new BLAKE2s hash.Hash of the parameters.
+func NewS(params *Params) (hash.Hash, error) {
+	if err := params.validate(SizeS, SaltSizeS); err != nil {
+		return nil, err
+	}
+
+	c := params.config(SizeS)
+
+	return newModel2s(&c, params.Key), nil
+}
+
+// NewBP creates a new BLAKE2bp hash.Hash, four BLAKE2b leaves of the interleaved blocks under a root,
+// the key is optional.
+func NewBP(key []byte) (hash.Hash, error) {
+	if len(key) > SizeB {
+		return nil, fmt.Errorf("%w: %d", ErrKeySize, len(key))
+	}
+
+	return newParallel(parallelismB, BlockSizeB, SizeB, key, func(c *config, key []byte) hash.Hash { return newModel2b(c, key) }), nil
+}
+
+// NewSP creates a new BLAKE2sp hash.Hash, eight BLAKE2s leaves of the interleaved blocks under a root,
+// the key is optional.
+func NewSP(key []byte) (hash.Hash, error) {
+	if len(key) > SizeS {
+		return nil, fmt.Errorf("%w: %d", ErrKeySize, len(key))
+	}
+
+	return newParallel(parallelismS, BlockSizeS, SizeS, key, func(c *config, key []byte) hash.Hash { return newModel2s(c, key) }), nil
+}
+
+// NewXB creates a new BLAKE2Xb XOF of the parameters, the size is the output size up to MaxXOFSizeB
+// and the tree parameters are the ones of BLAKE2X.
+func NewXB(params *Params) (XOF, error) {
+	if err := validateXOF(params, MaxXOFSizeB, SizeB, SaltSizeB); err != nil {
+		return nil, err
+	}
+
+	return newXOF(params, SizeB, func(c *config, key []byte) hash.Hash { return newModel2b(c, key) }), nil
+}
+
+// NewXS creates a new BLAKE2Xs XOF of the parameters, the size is the output size up to MaxXOFSizeS
+// and the tree parameters are the ones of BLAKE2X.
+func NewXS(params *Params) (XOF, error) {
+	if err := validateXOF(params, MaxXOFSizeS, SizeS, SaltSizeS); err != nil {
+		return nil, err
+	}
+
+	return newXOF(params, SizeS, func(c *config, key []byte) hash.Hash { return newModel2s(c, key) }), nil
+}
+
+// validateXOF returns an error if the parameters of BLAKE2X are out of range.
+func validateXOF(params *Params, maxXOFSize, maxSize, saltSize int) error {
+	if params.Size < 0 || params.Size > maxXOFSize {
+		return fmt.Errorf("%w: %d", ErrSize, params.Size)
+	}
+
+	if params.Tree != (Tree{}) {
+		return ErrTree
+	}
+
+	p := *params
+	p.Size = 0
+
+	return p.validate(maxSize, saltSize)
+}
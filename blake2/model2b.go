@@ -0,0 +1,168 @@
+package blake2
+
+import (
+	"encoding/binary"
+	"math/bits"
+)
+
+// model2b represents a structure for the BLAKE2b hash.Hash, which is a node of a tree in tree hashing.
+// The last block is finalized by a flag, so a full buffer is only compressed when more data follows.
+type model2b struct {
+	size        int
+	lastNode    bool
+	iv          [8]uint64
+	key         [BlockSizeB]byte
+	keyed       bool
+	state       [8]uint64
+	counter     [2]uint64
+	buffer      [BlockSizeB]byte
+	bufferIndex int
+}
+
+// newModel2b creates a new BLAKE2b model of the parameter block, the key is optional.
+func newModel2b(c *config, key []byte) *model2b {
+	var block [64]byte
+	c.encode(block[:])
+
+	h := &model2b{size: c.size, lastNode: c.tree.LastNode}
+	for i := range h.iv {
+		h.iv[i] = ivB[i] ^ binary.LittleEndian.Uint64(block[8*i:])
+	}
+
+	// the key padded by zeros is the first block
+	if len(key) > 0 {
+		copy(h.key[:], key)
+		h.keyed = true
+	}
+
+	h.Reset()
+
+	return h
+}
+
+// implementation of the hash.Hash
+
+// Reset resets the hash.Hash to its initial state.
+func (r *model2b) Reset() {
+	r.state = r.iv
+	r.counter = [2]uint64{}
+	r.bufferIndex = 0
+
+	if r.keyed {
+		r.buffer = r.key
+		r.bufferIndex = BlockSizeB
+	}
+}
+
+// Size returns the number of bytes Sum will return.
+func (r *model2b) Size() int { return r.size }
+
+// BlockSize returns the hash's underlying block size.
+// The Write method must be able to accept any amount of data,
+// but it may operate more efficiently if all writes are a multiple of the block size.
+func (r *model2b) BlockSize() int { return BlockSizeB }
+
+// Write appends the data to the digest.
+func (r *model2b) Write(p []byte) (int, error) {
+	n := len(p)
+
+	for len(p) > 0 {
+		if r.bufferIndex == BlockSizeB {
+			r.compress(r.buffer[:], BlockSizeB, 0)
+			r.bufferIndex = 0
+		}
+
+		if r.bufferIndex == 0 {
+			for ; len(p) > BlockSizeB; p = p[BlockSizeB:] {
+				r.compress(p, BlockSizeB, 0)
+			}
+		}
+
+		x := copy(r.buffer[r.bufferIndex:], p)
+		r.bufferIndex += x
+		p = p[x:]
+	}
+
+	return n, nil
+}
+
+// Sum appends the current hash to b and returns the resulting slice.
+// It does not change the underlying hash state.
+func (r *model2b) Sum(b []byte) []byte {
+	// copy the model to allow other writes to continue and to prevent change of the state
+	s := *r
+
+	// the last block is padded by zeros and only its bytes are counted
+	for i := s.bufferIndex; i < BlockSizeB; i++ {
+		s.buffer[i] = 0
+	}
+
+	s.compress(s.buffer[:], uint64(s.bufferIndex), ^uint64(0))
+
+	var digest [SizeB]byte
+	for i, x := range s.state {
+		binary.LittleEndian.PutUint64(digest[8*i:], x)
+	}
+
+	return append(b, digest[:s.size]...)
+}
+
+// private
+
+// compress counts the length of the block and compresses it into the state, the final flag is set for the last block.
+func (r *model2b) compress(block []byte, length, final uint64) {
+	r.counter[0] += length
+	if r.counter[0] < length {
+		r.counter[1]++
+	}
+
+	var lastNode uint64
+	if final != 0 && r.lastNode {
+		lastNode = ^uint64(0)
+	}
+
+	var m [16]uint64
+	for i := range m {
+		m[i] = binary.LittleEndian.Uint64(block[8*i:])
+	}
+
+	v0, v1, v2, v3, v4, v5, v6, v7 := r.state[0], r.state[1], r.state[2], r.state[3], r.state[4], r.state[5], r.state[6], r.state[7]
+	v8, v9, v10, v11 := ivB[0], ivB[1], ivB[2], ivB[3]
+	v12, v13, v14, v15 := ivB[4]^r.counter[0], ivB[5]^r.counter[1], ivB[6]^final, ivB[7]^lastNode
+
+	for i := 0; i < roundsB; i++ {
+		s := &sigma[i%10]
+
+		v0, v4, v8, v12 = g2b(v0, v4, v8, v12, m[s[0]], m[s[1]])
+		v1, v5, v9, v13 = g2b(v1, v5, v9, v13, m[s[2]], m[s[3]])
+		v2, v6, v10, v14 = g2b(v2, v6, v10, v14, m[s[4]], m[s[5]])
+		v3, v7, v11, v15 = g2b(v3, v7, v11, v15, m[s[6]], m[s[7]])
+		v0, v5, v10, v15 = g2b(v0, v5, v10, v15, m[s[8]], m[s[9]])
+		v1, v6, v11, v12 = g2b(v1, v6, v11, v12, m[s[10]], m[s[11]])
+		v2, v7, v8, v13 = g2b(v2, v7, v8, v13, m[s[12]], m[s[13]])
+		v3, v4, v9, v14 = g2b(v3, v4, v9, v14, m[s[14]], m[s[15]])
+	}
+
+	r.state[0] ^= v0 ^ v8
+	r.state[1] ^= v1 ^ v9
+	r.state[2] ^= v2 ^ v10
+	r.state[3] ^= v3 ^ v11
+	r.state[4] ^= v4 ^ v12
+	r.state[5] ^= v5 ^ v13
+	r.state[6] ^= v6 ^ v14
+	r.state[7] ^= v7 ^ v15
+}
+
+// g2b is the mixing function of BLAKE2b.
+func g2b(a, b, c, d, x, y uint64) (uint64, uint64, uint64, uint64) {
+	a += b + x
+	d = bits.RotateLeft64(d^a, -32)
+	c += d
+	b = bits.RotateLeft64(b^c, -24)
+	a += b + y
+	d = bits.RotateLeft64(d^a, -16)
+	c += d
+	b = bits.RotateLeft64(b^c, -63)
+
+	return a, b, c, d
+}
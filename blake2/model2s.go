@@ -0,0 +1,168 @@
+package blake2
+
+import (
+	"encoding/binary"
+	"math/bits"
+)
+
+// model2s represents a structure for the BLAKE2s hash.Hash, which is a node of a tree in tree hashing.
+// The last block is finalized by a flag, so a full buffer is only compressed when more data follows.
+type model2s struct {
+	size        int
+	lastNode    bool
+	iv          [8]uint32
+	key         [BlockSizeS]byte
+	keyed       bool
+	state       [8]uint32
+	counter     [2]uint32
+	buffer      [BlockSizeS]byte
+	bufferIndex int
+}
+
+// newModel2s creates a new BLAKE2s model of the parameter block, the key is optional.
+func newModel2s(c *config, key []byte) *model2s {
+	var block [32]byte
+	c.encode(block[:])
+
+	h := &model2s{size: c.size, lastNode: c.tree.LastNode}
+	for i := range h.iv {
+		h.iv[i] = ivS[i] ^ binary.LittleEndian.Uint32(block[4*i:])
+	}
+
+	// the key padded by zeros is the first block
+	if len(key) > 0 {
+		copy(h.key[:], key)
+		h.keyed = true
+	}
+
+	h.Reset()
+
+	return h
+}
+
+// implementation of the hash.Hash
+
+// Reset resets the hash.Hash to its initial state.
+func (r *model2s) Reset() {
+	r.state = r.iv
+	r.counter = [2]uint32{}
+	r.bufferIndex = 0
+
+	if r.keyed {
+		r.buffer = r.key
+		r.bufferIndex = BlockSizeS
+	}
+}
+
+// Size returns the number of bytes Sum will return.
+func (r *model2s) Size() int { return r.size }
+
+// BlockSize returns the hash's underlying block size.
+// The Write method must be able to accept any amount of data,
+// but it may operate more efficiently if all writes are a multiple of the block size.
+func (r *model2s) BlockSize() int { return BlockSizeS }
+
+// Write appends the data to the digest.
+func (r *model2s) Write(p []byte) (int, error) {
+	n := len(p)
+
+	for len(p) > 0 {
+		if r.bufferIndex == BlockSizeS {
+			r.compress(r.buffer[:], BlockSizeS, 0)
+			r.bufferIndex = 0
+		}
+
+		if r.bufferIndex == 0 {
+			for ; len(p) > BlockSizeS; p = p[BlockSizeS:] {
+				r.compress(p, BlockSizeS, 0)
+			}
+		}
+
+		x := copy(r.buffer[r.bufferIndex:], p)
+		r.bufferIndex += x
+		p = p[x:]
+	}
+
+	return n, nil
+}
+
+// Sum appends the current hash to b and returns the resulting slice.
+// It does not change the underlying hash state.
+func (r *model2s) Sum(b []byte) []byte {
+	// copy the model to allow other writes to continue and to prevent change of the state
+	s := *r
+
+	// the last block is padded by zeros and only its bytes are counted
+	for i := s.bufferIndex; i < BlockSizeS; i++ {
+		s.buffer[i] = 0
+	}
+
+	s.compress(s.buffer[:], uint32(s.bufferIndex), ^uint32(0))
+
+	var digest [SizeS]byte
+	for i, x := range s.state {
+		binary.LittleEndian.PutUint32(digest[4*i:], x)
+	}
+
+	return append(b, digest[:s.size]...)
+}
+
+// private
+
+// compress counts the length of the block and compresses it into the state, the final flag is set for the last block.
+func (r *model2s) compress(block []byte, length, final uint32) {
+	r.counter[0] += length
+	if r.counter[0] < length {
+		r.counter[1]++
+	}
+
+	var lastNode uint32
+	if final != 0 && r.lastNode {
+		lastNode = ^uint32(0)
+	}
+
+	var m [16]uint32
+	for i := range m {
+		m[i] = binary.LittleEndian.Uint32(block[4*i:])
+	}
+
+	v0, v1, v2, v3, v4, v5, v6, v7 := r.state[0], r.state[1], r.state[2], r.state[3], r.state[4], r.state[5], r.state[6], r.state[7]
+	v8, v9, v10, v11 := ivS[0], ivS[1], ivS[2], ivS[3]
+	v12, v13, v14, v15 := ivS[4]^r.counter[0], ivS[5]^r.counter[1], ivS[6]^final, ivS[7]^lastNode
+
+	for i := 0; i < roundsS; i++ {
+		s := &sigma[i]
+
+		v0, v4, v8, v12 = g2s(v0, v4, v8, v12, m[s[0]], m[s[1]])
+		v1, v5, v9, v13 = g2s(v1, v5, v9, v13, m[s[2]], m[s[3]])
+		v2, v6, v10, v14 = g2s(v2, v6, v10, v14, m[s[4]], m[s[5]])
+		v3, v7, v11, v15 = g2s(v3, v7, v11, v15, m[s[6]], m[s[7]])
+		v0, v5, v10, v15 = g2s(v0, v5, v10, v15, m[s[8]], m[s[9]])
+		v1, v6, v11, v12 = g2s(v1, v6, v11, v12, m[s[10]], m[s[11]])
+		v2, v7, v8, v13 = g2s(v2, v7, v8, v13, m[s[12]], m[s[13]])
+		v3, v4, v9, v14 = g2s(v3, v4, v9, v14, m[s[14]], m[s[15]])
+	}
+
+	r.state[0] ^= v0 ^ v8
+	r.state[1] ^= v1 ^ v9
+	r.state[2] ^= v2 ^ v10
+	r.state[3] ^= v3 ^ v11
+	r.state[4] ^= v4 ^ v12
+	r.state[5] ^= v5 ^ v13
+	r.state[6] ^= v6 ^ v14
+	r.state[7] ^= v7 ^ v15
+}
+
+// g2s is the mixing function of BLAKE2s.
+func g2s(a, b, c, d, x, y uint32) (uint32, uint32, uint32, uint32) {
+	a += b + x
+	d = bits.RotateLeft32(d^a, -16)
+	c += d
+	b = bits.RotateLeft32(b^c, -12)
+	a += b + y
+	d = bits.RotateLeft32(d^a, -8)
+	c += d
+	b = bits.RotateLeft32(b^c, -7)
+
+	return a, b, c, d
+}
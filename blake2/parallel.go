@@ -0,0 +1,87 @@
+package blake2
+
+import "hash"
+
+// parallel represents a structure for BLAKE2bp and BLAKE2sp, the blocks are written to the leaves in turn
+// and the root hashes the checksums of the leaves.
+type parallel struct {
+	leaves    []hash.Hash
+	root      hash.Hash
+	blockSize int
+	// offset is the number of bytes written, modulo the size of a round of blocks over all the leaves.
+	offset int
+}
+
+// newParallel creates a new parallel model of the number of leaves, the nodes are created by newNode
+// with the parameter blocks of the tree of fanout the leaves and depth 2.
+func newParallel(leaves, blockSize, size int, key []byte, newNode func(c *config, key []byte) hash.Hash) *parallel {
+	h := &parallel{blockSize: blockSize}
+
+	c := config{
+		size:      size,
+		keyLength: len(key),
+		tree:      Tree{Fanout: uint8(leaves), MaxDepth: 2, InnerLength: uint8(size)},
+	}
+
+	for i := 0; i < leaves; i++ {
+		leaf := c
+		leaf.tree.NodeOffset = uint64(i)
+		leaf.tree.LastNode = i == leaves-1
+		h.leaves = append(h.leaves, newNode(&leaf, key))
+	}
+
+	// the root is not keyed by a first block, but its parameter block has the key length
+	c.tree.NodeDepth = 1
+	c.tree.LastNode = true
+	h.root = newNode(&c, nil)
+
+	return h
+}
+
+// implementation of the hash.Hash
+
+// Reset resets the hash.Hash to its initial state.
+func (r *parallel) Reset() {
+	for _, leaf := range r.leaves {
+		leaf.Reset()
+	}
+
+	r.offset = 0
+}
+
+// Size returns the number of bytes Sum will return.
+func (r *parallel) Size() int { return r.root.Size() }
+
+// BlockSize returns the hash's underlying block size.
+// The Write method must be able to accept any amount of data,
+// but it may operate more efficiently if all writes are a multiple of the block size.
+func (r *parallel) BlockSize() int { return r.blockSize }
+
+// Write appends the data to the digest.
+func (r *parallel) Write(p []byte) (int, error) {
+	n := len(p)
+	roundSize := r.blockSize * len(r.leaves)
+
+	for len(p) > 0 {
+		leaf := r.leaves[r.offset/r.blockSize]
+
+		x := min(r.blockSize-r.offset%r.blockSize, len(p))
+		_, _ = leaf.Write(p[:x])
+		r.offset = (r.offset + x) % roundSize
+		p = p[x:]
+	}
+
+	return n, nil
+}
+
+// Sum appends the current hash to b and returns the resulting slice.
+// It does not change the underlying hash state.
+func (r *parallel) Sum(b []byte) []byte {
+	r.root.Reset()
+
+	for _, leaf := range r.leaves {
+		_, _ = r.root.Write(leaf.Sum(nil))
+	}
+
+	return r.root.Sum(b)
+}
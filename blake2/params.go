@@ -0,0 +1,135 @@
+package blake2
+
+import (
+	"encoding/binary"
+	"fmt"
+)
+
+// Params represents the parameters of BLAKE2b and BLAKE2s, all of them are optional.
+type Params struct {
+	// Size is the size of the checksum in bytes, or the output size of BLAKE2X, zero means the maximum size
+	// of the checksum.
+	Size int
+	// Key is the key of the keyed hashing, up to the maximum size of the checksum.
+	Key []byte
+	// Salt is padded by zeros to the salt size.
+	Salt []byte
+	// Personalization is padded by zeros to the personalization size.
+	Personalization []byte
+	// Tree holds the parameters of tree hashing, the zero value means sequential hashing.
+	Tree Tree
+}
+
+// Tree represents the tree hashing parameters of the parameter block.
+type Tree struct {
+	// Fanout is the number of children of a node, zero means unlimited.
+	Fanout uint8
+	// MaxDepth is the maximal depth of the tree, from 1 to 255.
+	MaxDepth uint8
+	// LeafLength is the maximal size of a leaf in bytes, zero means unlimited.
+	LeafLength uint32
+	// NodeOffset is the offset of the node in its level, which is 48 bits for BLAKE2s.
+	NodeOffset uint64
+	// NodeDepth is the depth of the node, zero for the leaves.
+	NodeDepth uint8
+	// InnerLength is the size of the checksums of the inner nodes in bytes.
+	InnerLength uint8
+	// LastNode marks the last node of a level, which finalizes the node with the last node flag.
+	LastNode bool
+}
+
+// sequential is the tree of sequential hashing, a single node.
+var sequential = Tree{Fanout: 1, MaxDepth: 1}
+
+// config represents the fields of a parameter block.
+type config struct {
+	size            int
+	keyLength       int
+	salt            []byte
+	personalization []byte
+	tree            Tree
+}
+
+// validate returns an error if the parameters are out of range for the maximum size of the checksum,
+// which is also the maximum size of the key, and the size of the salt and the personalization.
+func (r *Params) validate(maxSize, saltSize int) error {
+	if r.Size < 0 || r.Size > maxSize {
+		return fmt.Errorf("%w: %d", ErrSize, r.Size)
+	}
+
+	if len(r.Key) > maxSize {
+		return fmt.Errorf("%w: %d", ErrKeySize, len(r.Key))
+	}
+
+	if len(r.Salt) > saltSize {
+		return fmt.Errorf("%w: %d", ErrSaltSize, len(r.Salt))
+	}
+
+	if len(r.Personalization) > saltSize {
+		return fmt.Errorf("%w: %d", ErrPersonalizationSize, len(r.Personalization))
+	}
+
+	if r.Tree != (Tree{}) {
+		if r.Tree.MaxDepth == 0 {
+			return fmt.Errorf("%w: depth is zero", ErrTree)
+		}
+
+		if int(r.Tree.InnerLength) > maxSize {
+			return fmt.Errorf("%w: inner length %d", ErrTree, r.Tree.InnerLength)
+		}
+
+		if maxSize == SizeS && r.Tree.NodeOffset >= maxNodeOffsetS {
+			return fmt.Errorf("%w: node offset %d", ErrTree, r.Tree.NodeOffset)
+		}
+	}
+
+	return nil
+}
+
+// config returns the config of the parameters, with the maximum size if the size is zero.
+func (r *Params) config(maxSize int) config {
+	c := config{
+		size:            r.Size,
+		keyLength:       len(r.Key),
+		salt:            r.Salt,
+		personalization: r.Personalization,
+		tree:            r.Tree,
+	}
+
+	if c.size == 0 {
+		c.size = maxSize
+	}
+
+	if c.tree == (Tree{}) {
+		c.tree = sequential
+	}
+
+	return c
+}
+
+// encode writes the parameter block to b, which is 64 bytes for BLAKE2b and 32 bytes for BLAKE2s.
+// The node offset takes 8 bytes in BLAKE2b and 6 bytes in BLAKE2s, the salt and the personalization
+// take the second half of the block.
+func (r *config) encode(b []byte) {
+	offsetSize := 8
+	if len(b) == 32 {
+		offsetSize = 6
+	}
+
+	b[0] = byte(r.size)
+	b[1] = byte(r.keyLength)
+	b[2] = r.tree.Fanout
+	b[3] = r.tree.MaxDepth
+	binary.LittleEndian.PutUint32(b[4:], r.tree.LeafLength)
+
+	var offset [8]byte
+	binary.LittleEndian.PutUint64(offset[:], r.tree.NodeOffset)
+	copy(b[8:8+offsetSize], offset[:])
+
+	b[8+offsetSize] = r.tree.NodeDepth
+	b[9+offsetSize] = r.tree.InnerLength
+
+	half := len(b) / 2
+	copy(b[half:], r.salt)
+	copy(b[half+half/2:], r.personalization)
+}
@@ -0,0 +1,148 @@
+package blake2
+
+import (
+	"hash"
+	"io"
+)
+
+// xof represents a structure for BLAKE2Xb and BLAKE2Xs, the root hashes the message with the output size
+// in its parameter block and every block of the output is the checksum of the root checksum by a node
+// of the next offset.
+type xof struct {
+	root            hash.Hash
+	newNode         func(c *config, key []byte) hash.Hash
+	maxSize         int
+	size            int
+	salt            []byte
+	personalization []byte
+	// the output state, the checksum of the root, the output block and the number of bytes read
+	squeezing   bool
+	rootSum     []byte
+	buffer      [SizeB]byte
+	bufferIndex int
+	read        int
+}
+
+// newXOF creates a new BLAKE2X model of the parameters, maxSize is the size of the checksum of the nodes.
+func newXOF(params *Params, maxSize int, newNode func(c *config, key []byte) hash.Hash) *xof {
+	h := &xof{
+		newNode:         newNode,
+		maxSize:         maxSize,
+		size:            params.Size,
+		salt:            params.Salt,
+		personalization: params.Personalization,
+	}
+
+	if h.size == 0 {
+		h.size = maxSize
+	}
+
+	// the output size takes the bytes after the 32 bits node offset
+	c := params.config(maxSize)
+	c.size = maxSize
+	c.tree.NodeOffset = uint64(h.size) << 32
+	h.root = newNode(&c, params.Key)
+
+	return h
+}
+
+// implementation of the hash.Hash
+
+// Reset resets the hash.Hash to its initial state.
+func (r *xof) Reset() {
+	r.root.Reset()
+	r.squeezing = false
+	r.read = 0
+}
+
+// Size returns the number of bytes Sum will return.
+func (r *xof) Size() int { return r.size }
+
+// BlockSize returns the hash's underlying block size.
+// The Write method must be able to accept any amount of data,
+// but it may operate more efficiently if all writes are a multiple of the block size.
+func (r *xof) BlockSize() int { return r.root.BlockSize() }
+
+// Write appends the data to the digest.
+// It returns ErrWriteAfterRead if the output has already been read.
+func (r *xof) Write(p []byte) (int, error) {
+	if r.squeezing {
+		return 0, ErrWriteAfterRead
+	}
+
+	return r.root.Write(p)
+}
+
+// Sum appends the current hash to b and returns the resulting slice.
+// It does not change the underlying hash state.
+func (r *xof) Sum(b []byte) []byte {
+	// the output is computed again from the root, which does not change while squeezing
+	s := *r
+	s.squeezing = false
+	s.read = 0
+
+	n := len(b)
+	b = append(b, make([]byte, s.size)...)
+	_, _ = s.Read(b[n:])
+
+	return b
+}
+
+// implementation of the io.Reader
+
+// Read squeezes output from the XOF, up to the output size.
+// It returns io.EOF when the whole output has been read.
+func (r *xof) Read(p []byte) (int, error) {
+	if !r.squeezing {
+		r.rootSum = r.root.Sum(nil)
+		r.squeezing = true
+		r.bufferIndex = r.maxSize
+	}
+
+	if r.read == r.size {
+		return 0, io.EOF
+	}
+
+	if len(p) > r.size-r.read {
+		p = p[:r.size-r.read]
+	}
+
+	n := len(p)
+	for len(p) > 0 {
+		if r.bufferIndex == r.maxSize {
+			r.expand()
+		}
+
+		x := copy(p, r.buffer[r.bufferIndex:r.maxSize])
+		r.bufferIndex += x
+		r.read += x
+		p = p[x:]
+	}
+
+	return n, nil
+}
+
+// private
+
+// expand puts the next block of the output in the buffer, the checksum of the root checksum by the node
+// of the offset of the block, whose checksum size is the rest of the output up to the maximum size.
+func (r *xof) expand() {
+	offset := r.read / r.maxSize
+
+	c := config{
+		size:            min(r.maxSize, r.size-offset*r.maxSize),
+		salt:            r.salt,
+		personalization: r.personalization,
+		tree: Tree{
+			LeafLength:  uint32(r.maxSize),
+			NodeOffset:  uint64(offset) | uint64(r.size)<<32,
+			InnerLength: uint8(r.maxSize),
+		},
+	}
+
+	node := r.newNode(&c, nil)
+	_, _ = node.Write(r.rootSum)
+	node.Sum(r.buffer[:0])
+
+	r.bufferIndex = 0
+}
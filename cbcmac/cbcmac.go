@@ -0,0 +1,136 @@
+// Package cbcmac implements the CBC-MAC algorithms 1 and 3 of ISO/IEC 9797-1, the plain CBC-MAC of a block cipher
+// and the retail MAC of ANSI X9.19 with an output transformation by a second key, with the padding methods 1 and 2.
+//
+// CBC-MAC is only secure for the messages of a fixed length, or with the padding method 2 and the output
+// transformation, CMAC should be preferred for the others.
+package cbcmac
+
+import (
+	"crypto/cipher"
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"hash"
+)
+
+var (
+	// ErrBlockSize is returned when the block sizes of the ciphers of the retail MAC differ.
+	ErrBlockSize = errors.New("cbcmac: invalid block size")
+	// ErrPadding is returned when the padding is not one of ZeroPadding and BitPadding.
+	ErrPadding = errors.New("cbcmac: invalid padding")
+)
+
+// Padding is the padding method of ISO/IEC 9797-1 of the last block.
+type Padding int
+
+const (
+	// ZeroPadding is the padding method 1, the zero bytes up to the block size, and a zero block for the empty message.
+	ZeroPadding Padding = iota
+	// BitPadding is the padding method 2, the byte 0x80 and the zero bytes up to the block size.
+	BitPadding
+)
+
+// model represents a structure for the CBC-MAC hash.Hash.
+type model struct {
+	block   cipher.Block
+	final   cipher.Block
+	padding Padding
+	x       []byte
+	buf     []byte
+	bufLen  int
+	written bool
+}
+
+// New creates a new CBC-MAC hash.Hash of the block cipher with the padding, the checksums are a block long.
+func New(block cipher.Block, padding Padding) (hash.Hash, error) {
+	return newModel(block, nil, padding)
+}
+
+// NewRetail creates a new hash.Hash of the retail MAC, the algorithm 3 of ISO/IEC 9797-1, with the padding. The last
+// block of the CBC-MAC of the first cipher is decrypted by the second one and encrypted by the first one again, like
+// the DES retail MAC of ANSI X9.19 with a key of 16 bytes.
+func NewRetail(block1, block2 cipher.Block, padding Padding) (hash.Hash, error) {
+	if block1.BlockSize() != block2.BlockSize() {
+		return nil, fmt.Errorf("%w: %d and %d", ErrBlockSize, block1.BlockSize(), block2.BlockSize())
+	}
+
+	return newModel(block1, block2, padding)
+}
+
+// newModel creates a new CBC-MAC model after validating its parameters.
+func newModel(block, final cipher.Block, padding Padding) (*model, error) {
+	if padding != ZeroPadding && padding != BitPadding {
+		return nil, fmt.Errorf("%w: %d", ErrPadding, padding)
+	}
+
+	size := block.BlockSize()
+
+	return &model{block: block, final: final, padding: padding, x: make([]byte, size), buf: make([]byte, size)}, nil
+}
+
+// implementation of the hash.Hash
+
+// Reset resets the hash.Hash to its initial state.
+func (r *model) Reset() {
+	clear(r.x)
+	r.bufLen = 0
+	r.written = false
+}
+
+// Size returns the number of bytes Sum will return.
+func (r *model) Size() int { return len(r.x) }
+
+// BlockSize returns the hash's underlying block size.
+func (r *model) BlockSize() int { return len(r.x) }
+
+// Write appends the data to the digest.
+func (r *model) Write(p []byte) (int, error) {
+	n := len(p)
+	r.written = r.written || n > 0
+
+	for len(p) > 0 {
+		x := copy(r.buf[r.bufLen:], p)
+		r.bufLen += x
+		p = p[x:]
+
+		if r.bufLen == len(r.buf) {
+			r.encrypt(r.x, r.buf)
+			r.bufLen = 0
+		}
+	}
+
+	return n, nil
+}
+
+// Sum appends the current checksum to b and returns the resulting slice, it does not change the underlying state.
+func (r *model) Sum(b []byte) []byte {
+	x := make([]byte, len(r.x))
+	copy(x, r.x)
+
+	// the zero padding adds nothing to the full blocks, except a zero block to the empty message
+	if r.padding == BitPadding || r.bufLen > 0 || !r.written {
+		last := make([]byte, len(r.buf))
+		copy(last, r.buf[:r.bufLen])
+
+		if r.padding == BitPadding {
+			last[r.bufLen] = 0x80
+		}
+
+		r.encrypt(x, last)
+	}
+
+	if r.final != nil {
+		r.final.Decrypt(x, x)
+		r.block.Encrypt(x, x)
+	}
+
+	return append(b, x...)
+}
+
+// private
+
+// encrypt chains the block into the state x.
+func (r *model) encrypt(x, block []byte) {
+	subtle.XORBytes(x, x, block)
+	r.block.Encrypt(x, x)
+}
@@ -0,0 +1,98 @@
+package cbcmac
+
+import (
+	"crypto/aes"
+	"crypto/des"
+	"encoding/hex"
+	"errors"
+	"hash"
+	"testing"
+)
+
+func TestVectors(t *testing.T) {
+	msg, _ := hex.DecodeString("6bc1bee22e409f96e93d7e117393172aae2d8a571e03ac9c9eb76fac45af8e51" +
+		"30c81c46a35ce411e5fbc1191a0a52eff69f2445df4f9b17ad2b417be66c3710")
+	aes128, _ := aes.NewCipher([]byte{0x2b, 0x7e, 0x15, 0x16, 0x28, 0xae, 0xd2, 0xa6, 0xab, 0xf7, 0x15, 0x88, 0x09, 0xcf, 0x4f, 0x3c})
+
+	// the last blocks of the CBC encryption of OpenSSL of the padded messages
+	vectors := []struct {
+		padding  Padding
+		length   int
+		expected string
+	}{
+		{ZeroPadding, 0, "7df76b0c1ab899b33e42f047b91b546f"},
+		{ZeroPadding, 16, "3ad77bb40d7a3660a89ecaf32466ef97"},
+		{ZeroPadding, 40, "07d192e3e6f099edcc39fde6d09c762d"},
+		{BitPadding, 16, "0539bda30b3f7634466a75d98418bf65"},
+		{BitPadding, 40, "a5260f98f1abf2b27562ed5fc1fbeb8d"},
+	}
+
+	for _, v := range vectors {
+		h, err := New(aes128, v.padding)
+		if err != nil {
+			t.Fatalf("padding %d failed: %s", v.padding, err)
+		}
+
+		h.Write(msg[:v.length/3])
+		h.Write(msg[v.length/3 : v.length])
+
+		if got := hex.EncodeToString(h.Sum(nil)); got != v.expected {
+			t.Errorf("CBC-MAC with padding %d of %d bytes is wrong:\n\texpected \"%s\"\n\tgot \"%s\"", v.padding, v.length, v.expected, got)
+		}
+	}
+}
+
+func TestRetail(t *testing.T) {
+	block1, _ := des.NewCipher([]byte{0x01, 0x23, 0x45, 0x67, 0x89, 0xab, 0xcd, 0xef})
+	block2, _ := des.NewCipher([]byte{0xfe, 0xdc, 0xba, 0x98, 0x76, 0x54, 0x32, 0x10})
+	msg := []byte("Now is the time for all ")
+
+	// the examples of ANSI X9.9 and X9.19
+	cases := []struct {
+		name     string
+		h        func() (hash.Hash, error)
+		expected string
+	}{
+		{"CBC-MAC", func() (hash.Hash, error) { return New(block1, ZeroPadding) }, "70a30640cc76dd8b"},
+		{"retail MAC", func() (hash.Hash, error) { return NewRetail(block1, block2, ZeroPadding) }, "a1c72e74ea3fa9b6"},
+	}
+
+	for _, c := range cases {
+		h, err := c.h()
+		if err != nil {
+			t.Fatalf("%s failed: %s", c.name, err)
+		}
+
+		h.Write(msg)
+
+		if got := hex.EncodeToString(h.Sum(nil)); got != c.expected {
+			t.Errorf("%s is wrong:\n\texpected \"%s\"\n\tgot \"%s\"", c.name, c.expected, got)
+		}
+	}
+}
+
+func TestErrors(t *testing.T) {
+	block1, _ := des.NewCipher(make([]byte, 8))
+	block2, _ := aes.NewCipher(make([]byte, 16))
+
+	if _, err := NewRetail(block1, block2, ZeroPadding); !errors.Is(err, ErrBlockSize) {
+		t.Errorf("different block sizes are accepted: %v", err)
+	}
+
+	if _, err := New(block1, 2); !errors.Is(err, ErrPadding) {
+		t.Errorf("unknown padding is accepted: %v", err)
+	}
+}
+
+func BenchmarkAES128(b *testing.B) {
+	block, _ := aes.NewCipher(make([]byte, 16))
+	h, _ := New(block, BitPadding)
+	data := make([]byte, 8192)
+
+	b.SetBytes(int64(len(data)))
+	for i := 0; i < b.N; i++ {
+		h.Reset()
+		h.Write(data)
+		h.Sum(nil)
+	}
+}
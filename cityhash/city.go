@@ -0,0 +1,241 @@
+package cityhash
+
+import (
+	"encoding/binary"
+	"math/bits"
+)
+
+// fetch64 reads a little-endian 64-bit word.
+func fetch64(p []byte) uint64 { return binary.LittleEndian.Uint64(p) }
+
+// fetch32 reads a little-endian 32-bit word.
+func fetch32(p []byte) uint64 { return uint64(binary.LittleEndian.Uint32(p)) }
+
+// rotate rotates right by s bits.
+func rotate(v uint64, s int) uint64 { return bits.RotateLeft64(v, -s) }
+
+// shiftMix mixes the high bits into the low bits.
+func shiftMix(v uint64) uint64 { return v ^ (v >> 47) }
+
+// hashLen16 hashes 128 bits into 64 bits.
+func hashLen16(u, v uint64) uint64 { return hashLen16Mul(u, v, kMul) }
+
+// hashLen16Mul hashes 128 bits into 64 bits with the multiplier.
+func hashLen16Mul(u, v, mul uint64) uint64 {
+	a := (u ^ v) * mul
+	a ^= a >> 47
+	b := (v ^ a) * mul
+	b ^= b >> 47
+
+	return b * mul
+}
+
+func hashLen0to16(p []byte) uint64 {
+	n := uint64(len(p))
+
+	switch {
+	case n >= 8:
+		mul := k2 + n*2
+		a := fetch64(p) + k2
+		b := fetch64(p[n-8:])
+		c := rotate(b, 37)*mul + a
+		d := (rotate(a, 25) + b) * mul
+
+		return hashLen16Mul(c, d, mul)
+	case n >= 4:
+		mul := k2 + n*2
+		return hashLen16Mul(n+fetch32(p)<<3, fetch32(p[n-4:]), mul)
+	case n > 0:
+		y := uint32(p[0]) + uint32(p[n>>1])<<8
+		z := uint32(n) + uint32(p[n-1])<<2
+
+		return shiftMix(uint64(y)*k2^uint64(z)*k0) * k2
+	}
+
+	return k2
+}
+
+func hashLen17to32(p []byte) uint64 {
+	n := uint64(len(p))
+	mul := k2 + n*2
+	a := fetch64(p) * k1
+	b := fetch64(p[8:])
+	c := fetch64(p[n-8:]) * mul
+	d := fetch64(p[n-16:]) * k2
+
+	return hashLen16Mul(rotate(a+b, 43)+rotate(c, 30)+d, a+rotate(b+k2, 18)+c, mul)
+}
+
+func hashLen33to64(p []byte) uint64 {
+	n := uint64(len(p))
+	mul := k2 + n*2
+	a := fetch64(p) * k2
+	b := fetch64(p[8:])
+	c := fetch64(p[n-24:])
+	d := fetch64(p[n-32:])
+	e := fetch64(p[16:]) * k2
+	f := fetch64(p[24:]) * 9
+	g := fetch64(p[n-8:])
+	h := fetch64(p[n-16:]) * mul
+
+	u := rotate(a+g, 43) + (rotate(b, 30)+c)*9
+	v := ((a + g) ^ d) + f + 1
+	w := bits.ReverseBytes64((u+v)*mul) + h
+	x := rotate(e+f, 42) + c
+	y := (bits.ReverseBytes64((v+w)*mul) + g) * mul
+	z := e + f + c
+	a = bits.ReverseBytes64((x+z)*mul+y) + b
+	b = shiftMix((z+a)*mul+d+h) * mul
+
+	return b + x
+}
+
+// weakHashLen32WithSeeds returns a 16-byte hash of 32 bytes and two seeds.
+func weakHashLen32WithSeeds(p []byte, a, b uint64) (uint64, uint64) {
+	w, x, y, z := fetch64(p), fetch64(p[8:]), fetch64(p[16:]), fetch64(p[24:])
+
+	a += w
+	b = rotate(b+a+z, 21)
+	c := a
+	a += x
+	a += y
+	b += rotate(a, 44)
+
+	return a + z, b + c
+}
+
+// hash64 returns the CityHash64 of p.
+func hash64(p []byte) uint64 {
+	n := uint64(len(p))
+
+	switch {
+	case n <= 16:
+		return hashLen0to16(p)
+	case n <= 32:
+		return hashLen17to32(p)
+	case n <= 64:
+		return hashLen33to64(p)
+	}
+
+	x := fetch64(p[n-40:])
+	y := fetch64(p[n-16:]) + fetch64(p[n-56:])
+	z := hashLen16(fetch64(p[n-48:])+n, fetch64(p[n-24:]))
+	v1, v2 := weakHashLen32WithSeeds(p[n-64:], n, z)
+	w1, w2 := weakHashLen32WithSeeds(p[n-32:], y+k1, x)
+	x = x*k1 + fetch64(p)
+
+	for rest := (n - 1) &^ 63; rest != 0; rest -= 64 {
+		x = rotate(x+y+v1+fetch64(p[8:]), 37) * k1
+		y = rotate(y+v2+fetch64(p[48:]), 42) * k1
+		x ^= w2
+		y += v1 + fetch64(p[40:])
+		z = rotate(z+w1, 33) * k1
+		v1, v2 = weakHashLen32WithSeeds(p, v2*k1, x+w1)
+		w1, w2 = weakHashLen32WithSeeds(p[32:], z+w2, y+fetch64(p[16:]))
+		z, x = x, z
+		p = p[64:]
+	}
+
+	return hashLen16(hashLen16(v1, w1)+shiftMix(y)*k1+z, hashLen16(v2, w2)+x)
+}
+
+// cityMurmur returns the 128-bit hash of an input shorter than 128 bytes with the seed.
+func cityMurmur(p []byte, seedLo, seedHi uint64) (uint64, uint64) {
+	n := uint64(len(p))
+	a, b := seedLo, seedHi
+
+	var c, d uint64
+	if n <= 16 {
+		a = shiftMix(a*k1) * k1
+		c = b*k1 + hashLen0to16(p)
+
+		if n >= 8 {
+			d = shiftMix(a + fetch64(p))
+		} else {
+			d = shiftMix(a + c)
+		}
+	} else {
+		c = hashLen16(fetch64(p[n-8:])+k1, a)
+		d = hashLen16(b+n, c+fetch64(p[n-16:]))
+		a += d
+
+		for ; len(p) > 16; p = p[16:] {
+			a ^= shiftMix(fetch64(p)*k1) * k1
+			a *= k1
+			b ^= a
+			c ^= shiftMix(fetch64(p[8:])*k1) * k1
+			c *= k1
+			d ^= c
+		}
+	}
+
+	a = hashLen16(a, c)
+	b = hashLen16(d, b)
+
+	return a ^ b, hashLen16(b, a)
+}
+
+// hash128WithSeed returns the CityHash128WithSeed of p as its low and high halves.
+func hash128WithSeed(p []byte, seedLo, seedHi uint64) (uint64, uint64) {
+	n := len(p)
+	if n < 128 {
+		return cityMurmur(p, seedLo, seedHi)
+	}
+
+	x, y, z := seedLo, seedHi, uint64(n)*k1
+	v1 := rotate(y^k1, 49)*k1 + fetch64(p)
+	v2 := rotate(v1, 42)*k1 + fetch64(p[8:])
+	w1 := rotate(y+z, 35)*k1 + x
+	w2 := rotate(x+fetch64(p[88:]), 53) * k1
+
+	// the loop consumes 128 bytes per iteration as two halves of 64 bytes
+	off := 0
+	for ; n >= 128; n -= 128 {
+		for i := 0; i < 2; i++ {
+			s := p[off:]
+			x = rotate(x+y+v1+fetch64(s[8:]), 37) * k1
+			y = rotate(y+v2+fetch64(s[48:]), 42) * k1
+			x ^= w2
+			y += v1 + fetch64(s[40:])
+			z = rotate(z+w1, 33) * k1
+			v1, v2 = weakHashLen32WithSeeds(s, v2*k1, x+w1)
+			w1, w2 = weakHashLen32WithSeeds(s[32:], z+w2, y+fetch64(s[16:]))
+			z, x = x, z
+			off += 64
+		}
+	}
+
+	x += rotate(v1+z, 49) * k0
+	y = y*k0 + rotate(w2, 37)
+	z = z*k0 + rotate(w1, 27)
+	w1 *= 9
+	v1 *= k0
+
+	// the tail of up to 127 bytes is hashed backwards in chunks of 32 bytes,
+	// the last chunk may overlap the bytes already consumed
+	for done := 0; done < n; {
+		done += 32
+		s := p[off+n-done:]
+		y = rotate(x+y, 42)*k0 + v2
+		w1 += fetch64(s[16:])
+		x = x*k0 + w1
+		z += w2 + fetch64(s)
+		w2 += v1
+		v1, v2 = weakHashLen32WithSeeds(s, v1+z, v2)
+		v1 *= k0
+	}
+
+	x = hashLen16(x, v1)
+	y = hashLen16(y+z, w1)
+
+	return hashLen16(x+v2, w2) + y, hashLen16(x+w2, y+v2)
+}
+
+// hash128 returns the CityHash128 of p as its low and high halves.
+func hash128(p []byte) (uint64, uint64) {
+	if len(p) >= 16 {
+		return hash128WithSeed(p[16:], fetch64(p), fetch64(p[8:])+k0)
+	}
+
+	return hash128WithSeed(p, k0, k1)
+}
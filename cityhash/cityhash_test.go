@@ -0,0 +1,95 @@
+package cityhash
+
+import (
+	"encoding/binary"
+	"testing"
+)
+
+// testData returns the input of the tests of the CityHash reference implementation.
+func testData() []byte {
+	data := make([]byte, 1<<20)
+	a, b := uint64(9), uint64(777)
+
+	for i := range data {
+		a += b
+		b += a
+		a = (a ^ (a >> 41)) * k0
+		b = (b^(b>>41))*k0 + uint64(i)
+		data[i] = byte(b >> 37)
+	}
+
+	return data
+}
+
+func TestReference(t *testing.T) {
+	data := testData()
+	// the CityHash64 and CityHash128 columns of city-test.cc, row i hashes i bytes at offset i*i
+	vectors := []struct {
+		row      int
+		city64   uint64
+		city128L uint64
+		city128H uint64
+	}{
+		{0, 0x9ae16a3b2f90404f, 0x3df09dfc64c09a2b, 0x3cb540c392e51e29},
+		{1, 0x541150e87f415e96, 0xc3cdc41e1df33513, 0x2c138ff2596d42f6},
+		{3, 0xef923a7a1af78eab, 0x2193fb7620cbf23b, 0x8b6a8ff06cda8302},
+		{4, 0x11df592596f41d88, 0x4d09e42f09cc3495, 0x666236631b9f253b},
+		{8, 0xa0f10149a0e538d6, 0x26b6689960ccf81d, 0x55f23b27bb9efd94},
+		{9, 0xfb8d9c70660b910b, 0x98ec31113e5e35d2, 0x5e4aeb853f1b9aa7},
+		{16, 0x3ead5f21d344056, 0xac059617f5906673, 0x94d50d3dcd3069a7},
+		{17, 0x6abbfde37ee03b5b, 0xa4375590b8ae7c82, 0x168fd42f9ecae4ff},
+		{32, 0x782fa1b08b475e7, 0x9a8c431f500ef06e, 0xd848581a580b6c12},
+		{33, 0xc5dc19b876d37a80, 0x7870765b470b2c5d, 0x78a9103ff960d82},
+		{64, 0xe88419922b87176f, 0xd1d44fe99451ef72, 0xec951ba8e51e3545},
+		{65, 0x105191e0ec8f7f60, 0xd3e86ac4f5eccfa4, 0xe5399df2b106ca1},
+		{127, 0xcbaa3cb8f64f54e0, 0x85b8e53f22e19507, 0xbb57137739ca486b},
+		{128, 0xb2e23e8116c2ba9f, 0xadc52dddb76f6e5e, 0x4aad4e925a962b68},
+		{129, 0x8aa77f52d7868eb9, 0xce030d15b5fe2f4, 0x86b4a7a0780c2431},
+		{191, 0x53c1a66d0b13003, 0x57466046cf6896ed, 0x8ac37e0e8b25b0c6},
+		{255, 0x915263c671b28809, 0xd17c928c5342477f, 0x745130b795254ad5},
+		{256, 0x2b67cdd38c307a5e, 0x6531c1fe32bcb417, 0x8c970d8df8cdbeb4},
+		{298, 0x74c0b8a6821faafe, 0x967e970df9673d2a, 0xd465247cffa415c0},
+		// the last row hashes the whole data
+		{-1, 0x5fb5e48ac7b7fa4f, 0x6cc09e60700563e9, 0xd18f23221e964791},
+	}
+
+	for _, v := range vectors {
+		p := data
+		if v.row >= 0 {
+			p = data[v.row*v.row : v.row*v.row+v.row]
+		}
+
+		h64 := New64()
+		h64.Write(p)
+
+		if got := h64.Sum64(); got != v.city64 {
+			t.Errorf("CityHash64 of row %d is wrong:\n\texpected \"%x\"\n\tgot \"%x\"", v.row, v.city64, got)
+		}
+
+		h128 := New128()
+		h128.Write(p)
+		sum := h128.Sum(nil)
+
+		if hi, lo := binary.BigEndian.Uint64(sum), binary.BigEndian.Uint64(sum[8:]); hi != v.city128H || lo != v.city128L {
+			t.Errorf("CityHash128 of row %d is wrong:\n\texpected \"%x%x\"\n\tgot \"%x\"", v.row, v.city128H, v.city128L, sum)
+		}
+	}
+}
+
+func BenchmarkCityHash64(b *testing.B) {
+	data := make([]byte, 8192)
+
+	b.SetBytes(int64(len(data)))
+	for i := 0; i < b.N; i++ {
+		hash64(data)
+	}
+}
+
+func BenchmarkCityHash128(b *testing.B) {
+	data := make([]byte, 8192)
+
+	b.SetBytes(int64(len(data)))
+	for i := 0; i < b.N; i++ {
+		hash128(data)
+	}
+}
@@ -0,0 +1,18 @@
+package cityhash
+
+const (
+	// Size64 is the size of a CityHash64 checksum in bytes.
+	Size64 = 8
+	// Size128 is the size of a CityHash128 checksum in bytes.
+	Size128 = 16
+	// BlockSize64 is the block size of CityHash64 in bytes.
+	BlockSize64 = 64
+	// BlockSize128 is the block size of CityHash128 in bytes.
+	BlockSize128 = 128
+
+	k0 = 0xc3a5c85c97cb3127
+	k1 = 0xb492b66fbe98f273
+	k2 = 0x9ae16a3b2f90404f
+
+	kMul = 0x9ddfea08eb382d69
+)
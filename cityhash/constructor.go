@@ -0,0 +1,18 @@
+// Package cityhash implements the CityHash64 and CityHash128 non-cryptographic hash algorithms of CityHash 1.1.
+//
+// CityHash is not an incremental hash, the models keep the written data and hash it on Sum.
+// The CityHash64 checksum is the big-endian form of the 64-bit value,
+// and the CityHash128 checksum is the big-endian form of the high then the low 64 bits.
+package cityhash
+
+import "hash"
+
+// New64 creates a new CityHash64 hash.Hash64.
+func New64() hash.Hash64 {
+	return &model{size: Size64}
+}
+
+// New128 creates a new CityHash128 hash.Hash.
+func New128() hash.Hash {
+	return &model{size: Size128}
+}
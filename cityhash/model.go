@@ -0,0 +1,53 @@
+package cityhash
+
+import "encoding/binary"
+
+// model represents a structure for the CityHash hash.Hash.
+type model struct {
+	size int
+	data []byte
+}
+
+// implementation of the hash.Hash
+
+// Reset resets the hash.Hash to its initial state.
+func (r *model) Reset() {
+	r.data = r.data[:0]
+}
+
+// Size returns the number of bytes Sum will return.
+func (r *model) Size() int { return r.size }
+
+// BlockSize returns the hash's underlying block size.
+func (r *model) BlockSize() int {
+	if r.size == Size128 {
+		return BlockSize128
+	}
+
+	return BlockSize64
+}
+
+// Write appends the data to the digest.
+func (r *model) Write(p []byte) (int, error) {
+	r.data = append(r.data, p...)
+	return len(p), nil
+}
+
+// Sum appends the current hash to b and returns the resulting slice.
+// It does not change the underlying hash state.
+func (r *model) Sum(b []byte) []byte {
+	if r.size == Size64 {
+		return binary.BigEndian.AppendUint64(b, hash64(r.data))
+	}
+
+	lo, hi := hash128(r.data)
+
+	return binary.BigEndian.AppendUint64(binary.BigEndian.AppendUint64(b, hi), lo)
+}
+
+// implementation of the hash.Hash64
+
+// Sum64 returns the CityHash64 of the data.
+func (r *model) Sum64() uint64 {
+	return hash64(r.data)
+}
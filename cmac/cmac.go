@@ -0,0 +1,112 @@
+// Package cmac implements CMAC of NIST SP 800-38B, the MAC of the block ciphers of 64 or 128 bits like AES-CMAC of
+// RFC 4493 and the TDEA CMAC of the payment industry.
+package cmac
+
+import (
+	"crypto/cipher"
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"hash"
+)
+
+// ErrBlockSize is returned when the block size of the cipher is not 8 or 16 bytes.
+var ErrBlockSize = errors.New("cmac: invalid block size")
+
+// model represents a structure for the CMAC hash.Hash.
+type model struct {
+	block  cipher.Block
+	k1, k2 []byte
+	x      []byte
+	buf    []byte
+	bufLen int
+}
+
+// New creates a new CMAC hash.Hash of the block cipher, the checksums are a block long.
+func New(block cipher.Block) (hash.Hash, error) {
+	var rb byte
+
+	switch block.BlockSize() {
+	case 8:
+		rb = 0x1b
+	case 16:
+		rb = 0x87
+	default:
+		return nil, fmt.Errorf("%w: %d", ErrBlockSize, block.BlockSize())
+	}
+
+	size := block.BlockSize()
+	r := &model{block: block, k1: make([]byte, size), k2: make([]byte, size), x: make([]byte, size), buf: make([]byte, size)}
+
+	// the subkeys are the doubles of the cipher of the zero block in the field of the block size
+	block.Encrypt(r.k1, r.k1)
+	double(r.k1, r.k1, rb)
+	double(r.k2, r.k1, rb)
+
+	return r, nil
+}
+
+// implementation of the hash.Hash
+
+// Reset resets the hash.Hash to its initial state.
+func (r *model) Reset() {
+	clear(r.x)
+	r.bufLen = 0
+}
+
+// Size returns the number of bytes Sum will return.
+func (r *model) Size() int { return len(r.x) }
+
+// BlockSize returns the hash's underlying block size.
+func (r *model) BlockSize() int { return len(r.x) }
+
+// Write appends the data to the digest, the last block is kept until Sum, since it is xored with a subkey.
+func (r *model) Write(p []byte) (int, error) {
+	n := len(p)
+
+	for len(p) > 0 {
+		if r.bufLen == len(r.buf) {
+			subtle.XORBytes(r.x, r.x, r.buf)
+			r.block.Encrypt(r.x, r.x)
+			r.bufLen = 0
+		}
+
+		x := copy(r.buf[r.bufLen:], p)
+		r.bufLen += x
+		p = p[x:]
+	}
+
+	return n, nil
+}
+
+// Sum appends the current checksum to b and returns the resulting slice, it does not change the underlying state.
+func (r *model) Sum(b []byte) []byte {
+	last := make([]byte, len(r.buf))
+	copy(last, r.buf[:r.bufLen])
+
+	if r.bufLen == len(r.buf) {
+		subtle.XORBytes(last, last, r.k1)
+	} else {
+		last[r.bufLen] = 0x80
+		subtle.XORBytes(last, last, r.k2)
+	}
+
+	subtle.XORBytes(last, last, r.x)
+	r.block.Encrypt(last, last)
+
+	return append(b, last...)
+}
+
+// private
+
+// double sets dst to the double of src in the field of the block size with the reduction byte rb.
+func double(dst, src []byte, rb byte) {
+	carry := src[0] >> 7
+
+	for i := 0; i < len(src)-1; i++ {
+		dst[i] = src[i]<<1 | src[i+1]>>7
+	}
+
+	// the reduction is constant-time by the mask of the carry
+	dst[len(src)-1] = src[len(src)-1]<<1 ^ -carry&rb
+}
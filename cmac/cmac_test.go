@@ -0,0 +1,84 @@
+package cmac
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/des"
+	"encoding/hex"
+	"errors"
+	"testing"
+)
+
+func TestVectors(t *testing.T) {
+	msg, _ := hex.DecodeString("6bc1bee22e409f96e93d7e117393172aae2d8a571e03ac9c9eb76fac45af8e51" +
+		"30c81c46a35ce411e5fbc1191a0a52eff69f2445df4f9b17ad2b417be66c3710")
+	aes128, _ := hex.DecodeString("2b7e151628aed2a6abf7158809cf4f3c")
+	aes256, _ := hex.DecodeString("603deb1015ca71be2b73aef0857d77811f352c073b6108d72d9810a30914dff4")
+	tdea, _ := hex.DecodeString("8aa83bf8cbda10620bc1bf19fbb6cd58bc313d4a371ca8b5")
+
+	// the examples of RFC 4493 and SP 800-38B
+	vectors := []struct {
+		name     string
+		key      []byte
+		length   int
+		expected string
+	}{
+		{"AES-128", aes128, 0, "bb1d6929e95937287fa37d129b756746"},
+		{"AES-128", aes128, 16, "070a16b46b4d4144f79bdd9dd04a287c"},
+		{"AES-128", aes128, 40, "dfa66747de9ae63030ca32611497c827"},
+		{"AES-128", aes128, 64, "51f0bebf7e3b9d92fc49741779363cfe"},
+		{"AES-256", aes256, 64, "e1992190549f6ed5696a2c056c315410"},
+		{"TDEA", tdea, 0, "b7a688e122ffaf95"},
+		{"TDEA", tdea, 16, "286d394673448197"},
+		{"TDEA", tdea, 20, "743ddbe0ce2dc2ed"},
+		{"TDEA", tdea, 32, "33e6b1092400eae5"},
+	}
+
+	for _, v := range vectors {
+		var block cipher.Block
+		if v.name == "TDEA" {
+			block, _ = des.NewTripleDESCipher(v.key)
+		} else {
+			block, _ = aes.NewCipher(v.key)
+		}
+
+		h, err := New(block)
+		if err != nil {
+			t.Fatalf("%s failed: %s", v.name, err)
+		}
+
+		// the message is written in two parts to test the buffering
+		h.Write(msg[:v.length/3])
+		h.Write(msg[v.length/3 : v.length])
+
+		if got := hex.EncodeToString(h.Sum(nil)); got != v.expected {
+			t.Errorf("CMAC-%s of %d bytes is wrong:\n\texpected \"%s\"\n\tgot \"%s\"", v.name, v.length, v.expected, got)
+		}
+	}
+}
+
+func TestErrors(t *testing.T) {
+	if _, err := New(wideBlock{}); !errors.Is(err, ErrBlockSize) {
+		t.Errorf("block size of 32 bytes is accepted: %v", err)
+	}
+}
+
+func BenchmarkAES128(b *testing.B) {
+	block, _ := aes.NewCipher(make([]byte, 16))
+	h, _ := New(block)
+	data := make([]byte, 8192)
+
+	b.SetBytes(int64(len(data)))
+	for i := 0; i < b.N; i++ {
+		h.Reset()
+		h.Write(data)
+		h.Sum(nil)
+	}
+}
+
+// wideBlock is a block cipher of 32 bytes.
+type wideBlock struct{}
+
+func (wideBlock) BlockSize() int          { return 32 }
+func (wideBlock) Encrypt(dst, src []byte) { copy(dst, src) }
+func (wideBlock) Decrypt(dst, src []byte) { copy(dst, src) }
@@ -0,0 +1,199 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/highdeger/vexillum"
+	"golang.org/x/crypto/blake2b"
+	"golang.org/x/crypto/blake2s"
+	"golang.org/x/crypto/ripemd160"
+	"golang.org/x/crypto/sha3"
+	"hash"
+	"hashed"
+	"hashed/keccak"
+	"hashed/ripemd"
+	"os"
+	"runtime"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var (
+	benchSizes   = vexillum.String('S', "sizes", "comma separated message sizes with optional K and M suffixes, used in bench", "16,1K,64K,1M")
+	benchCompare = vexillum.Bool('c', "compare", "also benchmark the in-house models against their reference implementations, used in bench", false)
+	benchJson    = vexillum.Bool('j', "json", "output results in json, used in bench", false)
+)
+
+// benchBudget is the number of bytes hashed to measure each message size, at least one message is hashed.
+const benchBudget = 32 << 20
+
+// benchKey and benchNonce are used for the keyed hash types and gmac when no key or nonce is given.
+var (
+	benchKey   = []byte("46cf18a9b447991b450cad3facf5937e")
+	benchNonce = []byte("b61f4c998037")
+)
+
+// benchReferences are the in-house models which have an equivalent implementation in x/crypto.
+var benchReferences = []struct {
+	hashType  string
+	model     func() hash.Hash
+	reference func() hash.Hash
+}{
+	{"keccak-256", keccak.New256, sha3.NewLegacyKeccak256},
+	{"keccak-512", keccak.New512, sha3.NewLegacyKeccak512},
+	{"ripemd-160", ripemd.New160, ripemd160.New},
+	{"blake2b-512", hashed.Blake2BType512, func() hash.Hash { h, _ := blake2b.New512(nil); return h }},
+	{"blake2s-256", hashed.Blake2SType256, func() hash.Hash { h, _ := blake2s.New256(nil); return h }},
+}
+
+// benchResult represents the result of benchmarking a hash type with a message size.
+type benchResult struct {
+	HashType       string  `json:"hash_type"`
+	Implementation string  `json:"implementation"`
+	Size           int     `json:"size"`
+	Iterations     int     `json:"iterations"`
+	NsPerOp        int64   `json:"ns_per_op"`
+	MBPerSecond    float64 `json:"mb_per_s"`
+	AllocsPerOp    int64   `json:"allocs_per_op"`
+	BytesPerOp     int64   `json:"bytes_per_op"`
+}
+
+// bench prints the throughput of the hash types, -t accepts a comma separated list or "all".
+func bench() {
+	sizes := parseSizes(*benchSizes)
+
+	hashTypes := strings.Split(*hashType, ",")
+	if *hashType == "all" {
+		hashTypes = hashed.HashTypes()
+	}
+
+	var results []benchResult
+
+	for _, t := range hashTypes {
+		options := hashed.DefaultOptions(t).
+			SetKey(parseKey()).
+			SetFunctionName([]byte(*functionName)).
+			SetCustomization([]byte(*customization)).
+			SetSubType(*subType).
+			SetSeed(parseSeed()).
+			SetSize(parseLength()).
+			SetSalt([]byte(*salt)).
+			SetPersonalization([]byte(*personalization)).
+			SetTree(parseTree()).
+			SetNonce(parseIV())
+		if *key == "" && hashed.IsKeyed(t) {
+			options.SetKey(benchKey)
+			if size := hashed.KeySize(t); size > 0 {
+				options.SetKey(benchKey[:size])
+			}
+		}
+
+		if *iv == "" {
+			options.SetNonce(benchNonce)
+		}
+
+		newHash := func() hash.Hash { return hashed.New(options) }
+		for _, size := range sizes {
+			results = append(results, benchmark(t, "hashed", newHash, size))
+		}
+	}
+
+	if *benchCompare {
+		for _, r := range benchReferences {
+			for _, size := range sizes {
+				results = append(results,
+					benchmark(r.hashType, "hashed model", r.model, size),
+					benchmark(r.hashType, "x/crypto", r.reference, size))
+			}
+		}
+	}
+
+	if *benchJson {
+		encoder := json.NewEncoder(os.Stdout)
+		encoder.SetIndent("", "  ")
+
+		if err := encoder.Encode(results); err != nil {
+			fatalError("cannot encode results: %s", err)
+		}
+
+		return
+	}
+
+	fmt.Printf("%-16s %-14s %10s %12s %12s %10s %10s\n", "type", "implementation", "size", "ns/op", "MB/s", "allocs/op", "B/op")
+	for _, r := range results {
+		fmt.Printf("%-16s %-14s %10d %12d %12.2f %10d %10d\n", r.HashType, r.Implementation, r.Size, r.NsPerOp, r.MBPerSecond, r.AllocsPerOp, r.BytesPerOp)
+	}
+}
+
+// benchmark measures writing size bytes to a new hash.Hash and computing the sum, repeated until benchBudget bytes
+// are hashed.
+func benchmark(hashType, implementation string, newHash func() hash.Hash, size int) benchResult {
+	h := newHash()
+	data := make([]byte, size)
+	sum := make([]byte, 0, h.Size())
+	iterations := max(1, benchBudget/size)
+
+	run := func(n int) {
+		for i := 0; i < n; i++ {
+			h.Reset()
+			_, _ = h.Write(data)
+			sum = h.Sum(sum[:0])
+		}
+	}
+
+	// the first run warms up the caches and the lazy allocations
+	run(1)
+
+	var before, after runtime.MemStats
+	runtime.GC()
+	runtime.ReadMemStats(&before)
+
+	start := time.Now()
+	run(iterations)
+	elapsed := time.Since(start)
+
+	runtime.ReadMemStats(&after)
+
+	var mbPerSecond float64
+	if elapsed > 0 {
+		mbPerSecond = float64(size) * float64(iterations) / 1e6 / elapsed.Seconds()
+	}
+
+	return benchResult{
+		HashType:       hashType,
+		Implementation: implementation,
+		Size:           size,
+		Iterations:     iterations,
+		NsPerOp:        elapsed.Nanoseconds() / int64(iterations),
+		MBPerSecond:    mbPerSecond,
+		AllocsPerOp:    int64(after.Mallocs-before.Mallocs) / int64(iterations),
+		BytesPerOp:     int64(after.TotalAlloc-before.TotalAlloc) / int64(iterations),
+	}
+}
+
+// parseSizes parses comma separated sizes like "16,1K,64M".
+func parseSizes(s string) []int {
+	var sizes []int
+
+	for _, field := range strings.Split(s, ",") {
+		field = strings.ToUpper(strings.TrimSpace(field))
+		multiplier := 1
+
+		switch {
+		case strings.HasSuffix(field, "K"):
+			multiplier = 1 << 10
+		case strings.HasSuffix(field, "M"):
+			multiplier = 1 << 20
+		}
+
+		n, err := strconv.Atoi(strings.TrimRight(field, "KM"))
+		if err != nil || n <= 0 {
+			fatalError("invalid size: %s", field)
+		}
+
+		sizes = append(sizes, n*multiplier)
+	}
+
+	return sizes
+}
@@ -0,0 +1,59 @@
+package main
+
+import (
+	"encoding/hex"
+	"fmt"
+	"hashed/ethereum"
+	"strings"
+)
+
+// selector prints the function selector of the signature in the input.
+func selector() {
+	s, err := ethereum.Selector(string(readInput()))
+	if err != nil {
+		fatalError("cannot compute selector: %s", err)
+	}
+
+	fmt.Println("0x" + hex.EncodeToString(s[:]))
+}
+
+// topic prints the topic of the event signature in the input.
+func topic() {
+	t, err := ethereum.Topic(string(readInput()))
+	if err != nil {
+		fatalError("cannot compute topic: %s", err)
+	}
+
+	fmt.Println("0x" + hex.EncodeToString(t[:]))
+}
+
+// eip55 prints the checksummed form of the address in the input.
+func eip55() {
+	address, err := ethereum.ParseAddress(strings.TrimSpace(string(readInput())))
+	if err != nil {
+		fatalError("cannot parse address: %s", err)
+	}
+
+	fmt.Println(ethereum.ChecksumAddress(address))
+}
+
+// eip191 prints the hash of the input as a personal message.
+func eip191() {
+	h := ethereum.PersonalMessageHash(readInput())
+	fmt.Println("0x" + hex.EncodeToString(h[:]))
+}
+
+// eip712 prints the hash of the typed data in the input json.
+func eip712() {
+	typedData, err := ethereum.ParseTypedData(readInput())
+	if err != nil {
+		fatalError("cannot parse typed data: %s", err)
+	}
+
+	h, err := typedData.Hash()
+	if err != nil {
+		fatalError("cannot hash typed data: %s", err)
+	}
+
+	fmt.Println("0x" + hex.EncodeToString(h[:]))
+}
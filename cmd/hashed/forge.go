@@ -0,0 +1,50 @@
+package main
+
+import (
+	"encoding/hex"
+	"fmt"
+	"github.com/highdeger/vexillum"
+	"os"
+)
+
+var (
+	forgeOffset = vexillum.Int('o', "offset", "offset of the forged bytes, -1 means the end of input", -1)
+	forgeTarget = vexillum.String('T', "target", "target checksum in hex, used in forge", "")
+	forgePatch  = vexillum.Bool('p', "patch", "overwrite bytes at the offset instead of inserting, used in forge", false)
+	forgeOutput = vexillum.String('O', "output", "file to write the forged input to, used in forge", "")
+)
+
+// forge prints the bytes which make the checksum of the input equal to the target.
+func forge() {
+	data := readInput()
+
+	target, err := hex.DecodeString(*forgeTarget)
+	if err != nil {
+		fatalError("invalid target checksum: %s", err)
+	}
+
+	offset := *forgeOffset
+	if offset == -1 {
+		offset = len(data)
+	}
+
+	h := newHash()
+
+	var forged, result []byte
+	if *forgePatch {
+		forged = h.ForgePatch(data, offset, target)
+		result = append(append(append(result, data[:offset]...), forged...), data[offset+len(forged):]...)
+	} else {
+		forged = h.ForgeInsert(data, offset, target)
+		result = append(append(append(result, data[:offset]...), forged...), data[offset:]...)
+	}
+
+	if *forgeOutput != "" {
+		err = os.WriteFile(*forgeOutput, result, 0644)
+		if err != nil {
+			fatalError("cannot write output file: %s", err)
+		}
+	}
+
+	fmt.Println(hex.EncodeToString(forged))
+}
@@ -0,0 +1,154 @@
+package main
+
+import (
+	"encoding/hex"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"hashed/base58"
+	"hashed/blake2"
+)
+
+// readInput returns the content of the input file if it is set, otherwise the input text.
+func readInput() []byte {
+	if *file == "" && *hexInput {
+		data, err := hex.DecodeString(*input)
+		if err != nil {
+			fatalError("cannot decode hex input: %s", err)
+		}
+
+		return data
+	}
+
+	if *file == "" {
+		return []byte(*input)
+	}
+
+	data, err := os.ReadFile(*file)
+	if err != nil {
+		fatalError("cannot read input file: %s", err)
+	}
+
+	return data
+}
+
+// encodeSum returns the checksum in the output encoding.
+func encodeSum(sum []byte) string {
+	switch *encoding {
+	case "hex":
+		return hex.EncodeToString(sum)
+	case "base58":
+		return base58.Encode(sum)
+	case "base58check":
+		if *versionByte < 0 || *versionByte > 0xff {
+			fatalError("version byte is out of range: %d", *versionByte)
+		}
+
+		return base58.CheckEncode(byte(*versionByte), sum)
+	}
+
+	fatalError("unknown encoding: %s", *encoding)
+
+	return ""
+}
+
+// parseSeed returns the value of the seed flag.
+func parseSeed() uint64 {
+	n, err := strconv.ParseUint(*seed, 0, 64)
+	if err != nil {
+		fatalError("invalid seed: %s", *seed)
+	}
+
+	return n
+}
+
+// parseLength returns the digest size in bytes of the length flag, which is in bits.
+func parseLength() int {
+	if *length < 0 || *length%8 != 0 {
+		fatalError("invalid length, it must be a non-negative multiple of 8: %d", *length)
+	}
+
+	return *length / 8
+}
+
+// parseKey returns the bytes of the key flag, which is hex encoded if the hex key flag is set.
+func parseKey() []byte {
+	if !*hexKey {
+		return []byte(*key)
+	}
+
+	b, err := hex.DecodeString(*key)
+	if err != nil {
+		fatalError("cannot decode key: %s", err)
+	}
+
+	return b
+}
+
+// parseIV returns the bytes of the hex encoded iv flag.
+func parseIV() []byte {
+	b, err := hex.DecodeString(*iv)
+	if err != nil {
+		fatalError("cannot decode iv: %s", err)
+	}
+
+	return b
+}
+
+// parseTree returns the BLAKE2 tree parameters of the tree flag, a comma separated list of name=value pairs.
+func parseTree() blake2.Tree {
+	var t blake2.Tree
+
+	if *tree == "" {
+		return t
+	}
+
+	for _, field := range strings.Split(*tree, ",") {
+		name, value, _ := strings.Cut(field, "=")
+
+		if name == "last" {
+			t.LastNode = value == "" || value == "true"
+			continue
+		}
+
+		bitSize := 8
+		switch name {
+		case "leaf":
+			bitSize = 32
+		case "offset":
+			bitSize = 64
+		}
+
+		n, err := strconv.ParseUint(value, 0, bitSize)
+		if err != nil {
+			fatalError("invalid tree parameter: %s", field)
+		}
+
+		switch name {
+		case "fanout":
+			t.Fanout = uint8(n)
+		case "depth":
+			t.MaxDepth = uint8(n)
+		case "leaf":
+			t.LeafLength = uint32(n)
+		case "offset":
+			t.NodeOffset = n
+		case "node-depth":
+			t.NodeDepth = uint8(n)
+		case "inner":
+			t.InnerLength = uint8(n)
+		default:
+			fatalError("unknown tree parameter: %s", name)
+		}
+	}
+
+	return t
+}
+
+// fatalError prints the error message and exits with status 1.
+func fatalError(format string, a ...any) {
+	fmt.Printf(format+"\n", a...)
+	os.Exit(1)
+}
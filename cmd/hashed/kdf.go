@@ -0,0 +1,129 @@
+package main
+
+import (
+	"fmt"
+	"hash"
+
+	"github.com/highdeger/vexillum"
+
+	"hashed"
+	"hashed/kdf"
+	"hashed/kmac"
+)
+
+var (
+	kdfName = vexillum.String('g', "kdf", "key derivation function used in kdf: hkdf, hkdf-extract, hkdf-expand, counter, feedback, double-pipeline or one-step", "hkdf")
+	label   = vexillum.String('L', "label", "label used in the counter, feedback and double-pipeline kdfs", "")
+	info    = vexillum.String('n', "info", "info used in hkdf, context used in the counter, feedback and double-pipeline kdfs, or fixed info used in the one-step kdf", "")
+	iv      = vexillum.String('I', "iv", "hex encoded initial value used in the feedback kdf, or the nonce used in gmac", "")
+)
+
+// deriveKey prints the key derived from the input, which is the pseudorandom key in hkdf-expand and the secret
+// otherwise. The kmac types select KMAC in the counter, feedback, double-pipeline and one-step kdfs, the other
+// hash types are used with hmac, or directly in the one-step kdf without the use-hmac flag.
+func deriveKey() {
+	secret := readInput()
+	size := parseLength()
+
+	var (
+		key []byte
+		err error
+	)
+
+	switch *kdfName {
+	case "hkdf":
+		newHash := hmacHashFunc()
+		key, err = kdf.HKDF(newHash, secret, []byte(*salt), []byte(*info), defaultSize(size, newHash().Size()))
+	case "hkdf-extract":
+		key = kdf.Extract(hmacHashFunc(), secret, []byte(*salt))
+	case "hkdf-expand":
+		newHash := hmacHashFunc()
+		key, err = kdf.Expand(newHash, secret, []byte(*info), defaultSize(size, newHash().Size()))
+	case "counter", "feedback", "double-pipeline":
+		key, err = keyBased(secret, size)
+	case "one-step":
+		key, err = oneStep(secret, size)
+	default:
+		fatalError("unknown kdf: %s", *kdfName)
+	}
+
+	if err != nil {
+		fatalError("cannot derive the key: %s", err)
+	}
+
+	fmt.Println(encodeSum(key))
+}
+
+// keyBased returns the key of the size derived by the kdf of SP 800-108 of the kdf flag.
+func keyBased(secret []byte, size int) ([]byte, error) {
+	params := kdf.Params{Label: []byte(*label), Context: []byte(*info)}
+
+	switch *kdfName {
+	case "counter":
+		params.Mode = kdf.Counter
+	case "feedback":
+		params.Mode = kdf.Feedback
+
+		params.IV = parseIV()
+	default:
+		params.Mode = kdf.DoublePipeline
+	}
+
+	switch *hashType {
+	case "kmac-128":
+		params.PRF, size = kdf.KMAC128, defaultSize(size, kmac.Size128)
+	case "kmac-256":
+		params.PRF, size = kdf.KMAC256, defaultSize(size, kmac.Size256)
+	default:
+		newHash := hmacHashFunc()
+		params.PRF, size = kdf.HMAC(newHash), defaultSize(size, newHash().Size())
+	}
+
+	return kdf.KBKDF(secret, size, &params)
+}
+
+// oneStep returns the key of the size derived by the one-step kdf of SP 800-56C, with the salt in the kmac types
+// and in hmac.
+func oneStep(secret []byte, size int) ([]byte, error) {
+	var h func() hash.Hash
+
+	switch {
+	case *hashType == "kmac-128" || *hashType == "kmac-256":
+		bits, defaultLength := 128, kmac.Size128
+		if *hashType == "kmac-256" {
+			bits, defaultLength = 256, kmac.Size256
+		}
+
+		size = defaultSize(size, defaultLength)
+
+		var err error
+		if h, err = kdf.SaltedKMAC(bits, []byte(*salt), size); err != nil {
+			return nil, err
+		}
+	case *hMacUse:
+		h = kdf.SaltedHMAC(hmacHashFunc(), []byte(*salt))
+	default:
+		h = hmacHashFunc()
+	}
+
+	return kdf.OneStep(h, secret, []byte(*info), defaultSize(size, h().Size()))
+}
+
+// hmacHashFunc returns the hash function of the hash type flag which can be used in hmac.
+func hmacHashFunc() func() hash.Hash {
+	newHash, err := hashed.HMacHashFunc(*hashType)
+	if err != nil {
+		fatalError("%s", err)
+	}
+
+	return newHash
+}
+
+// defaultSize returns the size, or the default size if it is zero.
+func defaultSize(size, defaultSize int) int {
+	if size == 0 {
+		return defaultSize
+	}
+
+	return size
+}
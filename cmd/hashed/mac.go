@@ -0,0 +1,80 @@
+package main
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/highdeger/vexillum"
+
+	"hashed"
+)
+
+var (
+	verifyTag = vexillum.String('y', "verify", "hex encoded tag of the tag length verified in sum with hmac or a keyed hash type, exits with an error if it does not match", "")
+	tagLength = vexillum.Int('G', "tag-length", "tag length in bits which the mac is truncated to in sum, at least 32, 0 for the full tag", 0)
+)
+
+// authenticate prints the tag of the input truncated to the tag length, or verifies the tag of the verify flag and
+// exits with an error if it does not match.
+func authenticate() {
+	mac := newMac()
+	r := strings.NewReader(string(readInput()))
+
+	if *verifyTag == "" {
+		fmt.Println(encodeSum(mac.Tag(r)))
+		return
+	}
+
+	tag, err := hex.DecodeString(*verifyTag)
+	if err != nil {
+		fatalError("cannot decode tag: %s", err)
+	}
+
+	err = mac.Verify(r, tag)
+	if errors.Is(err, hashed.ErrMacMismatch) {
+		fatalError("tag does not match")
+	} else if err != nil {
+		fatalError("cannot verify the tag: %s", err)
+	}
+
+	fmt.Println("tag matches")
+}
+
+// newMac creates a new hashed.Mac from the flags, hmac with the hmac key if it is used, otherwise the keyed hash type
+// with the key.
+func newMac() *hashed.Mac {
+	var (
+		mac *hashed.Mac
+		err error
+	)
+
+	if *hMacUse {
+		mac, err = hashed.NewHMac(newOptions(), []byte(*hMackey))
+	} else {
+		mac, err = hashed.NewMac(newOptions())
+	}
+
+	if err != nil {
+		fatalError("%s", err)
+	}
+
+	if *tagLength < 0 || *tagLength%8 != 0 {
+		fatalError("invalid tag length, it must be a non-negative multiple of 8: %d", *tagLength)
+	}
+
+	if err = mac.Truncate(*tagLength / 8); err != nil {
+		fatalError("%s", err)
+	}
+
+	if *verbose {
+		mac.Verbose()
+	}
+
+	if *debug {
+		mac.Debug()
+	}
+
+	return mac
+}
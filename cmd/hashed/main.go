@@ -8,32 +8,89 @@ import (
 )
 
 var (
-	hashType      = vexillum.String('t', "type", "hash type", "md5")
-	input         = vexillum.String('i', "input", "input text", "")
-	hMacUse       = vexillum.Bool('m', "use-hmac", "use hmac", false)
-	hMackey       = vexillum.String('k', "hmac-key", "hmac key", "")
-	key           = vexillum.String('K', "key", "key used in kmac and blake", "")
-	functionName  = vexillum.String('F', "function-name", "function name used in cshake", "")
-	customization = vexillum.String('C', "customization", "customization used in cshake and kmac", "")
-	verbose       = vexillum.Bool('v', "verbose", "verbose output", false)
-	debug         = vexillum.Bool('d', "debug", "debug output", false)
-	subType       = vexillum.String('s', "sub-type", "hash sub type", "")
+	command         = vexillum.WildString("command", "command to run: sum, forge, bench, selector, topic, eip55, eip191, eip712, bsdsum (sum -r), sysvsum (sum -s), b2sum, passwd, crypt, kdf", "sum")
+	hashType        = vexillum.String('t', "type", "hash type, or a composition like sha2-256(ripemd-160(x))", "md5")
+	input           = vexillum.String('i', "input", "input text", "")
+	file            = vexillum.String('f', "file", "input file, used instead of input text", "")
+	hMacUse         = vexillum.Bool('m', "use-hmac", "use hmac", false)
+	hMackey         = vexillum.String('k', "hmac-key", "hmac key", "")
+	key             = vexillum.String('K', "key", "key used in kmac, skein, siphash, the blake2 mac types, cmac, gmac, cbc-mac, the retail mac and poly1305", "")
+	functionName    = vexillum.String('F', "function-name", "function name used in cshake", "")
+	customization   = vexillum.String('C', "customization", "customization used in cshake, kmac, skein and ascon-cxof128", "")
+	verbose         = vexillum.Bool('v', "verbose", "verbose output", false)
+	debug           = vexillum.Bool('d', "debug", "debug output", false)
+	subType         = vexillum.String('s', "sub-type", "hash sub type, or the padding of cbc-mac and the retail mac: zero or bit", "")
+	hexInput        = vexillum.Bool('x', "hex-input", "input text is hex encoded, like a public key", false)
+	hexKey          = vexillum.Bool('X', "hex-key", "key is hex encoded, like the keys of cmac, gmac, cbc-mac, the retail mac and poly1305", false)
+	encoding        = vexillum.String('e', "encoding", "output encoding: hex, base58 or base58check", "hex")
+	versionByte     = vexillum.Int('V', "version-byte", "version byte prepended to the checksum in base58check encoding", 0)
+	seed            = vexillum.String('z', "seed", "seed used in xxhash and murmur3, decimal or 0x prefixed hex", "0")
+	length          = vexillum.Int('l', "length", "digest length in bits used in skein, ascon-xof128, ascon-cxof128, xoodyak, b2sum and the variable blake2 types, or the derived key length in kdf, 0 for the default", 0)
+	salt            = vexillum.String('a', "salt", "salt used in blake2b, blake2s, blake2xb, blake2xs, their mac types, hkdf and the one-step kdf", "")
+	personalization = vexillum.String('P', "personalization", "personalization used in blake2b, blake2s, blake2xb, blake2xs and their mac types", "")
+	tree            = vexillum.String('r', "tree", "tree parameters used in blake2b, blake2s and their mac types, like fanout=2,depth=3,leaf=4096,offset=1,node-depth=0,inner=64,last", "")
 )
 
 func main() {
 	vexillum.OnBareRun(func() {})
 	vexillum.Parse()
 
-	h := hashed.New(hashed.DefaultOptions(*hashType).
-		SetKey([]byte(*key)).
-		SetFunctionName([]byte(*functionName)).
-		SetCustomization([]byte(*customization)).
-		SetSubType(*subType))
+	switch *command {
+	case "sum":
+		sum()
+	case "forge":
+		forge()
+	case "bench":
+		bench()
+	case "selector":
+		selector()
+	case "topic":
+		topic()
+	case "eip55":
+		eip55()
+	case "eip191":
+		eip191()
+	case "eip712":
+		eip712()
+	case "bsdsum":
+		bsdsum()
+	case "sysvsum":
+		sysvsum()
+	case "b2sum":
+		b2sum()
+	case "passwd":
+		passwd()
+	case "crypt":
+		crypt()
+	case "kdf":
+		deriveKey()
+	default:
+		fatalError("unknown command: %s", *command)
+	}
+}
+
+// sum prints the checksum of the input, or the tag of a mac when it is truncated or verified.
+func sum() {
+	if *verifyTag != "" || *tagLength != 0 {
+		authenticate()
+		return
+	}
+
+	h := newHash()
 
 	if *hMacUse {
 		h.HMac([]byte(*hMackey))
 	}
 
+	r := strings.NewReader(string(readInput()))
+
+	fmt.Println(encodeSum(h.GetSum(r)))
+}
+
+// newHash creates a new hashed.Hash from the flags.
+func newHash() *hashed.Hash {
+	h := hashed.New(newOptions())
+
 	if *verbose {
 		h.Verbose()
 	}
@@ -42,7 +99,20 @@ func main() {
 		h.Debug()
 	}
 
-	r := strings.NewReader(*input)
+	return h
+}
 
-	fmt.Println(h.GetSumHex(r, false))
+// newOptions creates the hashed.Options of the flags.
+func newOptions() *hashed.Options {
+	return hashed.DefaultOptions(*hashType).
+		SetKey(parseKey()).
+		SetFunctionName([]byte(*functionName)).
+		SetCustomization([]byte(*customization)).
+		SetSubType(*subType).
+		SetSeed(parseSeed()).
+		SetSize(parseLength()).
+		SetSalt([]byte(*salt)).
+		SetPersonalization([]byte(*personalization)).
+		SetTree(parseTree()).
+		SetNonce(parseIV())
 }
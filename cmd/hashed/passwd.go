@@ -0,0 +1,107 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"os/signal"
+
+	"github.com/highdeger/vexillum"
+
+	"hashed/password"
+)
+
+var (
+	scheme         = vexillum.String('w', "scheme", "password hashing scheme used in passwd: argon2id, argon2i, argon2d, scrypt, bcrypt, pbkdf2-<hash type>, md5-crypt, apr1, sha256-crypt, sha512-crypt or yescrypt", "argon2id")
+	schemeSettings = vexillum.String('W', "scheme-settings", "password hashing settings used in passwd, like m=65536,t=3,p=4, ln=15,r=8,p=1, cost=12, i=600000 or rounds=5000", "")
+	cryptString    = vexillum.String('H', "crypt-string", "password hash verified in crypt, in a crypt(3) format like $6$salt$hash or the PHC string format", "")
+)
+
+// stdin reads the passwords from the standard input when it is not a terminal.
+var stdin = bufio.NewReader(os.Stdin)
+
+// passwd reads a password from the terminal and prints its encoded hash, the password is asked twice.
+func passwd() {
+	params, err := password.New(*scheme, *schemeSettings)
+	if err != nil {
+		fatalError("%s", err)
+	}
+
+	pw := readPassword("Password: ")
+	if isTerminal(int(os.Stdin.Fd())) && !bytes.Equal(pw, readPassword("Retype password: ")) {
+		fatalError("passwords do not match")
+	}
+
+	encoded, err := password.Hash(pw, params)
+	if err != nil {
+		fatalError("cannot hash the password: %s", err)
+	}
+
+	fmt.Println(encoded)
+}
+
+// crypt reads a password from the terminal and verifies it against the crypt string,
+// it exits with an error if the password does not match.
+func crypt() {
+	if _, err := password.Parse(*cryptString); err != nil {
+		fatalError("%s", err)
+	}
+
+	err := password.Verify(readPassword("Password: "), *cryptString)
+	if errors.Is(err, password.ErrMismatch) {
+		fatalError("password does not match")
+	} else if err != nil {
+		fatalError("cannot verify the password: %s", err)
+	}
+
+	fmt.Println("password matches")
+}
+
+// readPassword prints the prompt and reads a line from the terminal without echo,
+// or from the standard input if it is not a terminal. The echo is restored before any exit.
+func readPassword(prompt string) []byte {
+	fd := int(os.Stdin.Fd())
+	restore := func() {}
+
+	if isTerminal(fd) {
+		fmt.Fprint(os.Stderr, prompt)
+
+		restoreEcho, err := disableEcho(fd)
+		if err != nil {
+			fatalError("cannot disable the echo of the terminal: %s", err)
+		}
+
+		// an interrupt exits after restoring the echo, the goroutine ends when the channel is closed
+		interrupts := make(chan os.Signal, 1)
+		signal.Notify(interrupts, os.Interrupt)
+
+		go func() {
+			if _, ok := <-interrupts; ok {
+				restoreEcho()
+				fmt.Fprintln(os.Stderr)
+				os.Exit(130)
+			}
+		}()
+
+		restore = func() {
+			signal.Stop(interrupts)
+			close(interrupts)
+			restoreEcho()
+			fmt.Fprintln(os.Stderr)
+		}
+	} else if !echoControl {
+		fmt.Fprintln(os.Stderr, "warning: the echo of the terminal cannot be disabled on this platform, a typed password is visible")
+		fmt.Fprint(os.Stderr, prompt)
+	}
+
+	line, err := stdin.ReadBytes('\n')
+	restore()
+
+	if err != nil && len(line) == 0 {
+		fatalError("cannot read the password: %s", err)
+	}
+
+	return bytes.TrimRight(line, "\r\n")
+}
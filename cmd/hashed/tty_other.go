@@ -0,0 +1,18 @@
+//go:build !(linux || darwin || dragonfly || freebsd || netbsd || openbsd)
+
+package main
+
+import "errors"
+
+// echoControl reports whether the echo of the terminal can be disabled on this platform.
+const echoControl = false
+
+// isTerminal reports whether the file descriptor is a terminal, the terminals are not supported on this platform.
+func isTerminal(int) bool {
+	return false
+}
+
+// disableEcho is not supported on this platform.
+func disableEcho(int) (func(), error) {
+	return nil, errors.New("terminal is not supported")
+}
@@ -0,0 +1,70 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"hashed"
+	"hashed/blake2"
+	"hashed/unixsum"
+	"io"
+	"os"
+)
+
+// bsdsum prints the BSD checksum and the 1024-byte block count of the input, like sum -r.
+func bsdsum() {
+	h := unixsum.NewBSD()
+	writeInput(h)
+
+	fmt.Println(fmt.Sprintf("%05d %5d", h.Sum16(), h.Blocks()) + fileSuffix())
+}
+
+// sysvsum prints the System V checksum and the 512-byte block count of the input, like sum -s.
+func sysvsum() {
+	h := unixsum.NewSysV()
+	writeInput(h)
+
+	fmt.Println(fmt.Sprintf("%d %d", h.Sum16(), h.Blocks()) + fileSuffix())
+}
+
+// b2sum prints the BLAKE2b checksum and the name of the input, like b2sum, the length flag sets the digest length.
+func b2sum() {
+	h := hashed.Blake2B(&blake2.Params{Size: parseLength()})
+	writeInput(h)
+
+	name := *file
+	if name == "" {
+		name = "-"
+	}
+
+	fmt.Printf("%x  %s\n", h.Sum(nil), name)
+}
+
+// writeInput streams the input file, or the input text, to w.
+func writeInput(w io.Writer) {
+	var r io.Reader
+
+	if *file == "" {
+		r = bytes.NewReader(readInput())
+	} else {
+		f, err := os.Open(*file)
+		if err != nil {
+			fatalError("cannot read input file: %s", err)
+		}
+		defer f.Close()
+
+		r = f
+	}
+
+	if _, err := io.Copy(w, r); err != nil {
+		fatalError("cannot read input file: %s", err)
+	}
+}
+
+// fileSuffix returns the name of the input file preceded by a space, as printed by sum, or nothing for input text.
+func fileSuffix() string {
+	if *file == "" {
+		return ""
+	}
+
+	return " " + *file
+}
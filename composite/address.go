@@ -0,0 +1,33 @@
+package composite
+
+import (
+	"errors"
+
+	"hashed/base58"
+)
+
+// ErrPublicKey is returned when a public key is not an uncompressed SEC1 public key, with or without its 0x04 prefix.
+var ErrPublicKey = errors.New("composite: public key must be 64 bytes, or 65 bytes with the 0x04 prefix")
+
+// BitcoinAddress returns the Base58Check address of the HASH160 of the public key with the version byte,
+// like VersionP2PKH for the pay-to-public-key-hash addresses.
+func BitcoinAddress(publicKey []byte, version byte) string {
+	digest := Sum160(publicKey)
+	return base58.CheckEncode(version, digest[:])
+}
+
+// EthereumAddress returns the address of the uncompressed public key, which is the last 20 bytes of its KECCAK-256.
+func EthereumAddress(publicKey []byte) ([AddressSize]byte, error) {
+	var r [AddressSize]byte
+
+	switch {
+	case len(publicKey) == publicKeySize && publicKey[0] == 0x04:
+	case len(publicKey) == publicKeySize-1:
+	default:
+		return r, ErrPublicKey
+	}
+
+	copy(r[:], sum(NewAddress(), publicKey))
+
+	return r, nil
+}
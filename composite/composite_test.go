@@ -0,0 +1,57 @@
+package composite
+
+import (
+	"encoding/hex"
+	"testing"
+)
+
+// generator is the uncompressed public key of the private key 1, the generator point of secp256k1.
+const generator = "0479be667ef9dcbbac55a06295ce870b07029bfcdb2dce28d959f2815b16f81798" +
+	"483ada7726a3c4655da4fbfc0e1108a8fd17b448a68554199c47d08ffb10d4b8"
+
+func TestBitcoinAddress(t *testing.T) {
+	vectors := []struct {
+		publicKey string
+		expected  string
+	}{
+		{"0279be667ef9dcbbac55a06295ce870b07029bfcdb2dce28d959f2815b16f81798", "1BgGZ9tcN4rm9KBzDn7KprQz87SZ26SAMH"},
+		{generator, "1EHNa6Q4Jz2uvNExL497mE43ikXhwF6kZm"},
+	}
+
+	for _, v := range vectors {
+		publicKey, _ := hex.DecodeString(v.publicKey)
+
+		if address := BitcoinAddress(publicKey, VersionP2PKH); address != v.expected {
+			t.Errorf("address of '%s' is wrong:\n\texpected \"%s\"\n\tgot \"%s\"", v.publicKey, v.expected, address)
+		}
+	}
+}
+
+func TestEthereumAddress(t *testing.T) {
+	expected := "7e5f4552091a69125d5dfcb7b8c2659029395bdf"
+	publicKey, _ := hex.DecodeString(generator)
+
+	// with and without the 0x04 prefix
+	for _, key := range [][]byte{publicKey, publicKey[1:]} {
+		address, err := EthereumAddress(key)
+		if err != nil || hex.EncodeToString(address[:]) != expected {
+			t.Errorf("address of %d bytes key is wrong:\n\texpected \"%s\"\n\tgot \"%x\" (%v)", len(key), expected, address, err)
+		}
+	}
+
+	if _, err := EthereumAddress(publicKey[:33]); err != ErrPublicKey {
+		t.Errorf("public key error is wrong:\n\texpected \"%v\"\n\tgot \"%v\"", ErrPublicKey, err)
+	}
+}
+
+func TestSum(t *testing.T) {
+	sum160 := Sum160([]byte("hello"))
+	if expected := "b6a9c8c230722b7c748331a8b450f05566dc7d0f"; hex.EncodeToString(sum160[:]) != expected {
+		t.Errorf("hash160 is wrong:\n\texpected \"%s\"\n\tgot \"%x\"", expected, sum160)
+	}
+
+	sum256 := Sum256([]byte("hello"))
+	if expected := "9595c9df90075148eb06860365df33584b75bff782a510c6cd4883a419833d50"; hex.EncodeToString(sum256[:]) != expected {
+		t.Errorf("hash256 is wrong:\n\texpected \"%s\"\n\tgot \"%x\"", expected, sum256)
+	}
+}
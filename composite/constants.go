@@ -0,0 +1,18 @@
+package composite
+
+const (
+	// Size160 is the size of a HASH160 checksum in bytes.
+	Size160 = 20
+	// Size256 is the size of a HASH256 (double SHA-256) checksum in bytes.
+	Size256 = 32
+	// AddressSize is the size of an Ethereum address in bytes.
+	AddressSize = 20
+
+	// VersionP2PKH is the Base58Check version byte of the Bitcoin pay-to-public-key-hash addresses.
+	VersionP2PKH = 0x00
+	// VersionP2SH is the Base58Check version byte of the Bitcoin pay-to-script-hash addresses.
+	VersionP2SH = 0x05
+
+	// publicKeySize is the size of an uncompressed SEC1 public key with its 0x04 prefix.
+	publicKeySize = 65
+)
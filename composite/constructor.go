@@ -0,0 +1,66 @@
+// Package composite implements the hash functions built by chaining other hash functions,
+// like HASH160 and HASH256 of Bitcoin, and the address derivations of Bitcoin and Ethereum.
+//
+// Compositions can also be described by a specification, see Parse.
+package composite
+
+import (
+	"crypto/sha256"
+	"hash"
+
+	"hashed/ripemd"
+)
+
+// New creates a new hash.Hash which hashes the data with inner and its digest with outer.
+func New(outer, inner func() hash.Hash) hash.Hash {
+	return NewIterated(outer, inner(), 1)
+}
+
+// NewIterated creates a new hash.Hash which hashes the data with inner and then applies outer rounds times to the digest.
+func NewIterated(outer func() hash.Hash, inner hash.Hash, rounds int) hash.Hash {
+	r := &model{outer: outer, inner: inner, rounds: rounds}
+
+	r.size = inner.Size()
+	if rounds > 0 {
+		r.size = outer().Size()
+	}
+
+	return r
+}
+
+// NewConcat creates a new hash.Hash whose digest is the concatenation of the digests of the parts.
+func NewConcat(parts ...hash.Hash) hash.Hash {
+	return &concatModel{parts: parts}
+}
+
+// New160 creates a new HASH160 hash.Hash, the RIPEMD-160 of the SHA-256 of the data.
+func New160() hash.Hash { return New(ripemd.New160, sha256.New) }
+
+// New256 creates a new HASH256 hash.Hash, the double SHA-256 of the data.
+func New256() hash.Hash { return New(sha256.New, sha256.New) }
+
+// NewAddress creates a new hash.Hash of Ethereum addresses, the last 20 bytes of the KECCAK-256 of a public key.
+// The 0x04 prefix of an uncompressed SEC1 public key is dropped.
+func NewAddress() hash.Hash { return new(addressModel) }
+
+// Sum160 returns the HASH160 checksum of the data.
+func Sum160(data []byte) [Size160]byte {
+	var r [Size160]byte
+	copy(r[:], sum(New160(), data))
+
+	return r
+}
+
+// Sum256 returns the HASH256 checksum of the data.
+func Sum256(data []byte) [Size256]byte {
+	var r [Size256]byte
+	copy(r[:], sum(New256(), data))
+
+	return r
+}
+
+// sum returns the checksum of the data by h.
+func sum(h hash.Hash, data []byte) []byte {
+	_, _ = h.Write(data)
+	return h.Sum(nil)
+}
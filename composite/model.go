@@ -0,0 +1,187 @@
+package composite
+
+import (
+	"hash"
+
+	"hashed/keccak"
+)
+
+// model represents a structure for the composite hash.Hash, which applies the outer hash rounds times
+// to the digest of the inner hash.
+type model struct {
+	outer  func() hash.Hash
+	inner  hash.Hash
+	rounds int
+	size   int
+}
+
+// implementation of the hash.Hash
+
+// Reset resets the hash.Hash to its initial state.
+func (r *model) Reset() {
+	r.inner.Reset()
+}
+
+// Size returns the number of bytes Sum will return.
+func (r *model) Size() int { return r.size }
+
+// BlockSize returns the hash's underlying block size, which is the block size of the inner hash.
+func (r *model) BlockSize() int { return r.inner.BlockSize() }
+
+// Write appends the data to the digest.
+func (r *model) Write(p []byte) (int, error) {
+	return r.inner.Write(p)
+}
+
+// Sum appends the current hash to b and returns the resulting slice.
+// It does not change the underlying hash state.
+func (r *model) Sum(b []byte) []byte {
+	digest := r.inner.Sum(nil)
+
+	for i := 0; i < r.rounds; i++ {
+		digest = sum(r.outer(), digest)
+	}
+
+	return append(b, digest...)
+}
+
+// concatModel represents a structure for the hash.Hash whose digest is the concatenation of the digests of its parts.
+type concatModel struct {
+	parts []hash.Hash
+}
+
+// implementation of the hash.Hash
+
+// Reset resets the hash.Hash to its initial state.
+func (r *concatModel) Reset() {
+	for _, h := range r.parts {
+		h.Reset()
+	}
+}
+
+// Size returns the number of bytes Sum will return.
+func (r *concatModel) Size() int {
+	size := 0
+	for _, h := range r.parts {
+		size += h.Size()
+	}
+
+	return size
+}
+
+// BlockSize returns the hash's underlying block size, which is the block size of the first part.
+func (r *concatModel) BlockSize() int { return r.parts[0].BlockSize() }
+
+// Write appends the data to the digest.
+func (r *concatModel) Write(p []byte) (int, error) {
+	for _, h := range r.parts {
+		_, _ = h.Write(p)
+	}
+
+	return len(p), nil
+}
+
+// Sum appends the current hash to b and returns the resulting slice.
+// It does not change the underlying hash state.
+func (r *concatModel) Sum(b []byte) []byte {
+	for _, h := range r.parts {
+		b = h.Sum(b)
+	}
+
+	return b
+}
+
+// inputModel represents a structure for the hash.Hash whose digest is the data itself.
+// It is used where the data is concatenated to other digests, so it keeps all the data in memory.
+type inputModel struct {
+	data []byte
+}
+
+// implementation of the hash.Hash
+
+// Reset resets the hash.Hash to its initial state.
+func (r *inputModel) Reset() {
+	r.data = r.data[:0]
+}
+
+// Size returns the number of bytes Sum will return, which is the length of the data.
+func (r *inputModel) Size() int { return len(r.data) }
+
+// BlockSize returns the hash's underlying block size.
+func (r *inputModel) BlockSize() int { return 1 }
+
+// Write appends the data to the digest.
+func (r *inputModel) Write(p []byte) (int, error) {
+	r.data = append(r.data, p...)
+	return len(p), nil
+}
+
+// Sum appends the current hash to b and returns the resulting slice.
+// It does not change the underlying hash state.
+func (r *inputModel) Sum(b []byte) []byte {
+	return append(b, r.data...)
+}
+
+// addressModel represents a structure for the Ethereum address hash.Hash.
+// It holds back the first 65 bytes to know if they are an uncompressed public key with the 0x04 prefix.
+type addressModel struct {
+	keccak  hash.Hash
+	head    [publicKeySize]byte
+	headLen int
+}
+
+// implementation of the hash.Hash
+
+// Reset resets the hash.Hash to its initial state.
+func (r *addressModel) Reset() {
+	r.keccak = nil
+	r.headLen = 0
+}
+
+// Size returns the number of bytes Sum will return.
+func (r *addressModel) Size() int { return AddressSize }
+
+// BlockSize returns the hash's underlying block size.
+func (r *addressModel) BlockSize() int { return keccak.BlockSize256 }
+
+// Write appends the data to the digest.
+func (r *addressModel) Write(p []byte) (int, error) {
+	n := len(p)
+
+	if r.keccak == nil {
+		x := copy(r.head[r.headLen:], p)
+		r.headLen += x
+		p = p[x:]
+
+		if len(p) == 0 {
+			return n, nil
+		}
+
+		// longer than a public key, so all the data is hashed
+		r.keccak = keccak.New256()
+		_, _ = r.keccak.Write(r.head[:])
+	}
+
+	_, _ = r.keccak.Write(p)
+
+	return n, nil
+}
+
+// Sum appends the current hash to b and returns the resulting slice.
+// It does not change the underlying hash state.
+func (r *addressModel) Sum(b []byte) []byte {
+	var digest []byte
+
+	if r.keccak != nil {
+		digest = r.keccak.Sum(nil)
+	} else {
+		data := r.head[:r.headLen]
+		if r.headLen == publicKeySize && data[0] == 0x04 {
+			data = data[1:]
+		}
+
+		digest = sum(keccak.New256(), data)
+	}
+
+	return append(b, digest[len(digest)-AddressSize:]...)
+}
@@ -0,0 +1,247 @@
+package composite
+
+import (
+	"errors"
+	"fmt"
+	"hash"
+	"strconv"
+	"strings"
+)
+
+// ErrSpec is returned when a specification cannot be parsed.
+var ErrSpec = errors.New("composite: invalid specification")
+
+// Resolver returns the constructor of the hash type with the name, or nil if there is no such hash type.
+type Resolver func(name string) func() hash.Hash
+
+// IsSpec reports whether the hash type is a specification rather than the name of a hash type.
+func IsSpec(hashType string) bool {
+	return strings.ContainsAny(hashType, "()|")
+}
+
+// Parse parses the specification of a composition and returns the constructor of its hash.Hash.
+//
+// The data is x, a hash type applied to an expression is name(expression),
+// name^N(expression) applies it N times, and expression||expression concatenates the digests, like
+//
+//	sha2-256(ripemd-160(x))
+//	md5(x)||sha1(x)
+//	sha2-256^1000(x)
+//	md5(x||sha1(x))
+//
+// The data itself may only appear inside a hash type, as it is kept in memory where it is concatenated.
+func Parse(spec string, resolve Resolver) (func() hash.Hash, error) {
+	p := &parser{tokens: tokenize(spec), resolve: resolve}
+
+	n, err := p.expression()
+	if err != nil {
+		return nil, err
+	}
+
+	if p.position < len(p.tokens) {
+		return nil, p.errorf("unexpected %q", p.tokens[p.position])
+	}
+
+	if n.hasInput() {
+		return nil, fmt.Errorf("%w: the data must be inside a hash type", ErrSpec)
+	}
+
+	return n.hash, nil
+}
+
+// node represents a node of a parsed specification.
+type node interface {
+	// hash creates a new hash.Hash whose digest is the value of the node for the written data.
+	hash() hash.Hash
+	// hasInput reports whether the value of the node contains the data itself.
+	hasInput() bool
+}
+
+// inputNode represents the data.
+type inputNode struct{}
+
+func (inputNode) hash() hash.Hash { return new(inputModel) }
+
+func (inputNode) hasInput() bool { return true }
+
+// applyNode represents a hash type applied rounds times to the value of its argument.
+type applyNode struct {
+	f        func() hash.Hash
+	rounds   int
+	argument node
+}
+
+func (r applyNode) hash() hash.Hash {
+	// the first round hashes the data directly
+	if _, ok := r.argument.(inputNode); ok {
+		return NewIterated(r.f, r.f(), r.rounds-1)
+	}
+
+	return NewIterated(r.f, r.argument.hash(), r.rounds)
+}
+
+func (applyNode) hasInput() bool { return false }
+
+// concatNode represents the concatenation of the values of its parts.
+type concatNode struct {
+	parts []node
+}
+
+func (r concatNode) hash() hash.Hash {
+	parts := make([]hash.Hash, len(r.parts))
+	for i, part := range r.parts {
+		parts[i] = part.hash()
+	}
+
+	return NewConcat(parts...)
+}
+
+func (r concatNode) hasInput() bool {
+	for _, part := range r.parts {
+		if part.hasInput() {
+			return true
+		}
+	}
+
+	return false
+}
+
+// parser represents a recursive descent parser of specifications.
+type parser struct {
+	tokens   []string
+	position int
+	resolve  Resolver
+}
+
+// expression parses term { "||" term }.
+func (r *parser) expression() (node, error) {
+	var parts []node
+
+	for {
+		n, err := r.term()
+		if err != nil {
+			return nil, err
+		}
+
+		parts = append(parts, n)
+
+		if !r.accept("||") {
+			break
+		}
+	}
+
+	if len(parts) == 1 {
+		return parts[0], nil
+	}
+
+	return concatNode{parts: parts}, nil
+}
+
+// term parses "x", "(" expression ")" or name [ "^" rounds ] "(" expression ")".
+func (r *parser) term() (node, error) {
+	if r.accept("(") {
+		n, err := r.expression()
+		if err != nil {
+			return nil, err
+		}
+
+		return n, r.expect(")")
+	}
+
+	name := r.next()
+	switch name {
+	case "":
+		return nil, r.errorf("unexpected end")
+	case "x":
+		return inputNode{}, nil
+	case "(", ")", "||", "|", "^":
+		return nil, r.errorf("unexpected %q", name)
+	}
+
+	f := r.resolve(name)
+	if f == nil {
+		return nil, fmt.Errorf("%w: unknown hash type %q", ErrSpec, name)
+	}
+
+	rounds := 1
+	if r.accept("^") {
+		var err error
+		if rounds, err = strconv.Atoi(r.next()); err != nil || rounds < 1 {
+			return nil, r.errorf("rounds of %s must be a positive number", name)
+		}
+	}
+
+	if err := r.expect("("); err != nil {
+		return nil, err
+	}
+
+	argument, err := r.expression()
+	if err != nil {
+		return nil, err
+	}
+
+	return applyNode{f: f, rounds: rounds, argument: argument}, r.expect(")")
+}
+
+// next returns the next token and moves to the one after it, or returns an empty string at the end.
+func (r *parser) next() string {
+	if r.position == len(r.tokens) {
+		return ""
+	}
+
+	r.position++
+
+	return r.tokens[r.position-1]
+}
+
+// accept moves to the next token if the current one is the token.
+func (r *parser) accept(token string) bool {
+	if r.position < len(r.tokens) && r.tokens[r.position] == token {
+		r.position++
+		return true
+	}
+
+	return false
+}
+
+// expect moves to the next token if the current one is the token, or returns an error.
+func (r *parser) expect(token string) error {
+	if !r.accept(token) {
+		return r.errorf("expected %q", token)
+	}
+
+	return nil
+}
+
+// errorf returns an error at the current token.
+func (r *parser) errorf(format string, a ...any) error {
+	return fmt.Errorf("%w: %s at token %d", ErrSpec, fmt.Sprintf(format, a...), r.position+1)
+}
+
+// tokenize splits the specification into names, numbers, "(", ")", "||" and "^", ignoring spaces.
+func tokenize(spec string) []string {
+	var tokens []string
+
+	for i := 0; i < len(spec); {
+		switch c := spec[i]; {
+		case c == ' ' || c == '\t':
+			i++
+		case strings.HasPrefix(spec[i:], "||"):
+			tokens = append(tokens, "||")
+			i += 2
+		case strings.IndexByte("()^|", c) >= 0:
+			tokens = append(tokens, string(c))
+			i++
+		default:
+			j := i
+			for j < len(spec) && strings.IndexByte(" \t()^|", spec[j]) < 0 {
+				j++
+			}
+
+			tokens = append(tokens, spec[i:j])
+			i = j
+		}
+	}
+
+	return tokens
+}
@@ -0,0 +1,85 @@
+package composite
+
+import (
+	"crypto/md5"
+	"crypto/sha1"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"hash"
+	"testing"
+)
+
+// resolve resolves the hash types of the tests.
+func resolve(name string) func() hash.Hash {
+	return map[string]func() hash.Hash{
+		"md5":      md5.New,
+		"sha1":     sha1.New,
+		"sha2-256": sha256.New,
+	}[name]
+}
+
+func TestParse(t *testing.T) {
+	data := []byte("abc")
+
+	md5Sum := md5.Sum(data)
+	sha1Sum := sha1.Sum(data)
+	sha256Sum := sha256.Sum256(data)
+	sha256Twice := sha256.Sum256(sha256Sum[:])
+	sha256Thrice := sha256.Sum256(sha256Twice[:])
+	md5OfBoth := md5.Sum(append(append([]byte{}, data...), sha1Sum[:]...))
+
+	vectors := []struct {
+		spec     string
+		expected []byte
+	}{
+		{"md5(x)", md5Sum[:]},
+		{" sha2-256 ( sha2-256 ( x ) ) ", sha256Twice[:]},
+		{"sha2-256^3(x)", sha256Thrice[:]},
+		{"sha2-256^2(sha2-256(x))", sha256Thrice[:]},
+		{"md5(x)||sha1(x)", append(md5Sum[:], sha1Sum[:]...)},
+		{"(md5(x)||sha1(x))", append(md5Sum[:], sha1Sum[:]...)},
+		{"md5(x||sha1(x))", md5OfBoth[:]},
+	}
+
+	for _, v := range vectors {
+		f, err := Parse(v.spec, resolve)
+		if err != nil {
+			t.Fatalf("'%s' cannot be parsed: %s", v.spec, err)
+		}
+
+		h := f()
+		h.Write(data[:1])
+		h.Write(data[1:])
+
+		if output := h.Sum(nil); hex.EncodeToString(output) != hex.EncodeToString(v.expected) {
+			t.Errorf("'%s' is wrong:\n\texpected \"%x\"\n\tgot \"%x\"", v.spec, v.expected, output)
+		}
+
+		if h.Size() != len(v.expected) {
+			t.Errorf("size of '%s' is wrong:\n\texpected %d\n\tgot %d", v.spec, len(v.expected), h.Size())
+		}
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	specs := []string{
+		"",
+		"x",
+		"x||md5(x)",
+		"md5",
+		"md5(x",
+		"md5(x))",
+		"md5()",
+		"md5(x)|sha1(x)",
+		"md5^0(x)",
+		"md5^a(x)",
+		"sha3-256(x)",
+	}
+
+	for _, spec := range specs {
+		if _, err := Parse(spec, resolve); !errors.Is(err, ErrSpec) {
+			t.Errorf("error of '%s' is wrong:\n\texpected \"%v\"\n\tgot \"%v\"", spec, ErrSpec, err)
+		}
+	}
+}
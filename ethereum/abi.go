@@ -0,0 +1,188 @@
+package ethereum
+
+import (
+	"errors"
+	"strings"
+)
+
+// ErrSignature is returned when an ABI signature cannot be parsed.
+var ErrSignature = errors.New("ethereum: invalid signature")
+
+// typeAliases are the ABI types which are replaced by their canonical types in signatures.
+var typeAliases = map[string]string{
+	"uint":   "uint256",
+	"int":    "int256",
+	"fixed":  "fixed128x18",
+	"ufixed": "ufixed128x18",
+	"byte":   "bytes1",
+}
+
+// Selector returns the function selector of the signature, which is the first 4 bytes of the KECCAK-256
+// of its canonical form, like a9059cbb for "transfer(address to, uint amount)".
+func Selector(signature string) ([SelectorSize]byte, error) {
+	var r [SelectorSize]byte
+
+	canonical, err := CanonicalSignature(signature)
+	if err != nil {
+		return r, err
+	}
+
+	digest := Keccak256([]byte(canonical))
+	copy(r[:], digest[:])
+
+	return r, nil
+}
+
+// Topic returns the topic of the event signature, which is the KECCAK-256 of its canonical form.
+func Topic(signature string) ([HashSize]byte, error) {
+	canonical, err := CanonicalSignature(signature)
+	if err != nil {
+		return [HashSize]byte{}, err
+	}
+
+	return Keccak256([]byte(canonical)), nil
+}
+
+// CanonicalSignature returns the canonical form of the function or event signature,
+// which has no spaces, parameter names or indexed keywords, and uses the canonical types.
+func CanonicalSignature(signature string) (string, error) {
+	signature = strings.TrimSpace(signature)
+	signature = strings.TrimPrefix(signature, "function ")
+	signature = strings.TrimPrefix(signature, "event ")
+
+	open := strings.IndexByte(signature, '(')
+	if open < 1 || !strings.HasSuffix(signature, ")") {
+		return "", ErrSignature
+	}
+
+	name := strings.TrimSpace(signature[:open])
+	if strings.ContainsAny(name, " \t") {
+		return "", ErrSignature
+	}
+
+	parameters, err := canonicalParameters(signature[open+1 : len(signature)-1])
+	if err != nil {
+		return "", err
+	}
+
+	return name + "(" + parameters + ")", nil
+}
+
+// private
+
+// canonicalParameters returns the comma separated canonical types of the parameters.
+func canonicalParameters(parameters string) (string, error) {
+	if strings.TrimSpace(parameters) == "" {
+		return "", nil
+	}
+
+	parts, err := splitParameters(parameters)
+	if err != nil {
+		return "", err
+	}
+
+	types := make([]string, len(parts))
+	for i, part := range parts {
+		if types[i], err = canonicalParameter(strings.TrimSpace(part)); err != nil {
+			return "", err
+		}
+	}
+
+	return strings.Join(types, ","), nil
+}
+
+// canonicalParameter returns the canonical type of the parameter, dropping its name and keywords.
+func canonicalParameter(parameter string) (string, error) {
+	if parameter == "" {
+		return "", ErrSignature
+	}
+
+	// a tuple type is everything up to its closing parenthesis and its array suffixes
+	if parameter[0] == '(' {
+		closing := matchingParenthesis(parameter)
+		if closing < 0 {
+			return "", ErrSignature
+		}
+
+		components, err := canonicalParameters(parameter[1:closing])
+		if err != nil {
+			return "", err
+		}
+
+		suffix, _, _ := strings.Cut(parameter[closing+1:], " ")
+
+		return "(" + components + ")" + suffix, nil
+	}
+
+	fields := strings.Fields(parameter)
+	if len(fields) > 3 {
+		return "", ErrSignature
+	}
+
+	return canonicalType(fields[0]), nil
+}
+
+// canonicalType replaces the alias at the beginning of the type, keeping its array suffixes.
+func canonicalType(t string) string {
+	base, suffix := t, ""
+	if i := strings.IndexByte(t, '['); i >= 0 {
+		base, suffix = t[:i], t[i:]
+	}
+
+	if canonical, found := typeAliases[base]; found {
+		base = canonical
+	}
+
+	return base + suffix
+}
+
+// splitParameters splits the parameters at the commas which are not inside tuples.
+func splitParameters(parameters string) ([]string, error) {
+	var (
+		parts []string
+		depth int
+		start int
+	)
+
+	for i := 0; i < len(parameters); i++ {
+		switch parameters[i] {
+		case '(':
+			depth++
+		case ')':
+			depth--
+			if depth < 0 {
+				return nil, ErrSignature
+			}
+		case ',':
+			if depth == 0 {
+				parts = append(parts, parameters[start:i])
+				start = i + 1
+			}
+		}
+	}
+
+	if depth != 0 {
+		return nil, ErrSignature
+	}
+
+	return append(parts, parameters[start:]), nil
+}
+
+// matchingParenthesis returns the index of the parenthesis closing the one at the beginning of s, or -1.
+func matchingParenthesis(s string) int {
+	depth := 0
+
+	for i := 0; i < len(s); i++ {
+		switch s[i] {
+		case '(':
+			depth++
+		case ')':
+			depth--
+			if depth == 0 {
+				return i
+			}
+		}
+	}
+
+	return -1
+}
@@ -0,0 +1,51 @@
+package ethereum
+
+import (
+	"encoding/hex"
+	"errors"
+	"strings"
+)
+
+// ErrChecksum is returned when a mixed-case address does not match its EIP-55 checksum.
+var ErrChecksum = errors.New("ethereum: invalid address checksum")
+
+// ChecksumAddress returns the EIP-55 mixed-case encoding of the address with the 0x prefix.
+// A hex letter is upper case when the corresponding nibble of the KECCAK-256 of the lower-case hex is 8 or more.
+func ChecksumAddress(address [AddressSize]byte) string {
+	lower := []byte(hex.EncodeToString(address[:]))
+	digest := Keccak256(lower)
+
+	for i, c := range lower {
+		nibble := digest[i/2] >> 4
+		if i%2 == 1 {
+			nibble = digest[i/2] & 0x0f
+		}
+
+		if c >= 'a' && nibble >= 8 {
+			lower[i] = c - 'a' + 'A'
+		}
+	}
+
+	return "0x" + string(lower)
+}
+
+// ParseAddress parses the hex address with an optional 0x prefix.
+// The EIP-55 checksum is verified when the address is mixed-case, all lower or all upper case addresses have none.
+func ParseAddress(s string) ([AddressSize]byte, error) {
+	var r [AddressSize]byte
+
+	s = strings.TrimPrefix(strings.TrimPrefix(s, "0x"), "0X")
+	if len(s) != 2*AddressSize {
+		return r, ErrAddress
+	}
+
+	if _, err := hex.Decode(r[:], []byte(s)); err != nil {
+		return r, ErrAddress
+	}
+
+	if s != strings.ToLower(s) && s != strings.ToUpper(s) && ChecksumAddress(r)[2:] != s {
+		return r, ErrChecksum
+	}
+
+	return r, nil
+}
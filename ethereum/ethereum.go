@@ -0,0 +1,35 @@
+// Package ethereum implements the hashing of Ethereum on top of KECCAK-256:
+// function selectors and event topics of ABI signatures, EIP-55 checksummed addresses,
+// EIP-191 signed data and EIP-712 typed structured data.
+package ethereum
+
+import (
+	"errors"
+
+	"hashed/keccak"
+)
+
+const (
+	// AddressSize is the size of an address in bytes.
+	AddressSize = 20
+	// HashSize is the size of a KECCAK-256 hash in bytes.
+	HashSize = keccak.Size256
+	// SelectorSize is the size of a function selector in bytes.
+	SelectorSize = 4
+)
+
+// ErrAddress is returned when an address is not 20 bytes in hex with an optional 0x prefix.
+var ErrAddress = errors.New("ethereum: invalid address")
+
+// Keccak256 returns the KECCAK-256 of the concatenation of the data.
+func Keccak256(data ...[]byte) [HashSize]byte {
+	h := keccak.New256()
+	for _, d := range data {
+		_, _ = h.Write(d)
+	}
+
+	var r [HashSize]byte
+	h.Sum(r[:0])
+
+	return r
+}
@@ -0,0 +1,148 @@
+package ethereum
+
+import (
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestSelector(t *testing.T) {
+	vectors := []struct {
+		signature string
+		expected  string
+	}{
+		{"transfer(address,uint256)", "a9059cbb"},
+		{"function transfer(address to, uint amount)", "a9059cbb"},
+		{"baz(uint32,bool)", "cdcd77c0"},
+	}
+
+	for _, v := range vectors {
+		selector, err := Selector(v.signature)
+		if err != nil || hex.EncodeToString(selector[:]) != v.expected {
+			t.Errorf("selector of '%s' is wrong:\n\texpected \"%s\"\n\tgot \"%x\" (%v)", v.signature, v.expected, selector, err)
+		}
+	}
+}
+
+func TestTopic(t *testing.T) {
+	expected := "ddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef"
+
+	topic, err := Topic("event Transfer(address indexed from, address indexed to, uint value)")
+	if err != nil || hex.EncodeToString(topic[:]) != expected {
+		t.Errorf("topic of Transfer is wrong:\n\texpected \"%s\"\n\tgot \"%x\" (%v)", expected, topic, err)
+	}
+}
+
+func TestCanonicalSignature(t *testing.T) {
+	vectors := []struct {
+		signature string
+		expected  string
+	}{
+		{"f()", "f()"},
+		{"f(uint[] a, (int, bytes32)[2] b, byte c)", "f(uint256[],(int256,bytes32)[2],bytes1)"},
+		{"f((uint,(address,bool)) indexed t)", "f((uint256,(address,bool)))"},
+	}
+
+	for _, v := range vectors {
+		canonical, err := CanonicalSignature(v.signature)
+		if err != nil || canonical != v.expected {
+			t.Errorf("canonical form of '%s' is wrong:\n\texpected \"%s\"\n\tgot \"%s\" (%v)", v.signature, v.expected, canonical, err)
+		}
+	}
+
+	for _, signature := range []string{"", "f", "(uint)", "f(uint", "f(uint))", "f(uint a b c d)"} {
+		if _, err := CanonicalSignature(signature); err != ErrSignature {
+			t.Errorf("error of '%s' is wrong:\n\texpected \"%v\"\n\tgot \"%v\"", signature, ErrSignature, err)
+		}
+	}
+}
+
+func TestChecksumAddress(t *testing.T) {
+	// the test cases of EIP-55, whose checksums happen to be all upper or all lower case for the first ones
+	addresses := []string{
+		"0x52908400098527886E0F7030069857D2E4169EE7",
+		"0x8617E340B3D01FA5F11F306F4090FD50E238070D",
+		"0xde709f2102306220921060314715629080e2fb77",
+		"0x27b1fdb04752bbc536007a920d24acb045561c26",
+		"0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed",
+		"0xfB6916095ca1df60bB79Ce92cE3Ea74c37c5d359",
+		"0xdbF03B407c01E7cD3CBea99509d93f8DDDC8C6FB",
+		"0xD1220A0cf47c7B9Be7A2E6BA89F429762e7b9aDb",
+	}
+
+	for _, expected := range addresses {
+		address, err := ParseAddress(expected)
+		if err != nil {
+			t.Fatalf("'%s' cannot be parsed: %s", expected, err)
+		}
+
+		if output := ChecksumAddress(address); output != expected {
+			t.Errorf("checksum address is wrong:\n\texpected \"%s\"\n\tgot \"%s\"", expected, output)
+		}
+	}
+
+	if _, err := ParseAddress("0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAeD"); err != ErrChecksum {
+		t.Errorf("checksum error is wrong:\n\texpected \"%v\"\n\tgot \"%v\"", ErrChecksum, err)
+	}
+
+	if _, err := ParseAddress("0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeA"); err != ErrAddress {
+		t.Errorf("address error is wrong:\n\texpected \"%v\"\n\tgot \"%v\"", ErrAddress, err)
+	}
+}
+
+func TestPersonalMessageHash(t *testing.T) {
+	expected := "50b2c43fd39106bafbba0da34fc430e1f91e3c96ea2acee2bc34119f92b37750"
+
+	if digest := PersonalMessageHash([]byte("hello")); hex.EncodeToString(digest[:]) != expected {
+		t.Errorf("personal message hash is wrong:\n\texpected \"%s\"\n\tgot \"%x\"", expected, digest)
+	}
+}
+
+func TestTypedData(t *testing.T) {
+	// the example of EIP-712
+	data, err := os.ReadFile(filepath.Join("testdata", "mail.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	typedData, err := ParseTypedData(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	encoded, _ := typedData.EncodeType("Mail")
+	if expected := "Mail(Person from,Person to,string contents)Person(string name,address wallet)"; encoded != expected {
+		t.Errorf("encoded type is wrong:\n\texpected \"%s\"\n\tgot \"%s\"", expected, encoded)
+	}
+
+	typeHash, _ := typedData.TypeHash("Mail")
+	domainSeparator, _ := typedData.DomainSeparator()
+	message, _ := typedData.HashStruct("Mail", typedData.Message)
+	digest, err := typedData.Hash()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	vectors := []struct {
+		name     string
+		expected string
+		output   []byte
+	}{
+		{"type hash", "a0cedeb2dc280ba39b857546d74f5549c3a1d7bdc2dd96bf881f76108e23dac2", typeHash[:]},
+		{"domain separator", "f2cee375fa42b42143804025fc449deafd50cc031ca257e0b194a650a912090f", domainSeparator[:]},
+		{"struct hash", "c52c0ee5d84264471806290a3f2c4cecfc5490626bf912d01f240d7a274b371e", message[:]},
+		{"hash", "be609aee343fb3c4b28e1df9e632fca64fcfaede20f02e86244efddf30957bd2", digest[:]},
+	}
+
+	for _, v := range vectors {
+		if hex.EncodeToString(v.output) != v.expected {
+			t.Errorf("%s is wrong:\n\texpected \"%s\"\n\tgot \"%x\"", v.name, v.expected, v.output)
+		}
+	}
+
+	delete(typedData.Message, "contents")
+	if _, err = typedData.Hash(); err == nil {
+		t.Errorf("missing member is accepted")
+	}
+}
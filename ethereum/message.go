@@ -0,0 +1,16 @@
+package ethereum
+
+import "strconv"
+
+// personalPrefix is the prefix of the EIP-191 version 0x45 personal messages, followed by the length of the message.
+const personalPrefix = "\x19Ethereum Signed Message:\n"
+
+// PersonalMessageHash returns the EIP-191 version 0x45 hash of the message, as signed by personal_sign and eth_sign.
+func PersonalMessageHash(message []byte) [HashSize]byte {
+	return Keccak256([]byte(personalPrefix+strconv.Itoa(len(message))), message)
+}
+
+// ValidatorHash returns the EIP-191 version 0x00 hash of the data with its intended validator.
+func ValidatorHash(validator [AddressSize]byte, data []byte) [HashSize]byte {
+	return Keccak256([]byte{0x19, 0x00}, validator[:], data)
+}
@@ -0,0 +1,355 @@
+package ethereum
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// domainType is the name of the type of the EIP-712 domain.
+const domainType = "EIP712Domain"
+
+// ErrTypedData is returned when the typed data does not match its types.
+var ErrTypedData = errors.New("ethereum: invalid typed data")
+
+// Field represents a member of an EIP-712 struct type.
+type Field struct {
+	Name string `json:"name"`
+	Type string `json:"type"`
+}
+
+// TypedData represents the EIP-712 typed structured data in the JSON format of eth_signTypedData_v4.
+type TypedData struct {
+	Types       map[string][]Field `json:"types"`
+	PrimaryType string             `json:"primaryType"`
+	Domain      map[string]any     `json:"domain"`
+	Message     map[string]any     `json:"message"`
+}
+
+// ParseTypedData parses the JSON of the typed data, the numbers may be JSON numbers or decimal or hex strings.
+func ParseTypedData(data []byte) (*TypedData, error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+
+	r := new(TypedData)
+	if err := decoder.Decode(r); err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrTypedData, err)
+	}
+
+	return r, nil
+}
+
+// Hash returns the EIP-712 hash to be signed, which is the KECCAK-256 of 0x19 0x01,
+// the domain separator and the struct hash of the message.
+func (r *TypedData) Hash() ([HashSize]byte, error) {
+	domainSeparator, err := r.DomainSeparator()
+	if err != nil {
+		return [HashSize]byte{}, err
+	}
+
+	// the message is left out when the primary type is the domain
+	if r.PrimaryType == domainType {
+		return Keccak256([]byte{0x19, 0x01}, domainSeparator[:]), nil
+	}
+
+	message, err := r.HashStruct(r.PrimaryType, r.Message)
+	if err != nil {
+		return [HashSize]byte{}, err
+	}
+
+	return Keccak256([]byte{0x19, 0x01}, domainSeparator[:], message[:]), nil
+}
+
+// DomainSeparator returns the struct hash of the domain.
+func (r *TypedData) DomainSeparator() ([HashSize]byte, error) {
+	return r.HashStruct(domainType, r.Domain)
+}
+
+// HashStruct returns the KECCAK-256 of the type hash and the encoded members of the data of the struct type.
+func (r *TypedData) HashStruct(typeName string, data map[string]any) ([HashSize]byte, error) {
+	encoded, err := r.encodeData(typeName, data)
+	if err != nil {
+		return [HashSize]byte{}, err
+	}
+
+	return Keccak256(encoded), nil
+}
+
+// TypeHash returns the KECCAK-256 of the encoded struct type.
+func (r *TypedData) TypeHash(typeName string) ([HashSize]byte, error) {
+	encoded, err := r.EncodeType(typeName)
+	if err != nil {
+		return [HashSize]byte{}, err
+	}
+
+	return Keccak256([]byte(encoded)), nil
+}
+
+// EncodeType returns the encoding of the struct type followed by the struct types it references sorted by name,
+// like "Mail(Person from,Person to,string contents)Person(string name,address wallet)".
+func (r *TypedData) EncodeType(typeName string) (string, error) {
+	if _, found := r.Types[typeName]; !found {
+		return "", fmt.Errorf("%w: unknown type %s", ErrTypedData, typeName)
+	}
+
+	found := map[string]bool{typeName: true}
+	r.dependencies(typeName, found)
+	delete(found, typeName)
+
+	names := append([]string{typeName}, sortedNames(found)...)
+
+	var b strings.Builder
+	for _, name := range names {
+		b.WriteString(name + "(")
+
+		for i, field := range r.Types[name] {
+			if i > 0 {
+				b.WriteByte(',')
+			}
+
+			b.WriteString(field.Type + " " + field.Name)
+		}
+
+		b.WriteByte(')')
+	}
+
+	return b.String(), nil
+}
+
+// private
+
+// dependencies adds the struct types referenced by the struct type to found.
+func (r *TypedData) dependencies(typeName string, found map[string]bool) {
+	for _, field := range r.Types[typeName] {
+		name := baseType(field.Type)
+		if _, isStruct := r.Types[name]; isStruct && !found[name] {
+			found[name] = true
+			r.dependencies(name, found)
+		}
+	}
+}
+
+// encodeData returns the type hash followed by the encoded members of the data.
+func (r *TypedData) encodeData(typeName string, data map[string]any) ([]byte, error) {
+	typeHash, err := r.TypeHash(typeName)
+	if err != nil {
+		return nil, err
+	}
+
+	encoded := typeHash[:]
+
+	for _, field := range r.Types[typeName] {
+		value, found := data[field.Name]
+		if !found {
+			return nil, fmt.Errorf("%w: missing %s.%s", ErrTypedData, typeName, field.Name)
+		}
+
+		word, err := r.encodeValue(field.Type, value)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s.%s: %s", ErrTypedData, typeName, field.Name, err)
+		}
+
+		encoded = append(encoded, word...)
+	}
+
+	return encoded, nil
+}
+
+// encodeValue returns the 32 bytes encoding of the value of the type.
+func (r *TypedData) encodeValue(typeName string, value any) ([]byte, error) {
+	// arrays are the hash of the concatenated encodings of their elements
+	if strings.HasSuffix(typeName, "]") {
+		open := strings.LastIndexByte(typeName, '[')
+		elementType, length := typeName[:open], typeName[open+1:len(typeName)-1]
+
+		elements, ok := value.([]any)
+		if !ok {
+			return nil, errors.New("array expected")
+		}
+
+		if length != "" && length != strconv.Itoa(len(elements)) {
+			return nil, fmt.Errorf("array of %s elements expected", length)
+		}
+
+		var encoded []byte
+		for _, element := range elements {
+			word, err := r.encodeValue(elementType, element)
+			if err != nil {
+				return nil, err
+			}
+
+			encoded = append(encoded, word...)
+		}
+
+		digest := Keccak256(encoded)
+
+		return digest[:], nil
+	}
+
+	if _, isStruct := r.Types[typeName]; isStruct {
+		data, ok := value.(map[string]any)
+		if !ok {
+			return nil, errors.New("object expected")
+		}
+
+		digest, err := r.HashStruct(typeName, data)
+
+		return digest[:], err
+	}
+
+	return encodeAtomic(typeName, value)
+}
+
+// encodeAtomic returns the 32 bytes encoding of the value of the atomic or dynamic type.
+func encodeAtomic(typeName string, value any) ([]byte, error) {
+	word := make([]byte, 32)
+
+	switch {
+	case typeName == "string":
+		s, ok := value.(string)
+		if !ok {
+			return nil, errors.New("string expected")
+		}
+
+		digest := Keccak256([]byte(s))
+
+		return digest[:], nil
+	case typeName == "bytes":
+		b, err := decodeHex(value)
+		if err != nil {
+			return nil, err
+		}
+
+		digest := Keccak256(b)
+
+		return digest[:], nil
+	case typeName == "bool":
+		b, ok := value.(bool)
+		if !ok {
+			return nil, errors.New("bool expected")
+		}
+
+		if b {
+			word[31] = 1
+		}
+
+		return word, nil
+	case typeName == "address":
+		s, ok := value.(string)
+		if !ok {
+			return nil, errors.New("address expected")
+		}
+
+		address, err := ParseAddress(s)
+		if err != nil {
+			return nil, err
+		}
+
+		copy(word[12:], address[:])
+
+		return word, nil
+	case strings.HasPrefix(typeName, "bytes"):
+		size, err := strconv.Atoi(typeName[len("bytes"):])
+		if err != nil || size < 1 || size > 32 {
+			return nil, fmt.Errorf("unknown type %s", typeName)
+		}
+
+		b, err := decodeHex(value)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(b) != size {
+			return nil, fmt.Errorf("%d bytes expected", size)
+		}
+
+		copy(word, b)
+
+		return word, nil
+	case strings.HasPrefix(typeName, "uint"), strings.HasPrefix(typeName, "int"):
+		return encodeInteger(typeName, value)
+	}
+
+	return nil, fmt.Errorf("unknown type %s", typeName)
+}
+
+// encodeInteger returns the 32 bytes two's complement encoding of the value of the uintN or intN type.
+func encodeInteger(typeName string, value any) ([]byte, error) {
+	signed := strings.HasPrefix(typeName, "int")
+
+	bits, err := strconv.Atoi(strings.TrimPrefix(strings.TrimPrefix(typeName, "u"), "int"))
+	if err != nil || bits < 8 || bits > 256 || bits%8 != 0 {
+		return nil, fmt.Errorf("unknown type %s", typeName)
+	}
+
+	var s string
+	switch v := value.(type) {
+	case json.Number:
+		s = v.String()
+	case string:
+		s = v
+	case float64:
+		s = strconv.FormatFloat(v, 'f', -1, 64)
+	default:
+		return nil, errors.New("number expected")
+	}
+
+	n, ok := new(big.Int).SetString(s, 0)
+	if !ok {
+		return nil, fmt.Errorf("invalid number %s", s)
+	}
+
+	low, high := new(big.Int), new(big.Int).Lsh(big.NewInt(1), uint(bits))
+	if signed {
+		high.Rsh(high, 1)
+		low.Neg(high)
+	}
+
+	if n.Cmp(low) < 0 || n.Cmp(high) >= 0 {
+		return nil, fmt.Errorf("%s is out of range of %s", s, typeName)
+	}
+
+	// two's complement of 256 bits
+	if n.Sign() < 0 {
+		n.Add(n, new(big.Int).Lsh(big.NewInt(1), 256))
+	}
+
+	return n.FillBytes(make([]byte, 32)), nil
+}
+
+// decodeHex returns the bytes of the hex string with the 0x prefix.
+func decodeHex(value any) ([]byte, error) {
+	s, ok := value.(string)
+	if !ok || !strings.HasPrefix(s, "0x") {
+		return nil, errors.New("hex string with 0x prefix expected")
+	}
+
+	return hex.DecodeString(s[2:])
+}
+
+// baseType returns the type without its array suffixes.
+func baseType(typeName string) string {
+	if i := strings.IndexByte(typeName, '['); i >= 0 {
+		return typeName[:i]
+	}
+
+	return typeName
+}
+
+// sortedNames returns the sorted names of the set.
+func sortedNames(set map[string]bool) []string {
+	names := make([]string, 0, len(set))
+	for name := range set {
+		names = append(names, name)
+	}
+
+	slices.Sort(names)
+
+	return names
+}
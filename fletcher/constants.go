@@ -0,0 +1,13 @@
+package fletcher
+
+const (
+	// Size16 is the size of a Fletcher-16 checksum in bytes.
+	Size16 = 2
+	// Size32 is the size of a Fletcher-32 checksum in bytes.
+	Size32 = 4
+	// Size64 is the size of a Fletcher-64 checksum in bytes.
+	Size64 = 8
+
+	// words is the number of words summed before a reduction, small enough for the sums to fit in 64 bits.
+	words = 4096
+)
@@ -0,0 +1,22 @@
+// Package fletcher implements the Fletcher-16, Fletcher-32 and Fletcher-64 checksums.
+//
+// Fletcher-32 and Fletcher-64 sum little-endian words of 16 and 32 bits,
+// and a trailing partial word is padded with zeros.
+// The checksums are the big-endian form of the second sum followed by the first one.
+package fletcher
+
+import "hash"
+
+// New16 creates a new Fletcher-16 hash.Hash.
+func New16() hash.Hash { return newModel(Size16) }
+
+// New32 creates a new Fletcher-32 hash.Hash32.
+func New32() hash.Hash32 { return newModel(Size32) }
+
+// New64 creates a new Fletcher-64 hash.Hash64.
+func New64() hash.Hash64 { return newModel(Size64) }
+
+// newModel creates a new Fletcher model of the size, whose words are half of it.
+func newModel(size int) *model {
+	return &model{size: size, wordSize: size / 2, mod: 1<<(size*4) - 1}
+}
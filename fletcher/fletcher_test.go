@@ -0,0 +1,63 @@
+package fletcher
+
+import (
+	"encoding/hex"
+	"hash"
+	"testing"
+)
+
+func TestVectors(t *testing.T) {
+	vectors := []struct {
+		newHash  func() hash.Hash
+		input    string
+		expected string
+	}{
+		{New16, "abcde", "c8f0"},
+		{New16, "abcdef", "2057"},
+		{New16, "abcdefgh", "0627"},
+		{func() hash.Hash { return New32() }, "abcde", "f04fc729"},
+		{func() hash.Hash { return New32() }, "abcdef", "56502d2a"},
+		{func() hash.Hash { return New32() }, "abcdefgh", "ebe19591"},
+		{func() hash.Hash { return New64() }, "abcde", "c8c6c527646362c6"},
+		{func() hash.Hash { return New64() }, "abcdef", "c8c72b276463c8c6"},
+		{func() hash.Hash { return New64() }, "abcdefgh", "312e2b28cccac8c6"},
+	}
+
+	for _, v := range vectors {
+		h := v.newHash()
+		h.Write([]byte(v.input))
+
+		if got := hex.EncodeToString(h.Sum(nil)); got != v.expected {
+			t.Errorf("checksum of \"%s\" is wrong:\n\texpected \"%s\"\n\tgot \"%s\"", v.input, v.expected, got)
+		}
+	}
+}
+
+func TestReduction(t *testing.T) {
+	// a long run of maximal words checks the delayed reduction against the one of every word
+	data := make([]byte, 3*words*4+3)
+	for i := range data {
+		data[i] = 0xff
+	}
+
+	for _, h := range []*model{newModel(Size16), newModel(Size32), newModel(Size64)} {
+		h.Write(data)
+
+		var s1, s2 uint64
+		for i := 0; i+h.wordSize <= len(data); i += h.wordSize {
+			s1 = (s1 + h.word(data[i:])) % h.mod
+			s2 = (s2 + s1) % h.mod
+		}
+
+		if tail := len(data) % h.wordSize; tail > 0 {
+			var last [4]byte
+			copy(last[:], data[len(data)-tail:])
+			s1 = (s1 + h.word(last[:])) % h.mod
+			s2 = (s2 + s1) % h.mod
+		}
+
+		if expected := s2<<(h.size*4) | s1; h.Sum64() != expected {
+			t.Errorf("Fletcher-%d of maximal words is wrong:\n\texpected \"%x\"\n\tgot \"%x\"", h.size*8, expected, h.Sum64())
+		}
+	}
+}
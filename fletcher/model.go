@@ -0,0 +1,110 @@
+package fletcher
+
+// model represents a structure for the Fletcher hash.Hash.
+type model struct {
+	size     int
+	wordSize int
+	mod      uint64
+	s1, s2   uint64
+	buf      [4]byte
+	bufLen   int
+}
+
+// implementation of the hash.Hash
+
+// Reset resets the hash.Hash to its initial state.
+func (r *model) Reset() {
+	r.s1, r.s2 = 0, 0
+	r.bufLen = 0
+}
+
+// Size returns the number of bytes Sum will return.
+func (r *model) Size() int { return r.size }
+
+// BlockSize returns the hash's underlying block size.
+func (r *model) BlockSize() int { return r.wordSize }
+
+// Write appends the data to the digest.
+func (r *model) Write(p []byte) (int, error) {
+	n := len(p)
+
+	if r.bufLen > 0 {
+		x := copy(r.buf[r.bufLen:r.wordSize], p)
+		r.bufLen += x
+		p = p[x:]
+
+		if r.bufLen < r.wordSize {
+			return n, nil
+		}
+
+		r.update(r.buf[:r.wordSize])
+		r.bufLen = 0
+	}
+
+	k := len(p) - len(p)%r.wordSize
+	r.update(p[:k])
+	r.bufLen = copy(r.buf[:], p[k:])
+
+	return n, nil
+}
+
+// Sum appends the current hash to b and returns the resulting slice.
+// It does not change the underlying hash state.
+func (r *model) Sum(b []byte) []byte {
+	s := r.Sum64()
+	for i := r.size - 1; i >= 0; i-- {
+		b = append(b, byte(s>>(8*i)))
+	}
+
+	return b
+}
+
+// implementation of the hash.Hash32 and hash.Hash64
+
+// Sum32 returns the checksum, truncated to 32 bits for Fletcher-64.
+func (r *model) Sum32() uint32 { return uint32(r.Sum64()) }
+
+// Sum64 returns the checksum.
+func (r *model) Sum64() uint64 {
+	s1, s2 := r.s1, r.s2
+
+	if r.bufLen > 0 {
+		var last [4]byte
+		copy(last[:], r.buf[:r.bufLen])
+		s1 = (s1 + r.word(last[:])) % r.mod
+		s2 = (s2 + s1) % r.mod
+	}
+
+	return s2<<(r.size*4) | s1
+}
+
+// private
+
+// word returns the little-endian word at the start of p.
+func (r *model) word(p []byte) uint64 {
+	var w uint64
+	for i := r.wordSize - 1; i >= 0; i-- {
+		w = w<<8 | uint64(p[i])
+	}
+
+	return w
+}
+
+// update adds the words of p, whose length is a multiple of the word size, to the sums.
+func (r *model) update(p []byte) {
+	s1, s2 := r.s1, r.s2
+
+	for len(p) > 0 {
+		n := min(len(p), words*r.wordSize)
+		for i := 0; i < n; i += r.wordSize {
+			s1 += r.word(p[i:])
+			s2 += s1
+		}
+
+		s1 %= r.mod
+		s2 %= r.mod
+		p = p[n:]
+	}
+
+	r.s1, r.s2 = s1, s2
+}
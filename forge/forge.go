@@ -0,0 +1,133 @@
+// Package forge implements CRC forging, computing the bytes which make a checksum match a target.
+//
+// It works with any hash.Hash whose output is an affine function of its input over GF(2),
+// which is the case for every CRC algorithm regardless of its polynomial, initial value,
+// reflection or final xor. The forged bytes are as long as the checksum itself.
+package forge
+
+import (
+	"bytes"
+	"errors"
+	"hash"
+)
+
+var (
+	// ErrOffset is returned when the offset is out of the data range.
+	ErrOffset = errors.New("forge: offset is out of range")
+	// ErrTargetSize is returned when the target size is not equal to the checksum size.
+	ErrTargetSize = errors.New("forge: target size does not match the checksum size")
+	// ErrUnsupported is returned when the hash is not a linear checksum or is wider than 64 bits.
+	ErrUnsupported = errors.New("forge: hash is not a supported linear checksum")
+)
+
+// Insert returns the bytes which inserted into data at the offset make its checksum equal to target.
+// Offset equal to the length of data means appending the bytes.
+func Insert(newHash func() hash.Hash, data []byte, offset int, target []byte) ([]byte, error) {
+	if offset < 0 || offset > len(data) {
+		return nil, ErrOffset
+	}
+
+	return solve(newHash, data[:offset], data[offset:], target)
+}
+
+// Patch returns the bytes which overwritten on data at the offset make its checksum equal to target.
+// The overwritten range is as long as the checksum size and must be within data.
+func Patch(newHash func() hash.Hash, data []byte, offset int, target []byte) ([]byte, error) {
+	n := newHash().Size()
+	if offset < 0 || offset+n > len(data) {
+		return nil, ErrOffset
+	}
+
+	return solve(newHash, data[:offset], data[offset+n:], target)
+}
+
+// private
+
+// solve returns the bytes which placed between prefix and suffix make the checksum equal to target.
+//
+// Let Lin(x) be H(x) xor H(zeros) for a message x, which is linear for CRC algorithms.
+// The checksum of prefix || x || suffix is c0 xor T(x), where c0 is the checksum with x set to zeros
+// and T(x) = Lin(x || zeros(len(suffix))). T is computed as T0 * (T0^-1 * T1)^len(suffix),
+// where T0 and T1 are the matrices of Lin for x and x || zero, so the suffix is hashed only once.
+func solve(newHash func() hash.Hash, prefix, suffix, target []byte) ([]byte, error) {
+	h := newHash()
+	n := h.Size()
+
+	if n == 0 || n > 8 {
+		return nil, ErrUnsupported
+	}
+
+	if len(target) != n {
+		return nil, ErrTargetSize
+	}
+
+	t0 := linearMatrix(h, n, 0)
+	t1 := linearMatrix(h, n, 1)
+
+	t0Inv, ok := t0.inverse()
+	if !ok {
+		return nil, ErrUnsupported
+	}
+
+	t1Inv, ok := t1.inverse()
+	if !ok {
+		return nil, ErrUnsupported
+	}
+
+	// T^-1 = (T0^-1 * T1)^-len(suffix) * T0^-1 = (T1^-1 * T0)^len(suffix) * T0^-1
+	tInv := t1Inv.mul(t0).pow(uint64(len(suffix))).mul(t0Inv)
+
+	c0 := checksum(h, prefix, make([]byte, n), suffix)
+	forged := toBytes(tInv.apply(toVector(target)^toVector(c0)), n)
+
+	if !bytes.Equal(checksum(h, prefix, forged, suffix), target) {
+		return nil, ErrUnsupported
+	}
+
+	return forged, nil
+}
+
+// linearMatrix returns the matrix of Lin(x || zeros(padding)) for n bytes long x.
+func linearMatrix(h hash.Hash, n, padding int) matrix {
+	m := newMatrix(n * 8)
+	zero := toVector(checksum(h, make([]byte, n+padding)))
+
+	for i := range m.cols {
+		x := make([]byte, n+padding)
+		copy(x, toBytes(1<<i, n))
+		m.cols[i] = toVector(checksum(h, x)) ^ zero
+	}
+
+	return m
+}
+
+// checksum returns the checksum of the concatenation of parts.
+func checksum(h hash.Hash, parts ...[]byte) []byte {
+	h.Reset()
+	for _, part := range parts {
+		_, _ = h.Write(part)
+	}
+
+	return h.Sum(nil)
+}
+
+// toVector converts at most 8 bytes to a bit vector in big-endian order.
+func toVector(b []byte) uint64 {
+	var v uint64
+	for _, c := range b {
+		v = v<<8 | uint64(c)
+	}
+
+	return v
+}
+
+// toBytes converts a bit vector to n bytes in big-endian order.
+func toBytes(v uint64, n int) []byte {
+	b := make([]byte, n)
+	for i := n - 1; i >= 0; i-- {
+		b[i] = byte(v)
+		v >>= 8
+	}
+
+	return b
+}
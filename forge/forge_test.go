@@ -0,0 +1,146 @@
+package forge
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"hash"
+	"hash/adler32"
+	"hash/crc32"
+	"hash/crc64"
+	"hash/fnv"
+	"math/rand"
+	"testing"
+
+	"hashed/crc16"
+	"hashed/crc16/algorithm"
+)
+
+// crc8 represents a bitwise CRC-8/SMBUS, polynomial 0x07 and no reflection, as the repository has no 8-bit CRC.
+type crc8 struct {
+	crc byte
+}
+
+func (r *crc8) Write(p []byte) (int, error) {
+	for _, b := range p {
+		r.crc ^= b
+		for i := 0; i < 8; i++ {
+			if r.crc&0x80 != 0 {
+				r.crc = r.crc<<1 ^ 0x07
+			} else {
+				r.crc <<= 1
+			}
+		}
+	}
+
+	return len(p), nil
+}
+
+func (r *crc8) Sum(b []byte) []byte { return append(b, r.crc) }
+func (r *crc8) Reset()              { r.crc = 0 }
+func (r *crc8) Size() int           { return 1 }
+func (r *crc8) BlockSize() int      { return 1 }
+
+// constant represents a 32-bit checksum which ignores its input, whose matrix is zero.
+type constant struct{}
+
+func (constant) Write(p []byte) (int, error) { return len(p), nil }
+func (constant) Sum(b []byte) []byte         { return append(b, 0xde, 0xad, 0xbe, 0xef) }
+func (constant) Reset()                      {}
+func (constant) Size() int                   { return 4 }
+func (constant) BlockSize() int              { return 1 }
+
+func TestCrc8(t *testing.T) {
+	h := new(crc8)
+	h.Write([]byte("123456789"))
+
+	if sum := h.Sum(nil)[0]; sum != 0xf4 {
+		t.Errorf("crc-8 check value is wrong:\n\texpected \"f4\"\n\tgot \"%02x\"", sum)
+	}
+}
+
+func TestForge(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	data := make([]byte, 100)
+	rnd.Read(data)
+
+	constructors := map[string]func() hash.Hash{
+		"crc-8/smbus":    func() hash.Hash { return new(crc8) },
+		"crc-16/arc":     func() hash.Hash { return crc16.New(crc16.MakeTable(algorithm.ARC)) },
+		"crc-16/x-25":    func() hash.Hash { return crc16.New(crc16.MakeTable(algorithm.X_25)) },
+		"crc-32/ieee":    func() hash.Hash { return crc32.NewIEEE() },
+		"crc-32/koopman": func() hash.Hash { return crc32.New(crc32.MakeTable(crc32.Koopman)) },
+		"crc-64/iso":     func() hash.Hash { return crc64.New(crc64.MakeTable(crc64.ISO)) },
+		"crc-64/ecma":    func() hash.Hash { return crc64.New(crc64.MakeTable(crc64.ECMA)) },
+	}
+
+	for name, newHash := range constructors {
+		n := newHash().Size()
+		target := make([]byte, n)
+		rnd.Read(target)
+
+		// offset 0 and len(data) insert at the ends, len(data)-n patches the end
+		for _, offset := range []int{0, 1, len(data) / 2, len(data) - n, len(data)} {
+			forged, err := Insert(newHash, data, offset, target)
+			if err != nil {
+				t.Fatalf("'%s' insert at %d returned %v", name, offset, err)
+			}
+
+			inserted := append(append(append([]byte{}, data[:offset]...), forged...), data[offset:]...)
+			if sum := checksum(newHash(), inserted); !bytes.Equal(sum, target) {
+				t.Errorf("'%s' insert at %d is wrong:\n\texpected \"%x\"\n\tgot \"%x\"", name, offset, target, sum)
+			}
+
+			if offset+n > len(data) {
+				continue
+			}
+
+			forged, err = Patch(newHash, data, offset, target)
+			if err != nil {
+				t.Fatalf("'%s' patch at %d returned %v", name, offset, err)
+			}
+
+			patched := append(append(append([]byte{}, data[:offset]...), forged...), data[offset+n:]...)
+			if sum := checksum(newHash(), patched); !bytes.Equal(sum, target) {
+				t.Errorf("'%s' patch at %d is wrong:\n\texpected \"%x\"\n\tgot \"%x\"", name, offset, target, sum)
+			}
+		}
+
+		// the forged bytes are the whole message
+		forged, err := Insert(newHash, nil, 0, target)
+		if err != nil || !bytes.Equal(checksum(newHash(), forged), target) {
+			t.Errorf("'%s' insert into empty data is wrong: %v", name, err)
+		}
+	}
+}
+
+func TestForgeErrors(t *testing.T) {
+	data := []byte("0123456789abcdef")
+	newCrc32 := func() hash.Hash { return crc32.NewIEEE() }
+	target := []byte{0x12, 0x34, 0x56, 0x78}
+
+	cases := []struct {
+		name    string
+		newHash func() hash.Hash
+		forge   func(func() hash.Hash, []byte, int, []byte) ([]byte, error)
+		offset  int
+		target  []byte
+		err     error
+	}{
+		{"insert before the data", newCrc32, Insert, -1, target, ErrOffset},
+		{"insert after the data", newCrc32, Insert, len(data) + 1, target, ErrOffset},
+		{"patch before the data", newCrc32, Patch, -1, target, ErrOffset},
+		{"patch past the end", newCrc32, Patch, len(data) - 3, target, ErrOffset},
+		{"short target", newCrc32, Insert, 0, target[:3], ErrTargetSize},
+		{"long target", newCrc32, Patch, 0, append(target, 0), ErrTargetSize},
+		{"non-linear fnv-1a", func() hash.Hash { return fnv.New64a() }, Insert, 4, make([]byte, 8), ErrUnsupported},
+		{"non-linear adler-32", func() hash.Hash { return adler32.New() }, Patch, 4, target, ErrUnsupported},
+		{"constant", func() hash.Hash { return constant{} }, Insert, 4, target, ErrUnsupported},
+		{"wider than 64 bits", sha256.New, Insert, 4, make([]byte, sha256.Size), ErrUnsupported},
+	}
+
+	for _, c := range cases {
+		if _, err := c.forge(c.newHash, data, c.offset, c.target); err != c.err {
+			t.Errorf("error of %s is wrong:\n\texpected \"%v\"\n\tgot \"%v\"", c.name, c.err, err)
+		}
+	}
+}
@@ -0,0 +1,112 @@
+package forge
+
+// matrix represents a square matrix over GF(2) stored as column bit vectors.
+// It supports up to 64 rows and columns, which is enough for CRC-64.
+type matrix struct {
+	size int
+	cols []uint64
+}
+
+// newMatrix creates a new zero matrix with the given size.
+func newMatrix(size int) matrix {
+	return matrix{size: size, cols: make([]uint64, size)}
+}
+
+// identity creates a new identity matrix with the given size.
+func identity(size int) matrix {
+	m := newMatrix(size)
+	for i := range m.cols {
+		m.cols[i] = 1 << i
+	}
+
+	return m
+}
+
+// apply returns the product of the matrix and the vector v.
+func (r matrix) apply(v uint64) uint64 {
+	var result uint64
+
+	for i := 0; v != 0; i++ {
+		if v&1 == 1 {
+			result ^= r.cols[i]
+		}
+
+		v >>= 1
+	}
+
+	return result
+}
+
+// mul returns the product of the matrix and m.
+func (r matrix) mul(m matrix) matrix {
+	result := newMatrix(r.size)
+	for i, col := range m.cols {
+		result.cols[i] = r.apply(col)
+	}
+
+	return result
+}
+
+// pow returns the matrix raised to the power of n.
+func (r matrix) pow(n uint64) matrix {
+	result := identity(r.size)
+	base := r
+
+	for n > 0 {
+		if n&1 == 1 {
+			result = result.mul(base)
+		}
+
+		base = base.mul(base)
+		n >>= 1
+	}
+
+	return result
+}
+
+// inverse returns the inverse of the matrix, ok is false if the matrix is singular.
+// It uses Gauss-Jordan elimination on the rows of the matrix.
+func (r matrix) inverse() (result matrix, ok bool) {
+	rows := r.transpose().cols
+	inv := identity(r.size).cols
+
+	for col := 0; col < r.size; col++ {
+		pivot := -1
+		for row := col; row < r.size; row++ {
+			if rows[row]>>col&1 == 1 {
+				pivot = row
+				break
+			}
+		}
+
+		if pivot == -1 {
+			return matrix{}, false
+		}
+
+		rows[col], rows[pivot] = rows[pivot], rows[col]
+		inv[col], inv[pivot] = inv[pivot], inv[col]
+
+		for row := 0; row < r.size; row++ {
+			if row != col && rows[row]>>col&1 == 1 {
+				rows[row] ^= rows[col]
+				inv[row] ^= inv[col]
+			}
+		}
+	}
+
+	return matrix{size: r.size, cols: inv}.transpose(), true
+}
+
+// transpose returns the transposed matrix.
+func (r matrix) transpose() matrix {
+	result := newMatrix(r.size)
+	for i, col := range r.cols {
+		for j := 0; j < r.size; j++ {
+			if col>>j&1 == 1 {
+				result.cols[j] |= 1 << i
+			}
+		}
+	}
+
+	return result
+}
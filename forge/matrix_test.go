@@ -0,0 +1,109 @@
+package forge
+
+import (
+	"math/rand"
+	"slices"
+	"testing"
+)
+
+// randomInvertible returns a random invertible matrix, the product of a lower and an upper unitriangular matrix.
+func randomInvertible(rnd *rand.Rand, size int) matrix {
+	lower, upper := identity(size), identity(size)
+	for i := 0; i < size; i++ {
+		for j := i + 1; j < size; j++ {
+			lower.cols[i] |= uint64(rnd.Intn(2)) << j
+			upper.cols[j] |= uint64(rnd.Intn(2)) << i
+		}
+	}
+
+	return lower.mul(upper)
+}
+
+func TestInverse(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+
+	for _, size := range []int{8, 16, 32, 64} {
+		m := randomInvertible(rnd, size)
+
+		inv, ok := m.inverse()
+		if !ok {
+			t.Fatalf("invertible matrix of size %d is singular", size)
+		}
+
+		if !slices.Equal(m.mul(inv).cols, identity(size).cols) || !slices.Equal(inv.mul(m).cols, identity(size).cols) {
+			t.Errorf("inverse of size %d is wrong", size)
+		}
+
+		// the inverse solves m * x = y
+		x := rnd.Uint64() >> (64 - size)
+		if got := inv.apply(m.apply(x)); got != x {
+			t.Errorf("solution of size %d is wrong:\n\texpected \"%x\"\n\tgot \"%x\"", size, x, got)
+		}
+	}
+}
+
+func TestSingular(t *testing.T) {
+	rnd := rand.New(rand.NewSource(2))
+
+	for _, size := range []int{8, 16, 32, 64} {
+		zeroColumn := randomInvertible(rnd, size)
+		zeroColumn.cols[size/2] = 0
+
+		equalColumns := randomInvertible(rnd, size)
+		equalColumns.cols[size-1] = equalColumns.cols[0]
+
+		// one column is the sum of two others, so the rank is size-1 without a zero or a repeated column
+		dependent := randomInvertible(rnd, size)
+		dependent.cols[1] = dependent.cols[0] ^ dependent.cols[size-1]
+
+		cases := map[string]matrix{
+			"zero":          newMatrix(size),
+			"zero column":   zeroColumn,
+			"equal columns": equalColumns,
+			"dependent":     dependent,
+		}
+
+		for name, m := range cases {
+			if _, ok := m.inverse(); ok {
+				t.Errorf("%s matrix of size %d is inverted", name, size)
+			}
+		}
+	}
+}
+
+func TestPow(t *testing.T) {
+	rnd := rand.New(rand.NewSource(3))
+	m := randomInvertible(rnd, 32)
+	inv, _ := m.inverse()
+
+	expected := identity(32)
+	for n := uint64(0); n < 20; n++ {
+		if got := m.pow(n); !slices.Equal(got.cols, expected.cols) {
+			t.Errorf("power %d is wrong", n)
+		}
+
+		expected = expected.mul(m)
+	}
+
+	if got := m.pow(1 << 40).mul(inv.pow(1 << 40)); !slices.Equal(got.cols, identity(32).cols) {
+		t.Errorf("power %d times the power of the inverse is not the identity", uint64(1<<40))
+	}
+}
+
+func TestTranspose(t *testing.T) {
+	rnd := rand.New(rand.NewSource(4))
+	m := randomInvertible(rnd, 64)
+	transposed := m.transpose()
+
+	for i := 0; i < 64; i++ {
+		for j := 0; j < 64; j++ {
+			if m.cols[i]>>j&1 != transposed.cols[j]>>i&1 {
+				t.Fatalf("element %d, %d of the transpose is wrong", j, i)
+			}
+		}
+	}
+
+	if !slices.Equal(transposed.transpose().cols, m.cols) {
+		t.Errorf("transpose of the transpose is wrong")
+	}
+}
@@ -0,0 +1,189 @@
+// Package gmac implements GMAC of NIST SP 800-38D, the authentication of GCM without encryption, where the message is
+// the additional authenticated data and the tag is computed by GHASH.
+//
+// The nonce must not be reused with the same key, otherwise the hash key of GHASH can be recovered from the tags.
+package gmac
+
+import (
+	"crypto/cipher"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash"
+)
+
+const (
+	// Size is the size of a GMAC checksum in bytes.
+	Size = 16
+	// BlockSize is the block size of GMAC in bytes, the block size of the cipher.
+	BlockSize = 16
+	// NonceSize is the recommended size of the nonce in bytes, the nonces of other sizes are hashed by GHASH.
+	NonceSize = 12
+)
+
+var (
+	// ErrBlockSize is returned when the block size of the cipher is not 16 bytes.
+	ErrBlockSize = errors.New("gmac: invalid block size")
+	// ErrNonceSize is returned when the nonce is empty.
+	ErrNonceSize = errors.New("gmac: invalid nonce size")
+)
+
+// element is an element of the field of GHASH, the bits of the blocks are the coefficients from x^0 to x^127.
+type element struct {
+	hi, lo uint64
+}
+
+// model represents a structure for the GMAC hash.Hash.
+type model struct {
+	key    element
+	mask   [Size]byte
+	y      element
+	buf    [BlockSize]byte
+	bufLen int
+	total  uint64
+}
+
+// New creates a new GMAC hash.Hash of the block cipher of 16 bytes, like AES, with the nonce.
+func New(block cipher.Block, nonce []byte) (hash.Hash, error) {
+	if block.BlockSize() != BlockSize {
+		return nil, fmt.Errorf("%w: %d", ErrBlockSize, block.BlockSize())
+	}
+
+	if len(nonce) == 0 {
+		return nil, fmt.Errorf("%w: %d", ErrNonceSize, len(nonce))
+	}
+
+	r := &model{}
+
+	var b [BlockSize]byte
+	block.Encrypt(b[:], b[:])
+	r.key = load(b[:])
+
+	// the pre-counter block is the nonce of 12 bytes and the counter 1, or GHASH of the other nonces
+	var j0 [BlockSize]byte
+	if len(nonce) == NonceSize {
+		copy(j0[:], nonce)
+		j0[BlockSize-1] = 1
+	} else {
+		// the length of the nonce is in the second half of the block of the lengths
+		_, _ = r.Write(nonce)
+		y := r.final(element{0, r.total * 8})
+		binary.BigEndian.PutUint64(j0[:], y.hi)
+		binary.BigEndian.PutUint64(j0[8:], y.lo)
+		r.Reset()
+	}
+
+	block.Encrypt(r.mask[:], j0[:])
+
+	return r, nil
+}
+
+// implementation of the hash.Hash
+
+// Reset resets the hash.Hash to its initial state.
+func (r *model) Reset() {
+	r.y = element{}
+	r.bufLen = 0
+	r.total = 0
+}
+
+// Size returns the number of bytes Sum will return.
+func (r *model) Size() int { return Size }
+
+// BlockSize returns the hash's underlying block size.
+func (r *model) BlockSize() int { return BlockSize }
+
+// Write appends the data to the digest.
+func (r *model) Write(p []byte) (int, error) {
+	n := len(p)
+	r.total += uint64(n)
+
+	if r.bufLen > 0 {
+		x := copy(r.buf[r.bufLen:], p)
+		r.bufLen += x
+		p = p[x:]
+
+		if r.bufLen < BlockSize {
+			return n, nil
+		}
+
+		r.y = r.update(r.y, r.buf[:])
+		r.bufLen = 0
+	}
+
+	for ; len(p) >= BlockSize; p = p[BlockSize:] {
+		r.y = r.update(r.y, p)
+	}
+
+	r.bufLen = copy(r.buf[:], p)
+
+	return n, nil
+}
+
+// Sum appends the current checksum to b and returns the resulting slice, it does not change the underlying state.
+func (r *model) Sum(b []byte) []byte {
+	y := r.final(element{r.total * 8, 0})
+
+	var tag [Size]byte
+	binary.BigEndian.PutUint64(tag[:], y.hi)
+	binary.BigEndian.PutUint64(tag[8:], y.lo)
+
+	for i := range tag {
+		tag[i] ^= r.mask[i]
+	}
+
+	return append(b, tag[:]...)
+}
+
+// private
+
+// final returns GHASH of the data padded to the blocks and the block of the lengths in bits, which are the lengths
+// of the data and of the empty ciphertext for the tag.
+func (r *model) final(lengths element) element {
+	y := r.y
+
+	if r.bufLen > 0 {
+		var last [BlockSize]byte
+		copy(last[:], r.buf[:r.bufLen])
+		y = r.update(y, last[:])
+	}
+
+	return multiply(element{y.hi ^ lengths.hi, y.lo ^ lengths.lo}, r.key)
+}
+
+// update returns the product of the xor of y and the block with the hash key.
+func (r *model) update(y element, block []byte) element {
+	x := load(block)
+
+	return multiply(element{y.hi ^ x.hi, y.lo ^ x.lo}, r.key)
+}
+
+// load returns the element of the block.
+func load(block []byte) element {
+	return element{binary.BigEndian.Uint64(block), binary.BigEndian.Uint64(block[8:])}
+}
+
+// multiply returns the product of x and y in the field of GHASH, in constant time by the masks of the bits.
+func multiply(x, y element) element {
+	var z element
+	v := y
+
+	for i := 0; i < 128; i++ {
+		var bit uint64
+		if i < 64 {
+			bit = x.hi >> (63 - i) & 1
+		} else {
+			bit = x.lo >> (127 - i) & 1
+		}
+
+		z.hi ^= v.hi & -bit
+		z.lo ^= v.lo & -bit
+
+		// the division by x is reduced by the polynomial x^128 + x^7 + x^2 + x + 1
+		carry := v.lo & 1
+		v.lo = v.lo>>1 | v.hi<<63
+		v.hi = v.hi>>1 ^ 0xe1<<56&-carry
+	}
+
+	return z
+}
@@ -0,0 +1,102 @@
+package gmac
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"encoding/hex"
+	"errors"
+	"testing"
+)
+
+func decodeHex(s string) []byte {
+	b, err := hex.DecodeString(s)
+	if err != nil {
+		panic(err)
+	}
+
+	return b
+}
+
+func TestVectors(t *testing.T) {
+	msg := decodeHex("6bc1bee22e409f96e93d7e117393172aae2d8a571e03ac9c9eb76fac45af8e51" +
+		"30c81c46a35ce411e5fbc1191a0a52eff69f2445df4f9b17ad2b417be66c3710")
+	aes128 := decodeHex("2b7e151628aed2a6abf7158809cf4f3c")
+	aes256 := decodeHex("603deb1015ca71be2b73aef0857d77811f352c073b6108d72d9810a30914dff4")
+
+	// the test case 1 of GCM and the tags of OpenSSL
+	vectors := []struct {
+		key      []byte
+		nonce    string
+		length   int
+		expected string
+	}{
+		{make([]byte, 16), "000000000000000000000000", 0, "58e2fccefa7e3061367f1d57a4e7455a"},
+		{aes128, "000102030405060708090a0b", 0, "a715b99567eaea4806b3a91c785f11cc"},
+		{aes128, "000102030405060708090a0b", 16, "1f8672178fb5d61cc42b0802f958d913"},
+		{aes128, "000102030405060708090a0b", 40, "650d72395df8e86abb44f8c34007d0ad"},
+		{aes128, "0001020304050607", 64, "dce93bea7282a13a7b0d49771aae0401"},
+		{aes256, "000102030405060708090a0b0c0d0e0f1011", 64, "beb471d1435ced80691cc837d80a292d"},
+	}
+
+	for _, v := range vectors {
+		block, _ := aes.NewCipher(v.key)
+
+		h, err := New(block, decodeHex(v.nonce))
+		if err != nil {
+			t.Fatalf("nonce %s failed: %s", v.nonce, err)
+		}
+
+		h.Write(msg[:v.length/3])
+		h.Write(msg[v.length/3 : v.length])
+
+		if got := hex.EncodeToString(h.Sum(nil)); got != v.expected {
+			t.Errorf("GMAC of %d bytes with the nonce %s is wrong:\n\texpected \"%s\"\n\tgot \"%s\"", v.length, v.nonce, v.expected, got)
+		}
+	}
+}
+
+func TestGCM(t *testing.T) {
+	block, _ := aes.NewCipher(decodeHex("feffe9928665731c6d6a8f9467308308"))
+	data := make([]byte, 100)
+
+	for i := range data {
+		data[i] = byte(i * 7)
+	}
+
+	for _, nonceSize := range []int{1, 8, NonceSize, 16, 60} {
+		nonce := data[:nonceSize]
+
+		aead, _ := cipher.NewGCMWithNonceSize(block, nonceSize)
+		h, _ := New(block, nonce)
+
+		for length := 0; length <= len(data); length += 11 {
+			h.Reset()
+			h.Write(data[:length])
+
+			if got, expected := h.Sum(nil), aead.Seal(nil, nonce, nil, data[:length]); hex.EncodeToString(got) != hex.EncodeToString(expected) {
+				t.Errorf("GMAC of %d bytes with a nonce of %d bytes is wrong:\n\texpected \"%x\"\n\tgot \"%x\"", length, nonceSize, expected, got)
+			}
+		}
+	}
+}
+
+func TestErrors(t *testing.T) {
+	block, _ := aes.NewCipher(make([]byte, 16))
+
+	if _, err := New(block, nil); !errors.Is(err, ErrNonceSize) {
+		t.Errorf("empty nonce is accepted: %v", err)
+	}
+}
+
+func BenchmarkAES128(b *testing.B) {
+	block, _ := aes.NewCipher(make([]byte, 16))
+	h, _ := New(block, make([]byte, NonceSize))
+	data := make([]byte, 8192)
+
+	b.SetBytes(int64(len(data)))
+	for i := 0; i < b.N; i++ {
+		h.Reset()
+		h.Write(data)
+		h.Sum(nil)
+	}
+}
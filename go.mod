@@ -5,6 +5,5 @@ go 1.21.1
 require (
 	github.com/highdeger/vexillum v1.0.0
 	golang.org/x/crypto v0.14.0
+	golang.org/x/sys v0.13.0
 )
-
-require golang.org/x/sys v0.13.0 // indirect
@@ -0,0 +1,15 @@
+// Package gost94 implements the GOST R 34.11-94 hash algorithm, which is built on the GOST 28147-89 block cipher.
+//
+// The vectors of 256 bits are kept as little-endian words, so the bytes of the messages are
+// processed in their order and the checksum is the state written in little-endian.
+package gost94
+
+import "hash"
+
+// New creates a new GOST R 34.11-94 hash.Hash with the S-box, like TestParamSet or CryptoProParamSet.
+func New(sBox *SBox) hash.Hash {
+	h := &model{tables: expand(sBox)}
+	h.Reset()
+
+	return h
+}
@@ -0,0 +1,47 @@
+package gost94
+
+import (
+	"encoding/hex"
+	"testing"
+)
+
+func TestExamples(t *testing.T) {
+	cases := []struct {
+		sBox     *SBox
+		input    string
+		expected string
+	}{
+		// the examples of the appendix A of GOST R 34.11-94
+		{&TestParamSet, "This is message, length=32 bytes", "b1c466d37519b82e8319819ff32595e047a28cb6f83eff1c6916a815a637fffa"},
+		{&TestParamSet, "Suppose the original message has length = 50 bytes", "471aba57a60a770d3a76130635c1fbea4ef14de51f78b4ae57dd893b62f55208"},
+		// short messages, as computed by the gosthash94 functions of Nettle
+		{&TestParamSet, "", "ce85b99cc46752fffee35cab9a7b0278abb4c2d2055cff685af4912c49490f8d"},
+		{&TestParamSet, "abc", "f3134348c44fb1b2a277729e2285ebb5cb5e0f29c975bc753b70497c06a4d51d"},
+		// the same messages with the CryptoPro S-box, as computed by Nettle
+		{&CryptoProParamSet, "This is message, length=32 bytes", "2cefc2f7b7bdc514e18ea57fa74ff357e7fa17d652c75f69cb1be7893ede48eb"},
+		{&CryptoProParamSet, "Suppose the original message has length = 50 bytes", "c3730c5cbccacf915ac292676f21e8bd4ef75331d9405e5f1a61dc3130a65011"},
+		{&CryptoProParamSet, "", "981e5f3ca30c841487830f84fb433e13ac1101569b9c13584ac483234cd656c0"},
+		{&CryptoProParamSet, "abc", "b285056dbf18d7392d7677369524dd14747459ed8143997e163b2986f92fd42c"},
+	}
+
+	for _, c := range cases {
+		h := New(c.sBox)
+		h.Write([]byte(c.input))
+
+		if got := hex.EncodeToString(h.Sum(nil)); got != c.expected {
+			t.Errorf("sum of \"%s\" is wrong:\n\texpected \"%s\"\n\tgot \"%s\"", c.input, c.expected, got)
+		}
+	}
+}
+
+func BenchmarkGost94(b *testing.B) {
+	h := New(&CryptoProParamSet)
+	data := make([]byte, 8192)
+
+	b.SetBytes(int64(len(data)))
+	for i := 0; i < b.N; i++ {
+		h.Reset()
+		h.Write(data)
+		h.Sum(nil)
+	}
+}
@@ -0,0 +1,216 @@
+package gost94
+
+import (
+	"encoding/binary"
+	"math/bits"
+)
+
+// model represents a structure for the GOST R 34.11-94 hash.Hash.
+type model struct {
+	tables         *[4][256]uint32
+	state          [4]uint64
+	sum            [4]uint64 // the sum of the processed blocks, Σ in the standard
+	buffer         [BlockSize]byte
+	bufferIndex    int
+	processedBytes uint64
+}
+
+// implementation of the hash.Hash
+
+// Reset resets the hash.Hash to its initial state.
+func (r *model) Reset() {
+	r.state = [4]uint64{}
+	r.sum = [4]uint64{}
+	r.bufferIndex = 0
+	r.processedBytes = 0
+}
+
+// Size returns the number of bytes Sum will return.
+func (r *model) Size() int { return Size }
+
+// BlockSize returns the hash's underlying block size.
+// The Write method must be able to accept any amount of data,
+// but it may operate more efficiently if all writes are a multiple of the block size.
+func (r *model) BlockSize() int { return BlockSize }
+
+// Write appends the data to the digest.
+func (r *model) Write(p []byte) (int, error) {
+	n := len(p)
+	r.processedBytes += uint64(n)
+
+	if r.bufferIndex > 0 {
+		x := copy(r.buffer[r.bufferIndex:], p)
+		r.bufferIndex += x
+		p = p[x:]
+
+		if r.bufferIndex < BlockSize {
+			return n, nil
+		}
+
+		r.block(r.buffer[:])
+		r.bufferIndex = 0
+	}
+
+	for ; len(p) >= BlockSize; p = p[BlockSize:] {
+		r.block(p)
+	}
+
+	r.bufferIndex = copy(r.buffer[:], p)
+
+	return n, nil
+}
+
+// Sum appends the current hash to b and returns the resulting slice.
+// It does not change the underlying hash state.
+func (r *model) Sum(b []byte) []byte {
+	// copy the model to allow other writes to continue and to prevent change of the state
+	s := *r
+
+	// the last partial block is padded by zeros, an empty one is not processed
+	if s.bufferIndex > 0 {
+		padded := s.buffer
+		for i := s.bufferIndex; i < BlockSize; i++ {
+			padded[i] = 0
+		}
+
+		s.block(padded[:])
+	}
+
+	s.compress(&[4]uint64{s.processedBytes << 3, s.processedBytes >> 61})
+	s.compress(&s.sum)
+
+	var digest [Size]byte
+	for i, v := range s.state {
+		binary.LittleEndian.PutUint64(digest[8*i:], v)
+	}
+
+	return append(b, digest[:]...)
+}
+
+// private
+
+// expand expands the S-box into tables which substitute a byte and rotate the result by 11 bits.
+func expand(sBox *SBox) *[4][256]uint32 {
+	tables := new([4][256]uint32)
+	for k := range tables {
+		for x := range tables[k] {
+			v := uint32(sBox[2*k+1][x>>4])<<4 | uint32(sBox[2*k][x&0xf])
+			tables[k][x] = bits.RotateLeft32(v<<(8*k), 11)
+		}
+	}
+
+	return tables
+}
+
+// block processes a full block of the message.
+func (r *model) block(p []byte) {
+	var m [4]uint64
+	for i := range m {
+		m[i] = binary.LittleEndian.Uint64(p[8*i:])
+	}
+
+	r.compress(&m)
+
+	var carry uint64
+	for i := range r.sum {
+		r.sum[i], carry = bits.Add64(r.sum[i], m[i], carry)
+	}
+}
+
+// round is the round function of GOST 28147-89.
+func (r *model) round(x uint32) uint32 {
+	return r.tables[0][byte(x)] ^ r.tables[1][byte(x>>8)] ^ r.tables[2][byte(x>>16)] ^ r.tables[3][byte(x>>24)]
+}
+
+// encrypt encrypts a block by GOST 28147-89 in the simple substitution mode.
+func (r *model) encrypt(key *[8]uint32, block uint64) uint64 {
+	n1, n2 := uint32(block), uint32(block>>32)
+
+	// the key is used three times in order and once in reverse order
+	for i := 0; i < 32; i++ {
+		k := key[i%8]
+		if i >= 24 {
+			k = key[7-i%8]
+		}
+
+		n1, n2 = n2^r.round(n1+k), n1
+	}
+
+	// the last round does not swap the halves
+	return uint64(n1)<<32 | uint64(n2)
+}
+
+// transform is the linear transformation A of the key generation.
+func transform(y [4]uint64) [4]uint64 {
+	return [4]uint64{y[1], y[2], y[3], y[0] ^ y[1]}
+}
+
+// permute is the byte permutation P of the key generation.
+func permute(w [4]uint64) (key [8]uint32) {
+	for k := range key {
+		for i, v := range w {
+			key[k] |= uint32(byte(v>>(8*k))) << (8 * i)
+		}
+	}
+
+	return key
+}
+
+// shift is the shift register ψ of the mixing transformation.
+func shift(y *[4]uint64) {
+	v := y[0] ^ y[0]>>16 ^ y[0]>>32 ^ y[0]>>48 ^ y[3] ^ y[3]>>48
+
+	y[0] = y[0]>>16 | y[1]<<48
+	y[1] = y[1]>>16 | y[2]<<48
+	y[2] = y[2]>>16 | y[3]<<48
+	y[3] = y[3]>>16 | v<<48
+}
+
+// compress is the step hash function, it mixes the block m into the state.
+func (r *model) compress(m *[4]uint64) {
+	// key generation
+	var keys [4][8]uint32
+
+	u, v := r.state, *m
+	for j := range keys {
+		if j > 0 {
+			u = transform(u)
+			if j == 2 {
+				for i := range u {
+					u[i] ^= c3[i]
+				}
+			}
+
+			v = transform(transform(v))
+		}
+
+		keys[j] = permute([4]uint64{u[0] ^ v[0], u[1] ^ v[1], u[2] ^ v[2], u[3] ^ v[3]})
+	}
+
+	// encryption
+	var s [4]uint64
+	for i := range s {
+		s[i] = r.encrypt(&keys[i], r.state[i])
+	}
+
+	// mixing transformation
+	for i := 0; i < 12; i++ {
+		shift(&s)
+	}
+
+	for i := range s {
+		s[i] ^= m[i]
+	}
+
+	shift(&s)
+
+	for i := range s {
+		s[i] ^= r.state[i]
+	}
+
+	for i := 0; i < 61; i++ {
+		shift(&s)
+	}
+
+	r.state = s
+}
@@ -0,0 +1,31 @@
+package groestl
+
+const (
+	// Size224 is the size of a Groestl-224 checksum in bytes.
+	Size224 = 28
+	// Size256 is the size of a Groestl-256 checksum in bytes.
+	Size256 = 32
+	// Size384 is the size of a Groestl-384 checksum in bytes.
+	Size384 = 48
+	// Size512 is the size of a Groestl-512 checksum in bytes.
+	Size512 = 64
+	// BlockSize256 the block size of Groestl-224 and Groestl-256 in bytes.
+	BlockSize256 = 64
+	// BlockSize512 the block size of Groestl-384 and Groestl-512 in bytes.
+	BlockSize512 = 128
+
+	// maxColumns is the number of columns of the wide state.
+	maxColumns = BlockSize512 / 8
+)
+
+// permutation represents the P and Q permutations of a state width.
+type permutation struct {
+	columns int
+	p       func(state *[maxColumns]uint64)
+	q       func(state *[maxColumns]uint64)
+}
+
+var (
+	permutation512  = permutation{columns: 8, p: permuteP512, q: permuteQ512}
+	permutation1024 = permutation{columns: 16, p: permuteP1024, q: permuteQ1024}
+)
@@ -0,0 +1,29 @@
+// Package groestl implements the Groestl hash algorithm of the final round of the SHA-3 competition,
+// with the 512 bits permutations for the sizes up to 256 bits and the 1024 bits ones for the larger sizes.
+//
+// The state is kept as big-endian columns, so the first row of the state is the most significant byte of each word.
+package groestl
+
+import "hash"
+
+//go:generate go run gen.go
+
+// New224 creates a new Groestl-224 hash.Hash.
+func New224() hash.Hash { return newModel(Size224, &permutation512) }
+
+// New256 creates a new Groestl-256 hash.Hash.
+func New256() hash.Hash { return newModel(Size256, &permutation512) }
+
+// New384 creates a new Groestl-384 hash.Hash.
+func New384() hash.Hash { return newModel(Size384, &permutation1024) }
+
+// New512 creates a new Groestl-512 hash.Hash.
+func New512() hash.Hash { return newModel(Size512, &permutation1024) }
+
+// newModel creates a new Groestl model of the size with the permutations.
+func newModel(size int, p *permutation) *model {
+	h := &model{size: size, permutation: p}
+	h.Reset()
+
+	return h
+}
@@ -0,0 +1,205 @@
+//go:build ignore
+
+// This program generates tables.go and permute.go, run it with "go generate".
+//
+// The S-box is the one of AES, the multiplicative inverse in GF(2^8) followed by the affine transformation,
+// and the tables combine it with the columns of the circulant matrix circ(2, 2, 3, 4, 5, 3, 5, 7)
+// of MixBytes, over GF(2^8) with the reduction polynomial x^8 + x^4 + x^3 + x + 1.
+//
+// The generated permutations keep the columns in variables, so ShiftBytes is done by the indexing of the tables.
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"math/bits"
+	"os"
+	"strings"
+)
+
+// permutation represents the parameters of a P or Q permutation.
+type permutation struct {
+	name    string
+	columns int
+	rounds  int
+	// shifts are the numbers of columns each row is shifted to the left by ShiftBytes.
+	shifts [8]int
+	q      bool
+}
+
+var permutations = []permutation{
+	{name: "P512", columns: 8, rounds: 10, shifts: [8]int{0, 1, 2, 3, 4, 5, 6, 7}},
+	{name: "Q512", columns: 8, rounds: 10, shifts: [8]int{1, 3, 5, 7, 0, 2, 4, 6}, q: true},
+	{name: "P1024", columns: 16, rounds: 14, shifts: [8]int{0, 1, 2, 3, 4, 5, 6, 11}},
+	{name: "Q1024", columns: 16, rounds: 14, shifts: [8]int{1, 3, 5, 11, 0, 2, 4, 6}, q: true},
+}
+
+// matrix is the first row of the circulant matrix of MixBytes.
+var matrix = [8]byte{2, 2, 3, 4, 5, 3, 5, 7}
+
+// multiply multiplies in GF(2^8) modulo x^8 + x^4 + x^3 + x + 1.
+func multiply(a, b byte) byte {
+	var p byte
+	for ; b != 0; b >>= 1 {
+		if b&1 != 0 {
+			p ^= a
+		}
+
+		carry := a & 0x80
+		a <<= 1
+
+		if carry != 0 {
+			a ^= 0x1b
+		}
+	}
+
+	return p
+}
+
+// sbox returns the S-box of AES.
+func sbox() (s [256]byte) {
+	for x := range s {
+		// the inverse is x^254, zero is mapped to zero
+		inverse := byte(1)
+		for i := 0; i < 254; i++ {
+			inverse = multiply(inverse, byte(x))
+		}
+
+		if x == 0 {
+			inverse = 0
+		}
+
+		s[x] = inverse ^ bits.RotateLeft8(inverse, 1) ^ bits.RotateLeft8(inverse, 2) ^
+			bits.RotateLeft8(inverse, 3) ^ bits.RotateLeft8(inverse, 4) ^ 0x63
+	}
+
+	return s
+}
+
+func main() {
+	s := sbox()
+
+	// the table of the row i is the column produced by a byte of the row i, the row 0 is the most significant byte
+	var tables [8][256]uint64
+	for i := range tables {
+		for x := range tables[i] {
+			var v uint64
+			for r := 0; r < 8; r++ {
+				v = v<<8 | uint64(multiply(matrix[(i-r+8)%8], s[x]))
+			}
+
+			tables[i][x] = v
+		}
+	}
+
+	var b bytes.Buffer
+
+	fmt.Fprintln(&b, "// Code generated by gen.go. DO NOT EDIT.")
+	fmt.Fprintln(&b)
+	fmt.Fprintln(&b, "package groestl")
+	fmt.Fprintln(&b)
+	fmt.Fprintln(&b, "// tables are the S-box combined with the columns of the matrix of MixBytes, one for each row of the state.")
+	fmt.Fprintln(&b, "var tables = [8][256]uint64{")
+
+	for _, table := range tables {
+		fmt.Fprintln(&b, "{")
+
+		for i := 0; i < 256; i += 4 {
+			for j := i; j < i+4; j++ {
+				fmt.Fprintf(&b, "0x%016x, ", table[j])
+			}
+
+			fmt.Fprintln(&b)
+		}
+
+		fmt.Fprintln(&b, "},")
+	}
+
+	fmt.Fprintln(&b, "}")
+
+	src, err := format.Source(b.Bytes())
+	if err != nil {
+		panic(err)
+	}
+
+	if err = os.WriteFile("tables.go", src, 0o644); err != nil {
+		panic(err)
+	}
+
+	b.Reset()
+
+	fmt.Fprintln(&b, "// Code generated by gen.go. DO NOT EDIT.")
+	fmt.Fprintln(&b)
+	fmt.Fprintln(&b, "package groestl")
+
+	for _, p := range permutations {
+		permute(&b, p)
+	}
+
+	if src, err = format.Source(b.Bytes()); err != nil {
+		panic(err)
+	}
+
+	if err = os.WriteFile("permute.go", src, 0o644); err != nil {
+		panic(err)
+	}
+}
+
+// permute writes the function of the permutation, which permutes the state in place.
+func permute(b *bytes.Buffer, p permutation) {
+	x := make([]string, p.columns)
+	y := make([]string, p.columns)
+	for j := range x {
+		x[j] = fmt.Sprintf("x%d", j)
+		y[j] = fmt.Sprintf("y%d", j)
+	}
+
+	fmt.Fprintln(b)
+	fmt.Fprintf(b, "// permute%s applies the %s permutation to the state.\n", p.name, p.name)
+	fmt.Fprintf(b, "func permute%s(state *[maxColumns]uint64) {\n", p.name)
+
+	for j := range x {
+		fmt.Fprintf(b, "%s := state[%d]\n", x[j], j)
+	}
+
+	fmt.Fprintln(b)
+	fmt.Fprintf(b, "for round := uint64(0); round < %d; round++ {\n", p.rounds)
+	fmt.Fprintln(b, "// AddRoundConstant")
+
+	for j := range x {
+		if p.q {
+			fmt.Fprintf(b, "%s ^= 0x%016x ^ round\n", x[j], ^uint64(j<<4))
+		} else {
+			fmt.Fprintf(b, "%s ^= (0x%02x ^ round) << 56\n", x[j], j<<4)
+		}
+	}
+
+	fmt.Fprintln(b)
+	fmt.Fprintln(b, "// SubBytes, ShiftBytes and MixBytes")
+
+	for j := range y {
+		fmt.Fprintf(b, "%s := ", y[j])
+
+		for i, shift := range p.shifts {
+			if i > 0 {
+				fmt.Fprint(b, " ^ ")
+			}
+
+			fmt.Fprintf(b, "tables[%d][byte(%s>>%d)]", i, x[(j+shift)%p.columns], 56-8*i)
+		}
+
+		fmt.Fprintln(b)
+	}
+
+	fmt.Fprintln(b)
+	fmt.Fprintf(b, "%s = %s\n", strings.Join(x, ", "), strings.Join(y, ", "))
+	fmt.Fprintln(b, "}")
+	fmt.Fprintln(b)
+
+	for j := range x {
+		fmt.Fprintf(b, "state[%d] = %s\n", j, x[j])
+	}
+
+	fmt.Fprintln(b, "}")
+}
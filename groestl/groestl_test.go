@@ -0,0 +1,60 @@
+package groestl
+
+import (
+	"encoding/hex"
+	"hash"
+	"strings"
+	"testing"
+)
+
+func TestExamples(t *testing.T) {
+	cases := []struct {
+		newHash  func() hash.Hash
+		input    string
+		expected string
+	}{
+		// the empty message of the known answer tests of the submission
+		{New224, "", "f2e180fb5947be964cd584e22e496242c6a329c577fc4ce8c36d34c3"},
+		{New256, "", "1a52d11d550039be16107f9c58db9ebcc417f16f736adb2502567119f0083467"},
+		{New384, "", "ac353c1095ace21439251007862d6c62f829ddbe6de4f78e68d310a9205a736d8b11d99bffe448f57a1cfa2934f044a5"},
+		{New512, "", "6d3ad29d279110eef3adbd66de2a0345a77baede1557f5d099fce0c03d6dc2ba8e6d4a6633dfbd66053c20faa87d1a11f39a7fbe4a6c2f009801370308fc4ad8"},
+		{New256, "The quick brown fox jumps over the lazy dog", "8c7ad62eb26a21297bc39c2d7293b4bd4d3399fa8afab29e970471739e28b301"},
+		{New256, "The quick brown fox jumps over the lazy dog.", "f48290b1bcacee406a0429b993adb8fb3d065f4b09cbcdb464a631d4a0080aaf"},
+		// the messages at the padding boundaries of the blocks of 64 and 128 bytes, the padding needs at least 9 bytes,
+		// and the messages of several blocks, computed with an independent implementation of the specification
+		{New224, strings.Repeat("a", 56), "782bd201644849b66e6a9cb53f17c2933a2210480e30384a28a24234"},
+		{New256, strings.Repeat("a", 55), "cdad09eab7f1875ea6fc59e6d939a3071ffe9bfe57926231d3b5a347e23dcad4"},
+		{New256, strings.Repeat("a", 56), "2490f220ca32d170cb958df8d11600461f658cc767d1b92c1f57e9614084e3d6"},
+		{New256, strings.Repeat("a", 64), "56e6d76870910b6d4258c6f5fdbee846873f94437d6409ab53922b91ce4afe8c"},
+		{New256, strings.Repeat("a", 200), "87db96bdae4b4f99f90a0fb72686b0ca44cdeee3381b491cf634552ff2e458cd"},
+		{New384, strings.Repeat("a", 120), "16dc59905612547b6b0c193134fe3930178ac14188c429af86976a1a7e6bb252d15be7a588bb1bb64af35f8a4228b7db"},
+		{New512, strings.Repeat("a", 119), "05379d6eb1dec550d6c97258fece314a3a5230bafc6a780ae0e55273cd2889c56196d3279654fabb7f755c4a48bf7c5dad8455c952bd161058e48706551e35e0"},
+		{New512, strings.Repeat("a", 120), "6e90753dd04f2bdab81666f8ca12205e41cc0ff154f10b34517212bc37d2e852a86c583b0685c1081f0a7f743b81b8e00f52e2def11fea21e3c7dc7f76a1bcfb"},
+		{New512, strings.Repeat("a", 128), "67aaf4835a3bfac29dc0413172af0f73d5c452c4ab7318535c6b4c4fa9fb87d4a5aaeba1a39ff272b795d6e3f72ecd8d3537e2b94a1682ac7c485ed324de8036"},
+		{New512, strings.Repeat("a", 300), "9f209d209e6d07eef7acf3f5c9bd3e9d71729f254709fbeae48de271867521df8bc592d8070d21fcdb278164e25454db02661b92a7f49d2e9682f2466540e0cc"},
+	}
+
+	for _, c := range cases {
+		h := c.newHash()
+		h.Write([]byte(c.input))
+
+		if got := hex.EncodeToString(h.Sum(nil)); got != c.expected {
+			t.Errorf("sum of \"%s\" of size %d is wrong:\n\texpected \"%s\"\n\tgot \"%s\"", c.input, h.Size(), c.expected, got)
+		}
+	}
+}
+
+func benchmarkGroestl(b *testing.B, h hash.Hash) {
+	data := make([]byte, 8192)
+
+	b.SetBytes(int64(len(data)))
+	for i := 0; i < b.N; i++ {
+		h.Reset()
+		h.Write(data)
+		h.Sum(nil)
+	}
+}
+
+func BenchmarkGroestl256(b *testing.B) { benchmarkGroestl(b, New256()) }
+
+func BenchmarkGroestl512(b *testing.B) { benchmarkGroestl(b, New512()) }
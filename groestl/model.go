@@ -0,0 +1,112 @@
+package groestl
+
+import "encoding/binary"
+
+// model represents a structure for the Groestl hash.Hash.
+type model struct {
+	size        int
+	permutation *permutation
+	state       [maxColumns]uint64
+	buffer      [BlockSize512]byte
+	bufferIndex int
+	blocks      uint64
+}
+
+// implementation of the hash.Hash
+
+// Reset resets the hash.Hash to its initial state.
+func (r *model) Reset() {
+	// the initial value is the size in bits in the last column
+	r.state = [maxColumns]uint64{}
+	r.state[r.permutation.columns-1] = uint64(r.size) << 3
+	r.bufferIndex = 0
+	r.blocks = 0
+}
+
+// Size returns the number of bytes Sum will return.
+func (r *model) Size() int { return r.size }
+
+// BlockSize returns the hash's underlying block size.
+// The Write method must be able to accept any amount of data,
+// but it may operate more efficiently if all writes are a multiple of the block size.
+func (r *model) BlockSize() int { return 8 * r.permutation.columns }
+
+// Write appends the data to the digest.
+func (r *model) Write(p []byte) (int, error) {
+	n := len(p)
+	blockSize := r.BlockSize()
+
+	if r.bufferIndex > 0 {
+		x := copy(r.buffer[r.bufferIndex:blockSize], p)
+		r.bufferIndex += x
+		p = p[x:]
+
+		if r.bufferIndex < blockSize {
+			return n, nil
+		}
+
+		r.compress(r.buffer[:blockSize])
+		r.bufferIndex = 0
+	}
+
+	for ; len(p) >= blockSize; p = p[blockSize:] {
+		r.compress(p)
+	}
+
+	r.bufferIndex = copy(r.buffer[:], p)
+
+	return n, nil
+}
+
+// Sum appends the current hash to b and returns the resulting slice.
+// It does not change the underlying hash state.
+func (r *model) Sum(b []byte) []byte {
+	// copy the model to allow other writes to continue and to prevent change of the state
+	s := *r
+	blockSize := s.BlockSize()
+
+	// the padding is a one bit, zeros and the number of blocks including the padding ones
+	var padding [2 * BlockSize512]byte
+	padding[0] = 0x80
+
+	n := blockSize - 8 - s.bufferIndex
+	if n < 1 {
+		n += blockSize
+	}
+
+	blocks := s.blocks + uint64(s.bufferIndex+n+8)/uint64(blockSize)
+	binary.BigEndian.PutUint64(padding[n:], blocks)
+	_, _ = s.Write(padding[:n+8])
+
+	// the output transformation is the truncation of P(h) ^ h
+	columns := s.permutation.columns
+	x := s.state
+	s.permutation.p(&x)
+
+	var output [BlockSize512]byte
+	for j := 0; j < columns; j++ {
+		binary.BigEndian.PutUint64(output[8*j:], x[j]^s.state[j])
+	}
+
+	return append(b, output[blockSize-s.size:blockSize]...)
+}
+
+// private
+
+// compress compresses a block into the state, h = P(h ^ m) ^ Q(m) ^ h.
+func (r *model) compress(block []byte) {
+	var p, q [maxColumns]uint64
+	for j := 0; j < r.permutation.columns; j++ {
+		q[j] = binary.BigEndian.Uint64(block[8*j:])
+		p[j] = r.state[j] ^ q[j]
+	}
+
+	r.permutation.p(&p)
+	r.permutation.q(&q)
+
+	for j := 0; j < r.permutation.columns; j++ {
+		r.state[j] ^= p[j] ^ q[j]
+	}
+
+	r.blocks++
+}
@@ -0,0 +1,247 @@
+// Code generated by gen.go. DO NOT EDIT.
+
+package groestl
+
+// permuteP512 applies the P512 permutation to the state.
+func permuteP512(state *[maxColumns]uint64) {
+	x0 := state[0]
+	x1 := state[1]
+	x2 := state[2]
+	x3 := state[3]
+	x4 := state[4]
+	x5 := state[5]
+	x6 := state[6]
+	x7 := state[7]
+
+	for round := uint64(0); round < 10; round++ {
+		// AddRoundConstant
+		x0 ^= (0x00 ^ round) << 56
+		x1 ^= (0x10 ^ round) << 56
+		x2 ^= (0x20 ^ round) << 56
+		x3 ^= (0x30 ^ round) << 56
+		x4 ^= (0x40 ^ round) << 56
+		x5 ^= (0x50 ^ round) << 56
+		x6 ^= (0x60 ^ round) << 56
+		x7 ^= (0x70 ^ round) << 56
+
+		// SubBytes, ShiftBytes and MixBytes
+		y0 := tables[0][byte(x0>>56)] ^ tables[1][byte(x1>>48)] ^ tables[2][byte(x2>>40)] ^ tables[3][byte(x3>>32)] ^ tables[4][byte(x4>>24)] ^ tables[5][byte(x5>>16)] ^ tables[6][byte(x6>>8)] ^ tables[7][byte(x7>>0)]
+		y1 := tables[0][byte(x1>>56)] ^ tables[1][byte(x2>>48)] ^ tables[2][byte(x3>>40)] ^ tables[3][byte(x4>>32)] ^ tables[4][byte(x5>>24)] ^ tables[5][byte(x6>>16)] ^ tables[6][byte(x7>>8)] ^ tables[7][byte(x0>>0)]
+		y2 := tables[0][byte(x2>>56)] ^ tables[1][byte(x3>>48)] ^ tables[2][byte(x4>>40)] ^ tables[3][byte(x5>>32)] ^ tables[4][byte(x6>>24)] ^ tables[5][byte(x7>>16)] ^ tables[6][byte(x0>>8)] ^ tables[7][byte(x1>>0)]
+		y3 := tables[0][byte(x3>>56)] ^ tables[1][byte(x4>>48)] ^ tables[2][byte(x5>>40)] ^ tables[3][byte(x6>>32)] ^ tables[4][byte(x7>>24)] ^ tables[5][byte(x0>>16)] ^ tables[6][byte(x1>>8)] ^ tables[7][byte(x2>>0)]
+		y4 := tables[0][byte(x4>>56)] ^ tables[1][byte(x5>>48)] ^ tables[2][byte(x6>>40)] ^ tables[3][byte(x7>>32)] ^ tables[4][byte(x0>>24)] ^ tables[5][byte(x1>>16)] ^ tables[6][byte(x2>>8)] ^ tables[7][byte(x3>>0)]
+		y5 := tables[0][byte(x5>>56)] ^ tables[1][byte(x6>>48)] ^ tables[2][byte(x7>>40)] ^ tables[3][byte(x0>>32)] ^ tables[4][byte(x1>>24)] ^ tables[5][byte(x2>>16)] ^ tables[6][byte(x3>>8)] ^ tables[7][byte(x4>>0)]
+		y6 := tables[0][byte(x6>>56)] ^ tables[1][byte(x7>>48)] ^ tables[2][byte(x0>>40)] ^ tables[3][byte(x1>>32)] ^ tables[4][byte(x2>>24)] ^ tables[5][byte(x3>>16)] ^ tables[6][byte(x4>>8)] ^ tables[7][byte(x5>>0)]
+		y7 := tables[0][byte(x7>>56)] ^ tables[1][byte(x0>>48)] ^ tables[2][byte(x1>>40)] ^ tables[3][byte(x2>>32)] ^ tables[4][byte(x3>>24)] ^ tables[5][byte(x4>>16)] ^ tables[6][byte(x5>>8)] ^ tables[7][byte(x6>>0)]
+
+		x0, x1, x2, x3, x4, x5, x6, x7 = y0, y1, y2, y3, y4, y5, y6, y7
+	}
+
+	state[0] = x0
+	state[1] = x1
+	state[2] = x2
+	state[3] = x3
+	state[4] = x4
+	state[5] = x5
+	state[6] = x6
+	state[7] = x7
+}
+
+// permuteQ512 applies the Q512 permutation to the state.
+func permuteQ512(state *[maxColumns]uint64) {
+	x0 := state[0]
+	x1 := state[1]
+	x2 := state[2]
+	x3 := state[3]
+	x4 := state[4]
+	x5 := state[5]
+	x6 := state[6]
+	x7 := state[7]
+
+	for round := uint64(0); round < 10; round++ {
+		// AddRoundConstant
+		x0 ^= 0xffffffffffffffff ^ round
+		x1 ^= 0xffffffffffffffef ^ round
+		x2 ^= 0xffffffffffffffdf ^ round
+		x3 ^= 0xffffffffffffffcf ^ round
+		x4 ^= 0xffffffffffffffbf ^ round
+		x5 ^= 0xffffffffffffffaf ^ round
+		x6 ^= 0xffffffffffffff9f ^ round
+		x7 ^= 0xffffffffffffff8f ^ round
+
+		// SubBytes, ShiftBytes and MixBytes
+		y0 := tables[0][byte(x1>>56)] ^ tables[1][byte(x3>>48)] ^ tables[2][byte(x5>>40)] ^ tables[3][byte(x7>>32)] ^ tables[4][byte(x0>>24)] ^ tables[5][byte(x2>>16)] ^ tables[6][byte(x4>>8)] ^ tables[7][byte(x6>>0)]
+		y1 := tables[0][byte(x2>>56)] ^ tables[1][byte(x4>>48)] ^ tables[2][byte(x6>>40)] ^ tables[3][byte(x0>>32)] ^ tables[4][byte(x1>>24)] ^ tables[5][byte(x3>>16)] ^ tables[6][byte(x5>>8)] ^ tables[7][byte(x7>>0)]
+		y2 := tables[0][byte(x3>>56)] ^ tables[1][byte(x5>>48)] ^ tables[2][byte(x7>>40)] ^ tables[3][byte(x1>>32)] ^ tables[4][byte(x2>>24)] ^ tables[5][byte(x4>>16)] ^ tables[6][byte(x6>>8)] ^ tables[7][byte(x0>>0)]
+		y3 := tables[0][byte(x4>>56)] ^ tables[1][byte(x6>>48)] ^ tables[2][byte(x0>>40)] ^ tables[3][byte(x2>>32)] ^ tables[4][byte(x3>>24)] ^ tables[5][byte(x5>>16)] ^ tables[6][byte(x7>>8)] ^ tables[7][byte(x1>>0)]
+		y4 := tables[0][byte(x5>>56)] ^ tables[1][byte(x7>>48)] ^ tables[2][byte(x1>>40)] ^ tables[3][byte(x3>>32)] ^ tables[4][byte(x4>>24)] ^ tables[5][byte(x6>>16)] ^ tables[6][byte(x0>>8)] ^ tables[7][byte(x2>>0)]
+		y5 := tables[0][byte(x6>>56)] ^ tables[1][byte(x0>>48)] ^ tables[2][byte(x2>>40)] ^ tables[3][byte(x4>>32)] ^ tables[4][byte(x5>>24)] ^ tables[5][byte(x7>>16)] ^ tables[6][byte(x1>>8)] ^ tables[7][byte(x3>>0)]
+		y6 := tables[0][byte(x7>>56)] ^ tables[1][byte(x1>>48)] ^ tables[2][byte(x3>>40)] ^ tables[3][byte(x5>>32)] ^ tables[4][byte(x6>>24)] ^ tables[5][byte(x0>>16)] ^ tables[6][byte(x2>>8)] ^ tables[7][byte(x4>>0)]
+		y7 := tables[0][byte(x0>>56)] ^ tables[1][byte(x2>>48)] ^ tables[2][byte(x4>>40)] ^ tables[3][byte(x6>>32)] ^ tables[4][byte(x7>>24)] ^ tables[5][byte(x1>>16)] ^ tables[6][byte(x3>>8)] ^ tables[7][byte(x5>>0)]
+
+		x0, x1, x2, x3, x4, x5, x6, x7 = y0, y1, y2, y3, y4, y5, y6, y7
+	}
+
+	state[0] = x0
+	state[1] = x1
+	state[2] = x2
+	state[3] = x3
+	state[4] = x4
+	state[5] = x5
+	state[6] = x6
+	state[7] = x7
+}
+
+// permuteP1024 applies the P1024 permutation to the state.
+func permuteP1024(state *[maxColumns]uint64) {
+	x0 := state[0]
+	x1 := state[1]
+	x2 := state[2]
+	x3 := state[3]
+	x4 := state[4]
+	x5 := state[5]
+	x6 := state[6]
+	x7 := state[7]
+	x8 := state[8]
+	x9 := state[9]
+	x10 := state[10]
+	x11 := state[11]
+	x12 := state[12]
+	x13 := state[13]
+	x14 := state[14]
+	x15 := state[15]
+
+	for round := uint64(0); round < 14; round++ {
+		// AddRoundConstant
+		x0 ^= (0x00 ^ round) << 56
+		x1 ^= (0x10 ^ round) << 56
+		x2 ^= (0x20 ^ round) << 56
+		x3 ^= (0x30 ^ round) << 56
+		x4 ^= (0x40 ^ round) << 56
+		x5 ^= (0x50 ^ round) << 56
+		x6 ^= (0x60 ^ round) << 56
+		x7 ^= (0x70 ^ round) << 56
+		x8 ^= (0x80 ^ round) << 56
+		x9 ^= (0x90 ^ round) << 56
+		x10 ^= (0xa0 ^ round) << 56
+		x11 ^= (0xb0 ^ round) << 56
+		x12 ^= (0xc0 ^ round) << 56
+		x13 ^= (0xd0 ^ round) << 56
+		x14 ^= (0xe0 ^ round) << 56
+		x15 ^= (0xf0 ^ round) << 56
+
+		// SubBytes, ShiftBytes and MixBytes
+		y0 := tables[0][byte(x0>>56)] ^ tables[1][byte(x1>>48)] ^ tables[2][byte(x2>>40)] ^ tables[3][byte(x3>>32)] ^ tables[4][byte(x4>>24)] ^ tables[5][byte(x5>>16)] ^ tables[6][byte(x6>>8)] ^ tables[7][byte(x11>>0)]
+		y1 := tables[0][byte(x1>>56)] ^ tables[1][byte(x2>>48)] ^ tables[2][byte(x3>>40)] ^ tables[3][byte(x4>>32)] ^ tables[4][byte(x5>>24)] ^ tables[5][byte(x6>>16)] ^ tables[6][byte(x7>>8)] ^ tables[7][byte(x12>>0)]
+		y2 := tables[0][byte(x2>>56)] ^ tables[1][byte(x3>>48)] ^ tables[2][byte(x4>>40)] ^ tables[3][byte(x5>>32)] ^ tables[4][byte(x6>>24)] ^ tables[5][byte(x7>>16)] ^ tables[6][byte(x8>>8)] ^ tables[7][byte(x13>>0)]
+		y3 := tables[0][byte(x3>>56)] ^ tables[1][byte(x4>>48)] ^ tables[2][byte(x5>>40)] ^ tables[3][byte(x6>>32)] ^ tables[4][byte(x7>>24)] ^ tables[5][byte(x8>>16)] ^ tables[6][byte(x9>>8)] ^ tables[7][byte(x14>>0)]
+		y4 := tables[0][byte(x4>>56)] ^ tables[1][byte(x5>>48)] ^ tables[2][byte(x6>>40)] ^ tables[3][byte(x7>>32)] ^ tables[4][byte(x8>>24)] ^ tables[5][byte(x9>>16)] ^ tables[6][byte(x10>>8)] ^ tables[7][byte(x15>>0)]
+		y5 := tables[0][byte(x5>>56)] ^ tables[1][byte(x6>>48)] ^ tables[2][byte(x7>>40)] ^ tables[3][byte(x8>>32)] ^ tables[4][byte(x9>>24)] ^ tables[5][byte(x10>>16)] ^ tables[6][byte(x11>>8)] ^ tables[7][byte(x0>>0)]
+		y6 := tables[0][byte(x6>>56)] ^ tables[1][byte(x7>>48)] ^ tables[2][byte(x8>>40)] ^ tables[3][byte(x9>>32)] ^ tables[4][byte(x10>>24)] ^ tables[5][byte(x11>>16)] ^ tables[6][byte(x12>>8)] ^ tables[7][byte(x1>>0)]
+		y7 := tables[0][byte(x7>>56)] ^ tables[1][byte(x8>>48)] ^ tables[2][byte(x9>>40)] ^ tables[3][byte(x10>>32)] ^ tables[4][byte(x11>>24)] ^ tables[5][byte(x12>>16)] ^ tables[6][byte(x13>>8)] ^ tables[7][byte(x2>>0)]
+		y8 := tables[0][byte(x8>>56)] ^ tables[1][byte(x9>>48)] ^ tables[2][byte(x10>>40)] ^ tables[3][byte(x11>>32)] ^ tables[4][byte(x12>>24)] ^ tables[5][byte(x13>>16)] ^ tables[6][byte(x14>>8)] ^ tables[7][byte(x3>>0)]
+		y9 := tables[0][byte(x9>>56)] ^ tables[1][byte(x10>>48)] ^ tables[2][byte(x11>>40)] ^ tables[3][byte(x12>>32)] ^ tables[4][byte(x13>>24)] ^ tables[5][byte(x14>>16)] ^ tables[6][byte(x15>>8)] ^ tables[7][byte(x4>>0)]
+		y10 := tables[0][byte(x10>>56)] ^ tables[1][byte(x11>>48)] ^ tables[2][byte(x12>>40)] ^ tables[3][byte(x13>>32)] ^ tables[4][byte(x14>>24)] ^ tables[5][byte(x15>>16)] ^ tables[6][byte(x0>>8)] ^ tables[7][byte(x5>>0)]
+		y11 := tables[0][byte(x11>>56)] ^ tables[1][byte(x12>>48)] ^ tables[2][byte(x13>>40)] ^ tables[3][byte(x14>>32)] ^ tables[4][byte(x15>>24)] ^ tables[5][byte(x0>>16)] ^ tables[6][byte(x1>>8)] ^ tables[7][byte(x6>>0)]
+		y12 := tables[0][byte(x12>>56)] ^ tables[1][byte(x13>>48)] ^ tables[2][byte(x14>>40)] ^ tables[3][byte(x15>>32)] ^ tables[4][byte(x0>>24)] ^ tables[5][byte(x1>>16)] ^ tables[6][byte(x2>>8)] ^ tables[7][byte(x7>>0)]
+		y13 := tables[0][byte(x13>>56)] ^ tables[1][byte(x14>>48)] ^ tables[2][byte(x15>>40)] ^ tables[3][byte(x0>>32)] ^ tables[4][byte(x1>>24)] ^ tables[5][byte(x2>>16)] ^ tables[6][byte(x3>>8)] ^ tables[7][byte(x8>>0)]
+		y14 := tables[0][byte(x14>>56)] ^ tables[1][byte(x15>>48)] ^ tables[2][byte(x0>>40)] ^ tables[3][byte(x1>>32)] ^ tables[4][byte(x2>>24)] ^ tables[5][byte(x3>>16)] ^ tables[6][byte(x4>>8)] ^ tables[7][byte(x9>>0)]
+		y15 := tables[0][byte(x15>>56)] ^ tables[1][byte(x0>>48)] ^ tables[2][byte(x1>>40)] ^ tables[3][byte(x2>>32)] ^ tables[4][byte(x3>>24)] ^ tables[5][byte(x4>>16)] ^ tables[6][byte(x5>>8)] ^ tables[7][byte(x10>>0)]
+
+		x0, x1, x2, x3, x4, x5, x6, x7, x8, x9, x10, x11, x12, x13, x14, x15 = y0, y1, y2, y3, y4, y5, y6, y7, y8, y9, y10, y11, y12, y13, y14, y15
+	}
+
+	state[0] = x0
+	state[1] = x1
+	state[2] = x2
+	state[3] = x3
+	state[4] = x4
+	state[5] = x5
+	state[6] = x6
+	state[7] = x7
+	state[8] = x8
+	state[9] = x9
+	state[10] = x10
+	state[11] = x11
+	state[12] = x12
+	state[13] = x13
+	state[14] = x14
+	state[15] = x15
+}
+
+// permuteQ1024 applies the Q1024 permutation to the state.
+func permuteQ1024(state *[maxColumns]uint64) {
+	x0 := state[0]
+	x1 := state[1]
+	x2 := state[2]
+	x3 := state[3]
+	x4 := state[4]
+	x5 := state[5]
+	x6 := state[6]
+	x7 := state[7]
+	x8 := state[8]
+	x9 := state[9]
+	x10 := state[10]
+	x11 := state[11]
+	x12 := state[12]
+	x13 := state[13]
+	x14 := state[14]
+	x15 := state[15]
+
+	for round := uint64(0); round < 14; round++ {
+		// AddRoundConstant
+		x0 ^= 0xffffffffffffffff ^ round
+		x1 ^= 0xffffffffffffffef ^ round
+		x2 ^= 0xffffffffffffffdf ^ round
+		x3 ^= 0xffffffffffffffcf ^ round
+		x4 ^= 0xffffffffffffffbf ^ round
+		x5 ^= 0xffffffffffffffaf ^ round
+		x6 ^= 0xffffffffffffff9f ^ round
+		x7 ^= 0xffffffffffffff8f ^ round
+		x8 ^= 0xffffffffffffff7f ^ round
+		x9 ^= 0xffffffffffffff6f ^ round
+		x10 ^= 0xffffffffffffff5f ^ round
+		x11 ^= 0xffffffffffffff4f ^ round
+		x12 ^= 0xffffffffffffff3f ^ round
+		x13 ^= 0xffffffffffffff2f ^ round
+		x14 ^= 0xffffffffffffff1f ^ round
+		x15 ^= 0xffffffffffffff0f ^ round
+
+		// SubBytes, ShiftBytes and MixBytes
+		y0 := tables[0][byte(x1>>56)] ^ tables[1][byte(x3>>48)] ^ tables[2][byte(x5>>40)] ^ tables[3][byte(x11>>32)] ^ tables[4][byte(x0>>24)] ^ tables[5][byte(x2>>16)] ^ tables[6][byte(x4>>8)] ^ tables[7][byte(x6>>0)]
+		y1 := tables[0][byte(x2>>56)] ^ tables[1][byte(x4>>48)] ^ tables[2][byte(x6>>40)] ^ tables[3][byte(x12>>32)] ^ tables[4][byte(x1>>24)] ^ tables[5][byte(x3>>16)] ^ tables[6][byte(x5>>8)] ^ tables[7][byte(x7>>0)]
+		y2 := tables[0][byte(x3>>56)] ^ tables[1][byte(x5>>48)] ^ tables[2][byte(x7>>40)] ^ tables[3][byte(x13>>32)] ^ tables[4][byte(x2>>24)] ^ tables[5][byte(x4>>16)] ^ tables[6][byte(x6>>8)] ^ tables[7][byte(x8>>0)]
+		y3 := tables[0][byte(x4>>56)] ^ tables[1][byte(x6>>48)] ^ tables[2][byte(x8>>40)] ^ tables[3][byte(x14>>32)] ^ tables[4][byte(x3>>24)] ^ tables[5][byte(x5>>16)] ^ tables[6][byte(x7>>8)] ^ tables[7][byte(x9>>0)]
+		y4 := tables[0][byte(x5>>56)] ^ tables[1][byte(x7>>48)] ^ tables[2][byte(x9>>40)] ^ tables[3][byte(x15>>32)] ^ tables[4][byte(x4>>24)] ^ tables[5][byte(x6>>16)] ^ tables[6][byte(x8>>8)] ^ tables[7][byte(x10>>0)]
+		y5 := tables[0][byte(x6>>56)] ^ tables[1][byte(x8>>48)] ^ tables[2][byte(x10>>40)] ^ tables[3][byte(x0>>32)] ^ tables[4][byte(x5>>24)] ^ tables[5][byte(x7>>16)] ^ tables[6][byte(x9>>8)] ^ tables[7][byte(x11>>0)]
+		y6 := tables[0][byte(x7>>56)] ^ tables[1][byte(x9>>48)] ^ tables[2][byte(x11>>40)] ^ tables[3][byte(x1>>32)] ^ tables[4][byte(x6>>24)] ^ tables[5][byte(x8>>16)] ^ tables[6][byte(x10>>8)] ^ tables[7][byte(x12>>0)]
+		y7 := tables[0][byte(x8>>56)] ^ tables[1][byte(x10>>48)] ^ tables[2][byte(x12>>40)] ^ tables[3][byte(x2>>32)] ^ tables[4][byte(x7>>24)] ^ tables[5][byte(x9>>16)] ^ tables[6][byte(x11>>8)] ^ tables[7][byte(x13>>0)]
+		y8 := tables[0][byte(x9>>56)] ^ tables[1][byte(x11>>48)] ^ tables[2][byte(x13>>40)] ^ tables[3][byte(x3>>32)] ^ tables[4][byte(x8>>24)] ^ tables[5][byte(x10>>16)] ^ tables[6][byte(x12>>8)] ^ tables[7][byte(x14>>0)]
+		y9 := tables[0][byte(x10>>56)] ^ tables[1][byte(x12>>48)] ^ tables[2][byte(x14>>40)] ^ tables[3][byte(x4>>32)] ^ tables[4][byte(x9>>24)] ^ tables[5][byte(x11>>16)] ^ tables[6][byte(x13>>8)] ^ tables[7][byte(x15>>0)]
+		y10 := tables[0][byte(x11>>56)] ^ tables[1][byte(x13>>48)] ^ tables[2][byte(x15>>40)] ^ tables[3][byte(x5>>32)] ^ tables[4][byte(x10>>24)] ^ tables[5][byte(x12>>16)] ^ tables[6][byte(x14>>8)] ^ tables[7][byte(x0>>0)]
+		y11 := tables[0][byte(x12>>56)] ^ tables[1][byte(x14>>48)] ^ tables[2][byte(x0>>40)] ^ tables[3][byte(x6>>32)] ^ tables[4][byte(x11>>24)] ^ tables[5][byte(x13>>16)] ^ tables[6][byte(x15>>8)] ^ tables[7][byte(x1>>0)]
+		y12 := tables[0][byte(x13>>56)] ^ tables[1][byte(x15>>48)] ^ tables[2][byte(x1>>40)] ^ tables[3][byte(x7>>32)] ^ tables[4][byte(x12>>24)] ^ tables[5][byte(x14>>16)] ^ tables[6][byte(x0>>8)] ^ tables[7][byte(x2>>0)]
+		y13 := tables[0][byte(x14>>56)] ^ tables[1][byte(x0>>48)] ^ tables[2][byte(x2>>40)] ^ tables[3][byte(x8>>32)] ^ tables[4][byte(x13>>24)] ^ tables[5][byte(x15>>16)] ^ tables[6][byte(x1>>8)] ^ tables[7][byte(x3>>0)]
+		y14 := tables[0][byte(x15>>56)] ^ tables[1][byte(x1>>48)] ^ tables[2][byte(x3>>40)] ^ tables[3][byte(x9>>32)] ^ tables[4][byte(x14>>24)] ^ tables[5][byte(x0>>16)] ^ tables[6][byte(x2>>8)] ^ tables[7][byte(x4>>0)]
+		y15 := tables[0][byte(x0>>56)] ^ tables[1][byte(x2>>48)] ^ tables[2][byte(x4>>40)] ^ tables[3][byte(x10>>32)] ^ tables[4][byte(x15>>24)] ^ tables[5][byte(x1>>16)] ^ tables[6][byte(x3>>8)] ^ tables[7][byte(x5>>0)]
+
+		x0, x1, x2, x3, x4, x5, x6, x7, x8, x9, x10, x11, x12, x13, x14, x15 = y0, y1, y2, y3, y4, y5, y6, y7, y8, y9, y10, y11, y12, y13, y14, y15
+	}
+
+	state[0] = x0
+	state[1] = x1
+	state[2] = x2
+	state[3] = x3
+	state[4] = x4
+	state[5] = x5
+	state[6] = x6
+	state[7] = x7
+	state[8] = x8
+	state[9] = x9
+	state[10] = x10
+	state[11] = x11
+	state[12] = x12
+	state[13] = x13
+	state[14] = x14
+	state[15] = x15
+}
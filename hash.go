@@ -6,6 +6,8 @@ import (
 	"hash"
 	"io"
 	"strings"
+
+	"hashed/forge"
 )
 
 type Hash struct {
@@ -68,6 +70,32 @@ func (r *Hash) HMac(key []byte) {
 	r.Hash = hmac.New(getHashFunc(r.options), key)
 }
 
+// ForgeInsert returns the bytes which inserted into data at the offset make its checksum equal to target.
+// It is only available for the CRC hash types.
+func (r *Hash) ForgeInsert(data []byte, offset int, target []byte) []byte {
+	r.assertForgeable()
+
+	forged, err := forge.Insert(getHashFunc(r.options), data, offset, target)
+	if err != nil {
+		r.fatalError("cannot forge %s: %s\n", r.options.HashType, err)
+	}
+
+	return forged
+}
+
+// ForgePatch returns the bytes which overwritten on data at the offset make its checksum equal to target.
+// It is only available for the CRC hash types.
+func (r *Hash) ForgePatch(data []byte, offset int, target []byte) []byte {
+	r.assertForgeable()
+
+	forged, err := forge.Patch(getHashFunc(r.options), data, offset, target)
+	if err != nil {
+		r.fatalError("cannot forge %s: %s\n", r.options.HashType, err)
+	}
+
+	return forged
+}
+
 func (r *Hash) KMac128Size(size int) *Hash {
 	r.options.KMac128Size = size
 	return r
@@ -90,6 +118,13 @@ func (r *Hash) Debug() *Hash {
 
 // private
 
+// assertForgeable asserts that the hash type is a CRC and if not, exits with an error.
+func (r *Hash) assertForgeable() {
+	if !strings.HasPrefix(strings.ToLower(r.options.HashType), "crc-") {
+		r.fatalError("forging is not supported for %s\n", r.options.HashType)
+	}
+}
+
 func (r *Hash) fatalError(format string, a ...any) {
 	msg := []string{fmt.Sprintf(format, a...)}
 
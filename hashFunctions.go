@@ -1,6 +1,9 @@
 package hashed
 
 import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/des"
 	"crypto/md5"
 	"crypto/sha1"
 	"crypto/sha256"
@@ -9,19 +12,43 @@ import (
 	"hash"
 	"hash/crc32"
 	"hash/crc64"
+	"hash/fnv"
+	"math"
 	"strings"
 
-	"golang.org/x/crypto/blake2b"
-	"golang.org/x/crypto/blake2s"
 	"golang.org/x/crypto/md4"
 	"golang.org/x/crypto/sha3"
 
+	"hashed/adler32"
+	"hashed/ascon"
+	"hashed/blake"
+	"hashed/blake2"
+	"hashed/cbcmac"
+	"hashed/cityhash"
+	"hashed/cmac"
+	"hashed/composite"
 	"hashed/crc16"
 	crc16Algorithm "hashed/crc16/algorithm"
+	"hashed/fletcher"
+	"hashed/gmac"
+	"hashed/gost94"
+	"hashed/groestl"
+	"hashed/jh"
 	"hashed/keccak"
 	"hashed/kmac"
 	"hashed/md2"
+	"hashed/murmur3"
+	"hashed/poly1305"
 	"hashed/ripemd"
+	"hashed/siphash"
+	"hashed/skein"
+	"hashed/sm3"
+	"hashed/streebog"
+	"hashed/tiger"
+	"hashed/unixsum"
+	"hashed/whirlpool"
+	"hashed/xoodyak"
+	"hashed/xxhash"
 )
 
 func Crc16(subType string) hash.Hash {
@@ -75,6 +102,30 @@ func Crc64(subType string) hash.Hash {
 	return crc64.New(crc64.MakeTable(algorithm))
 }
 
+func Adler32() hash.Hash {
+	return adler32.New()
+}
+
+func Fletcher16() hash.Hash {
+	return fletcher.New16()
+}
+
+func Fletcher32() hash.Hash {
+	return fletcher.New32()
+}
+
+func Fletcher64() hash.Hash {
+	return fletcher.New64()
+}
+
+func BsdSum() hash.Hash {
+	return unixsum.NewBSD()
+}
+
+func SysVSum() hash.Hash {
+	return unixsum.NewSysV()
+}
+
 func Md2() hash.Hash {
 	return md2.New()
 }
@@ -136,7 +187,7 @@ func KeccakType224() hash.Hash {
 }
 
 func KeccakType256() hash.Hash {
-	return sha3.NewLegacyKeccak256()
+	return keccak.New256()
 }
 
 func KeccakType384() hash.Hash {
@@ -144,7 +195,7 @@ func KeccakType384() hash.Hash {
 }
 
 func KeccakType512() hash.Hash {
-	return sha3.NewLegacyKeccak512()
+	return keccak.New512()
 }
 
 func ShakeType128() hash.Hash {
@@ -163,6 +214,53 @@ func CShakeType256(n, s []byte) hash.Hash {
 	return sha3.NewCShake256(n, s)
 }
 
+func AsconHash256() hash.Hash {
+	return ascon.NewHash256()
+}
+
+func AsconXofType128(size int) hash.Hash {
+	if size == 0 {
+		size = ascon.Size
+	}
+
+	h, err := ascon.NewXOF128(size)
+	if err != nil {
+		fatalError(fmt.Sprintf("ascon-xof128 size is not positive: %d", size))
+	}
+
+	return h
+}
+
+func AsconCXofType128(size int, customization []byte) hash.Hash {
+	if size == 0 {
+		size = ascon.Size
+	}
+
+	if len(customization) > ascon.MaxCustomizationSize {
+		fatalError(fmt.Sprintf("ascon-cxof128 customization is greater than %d bytes", ascon.MaxCustomizationSize))
+	}
+
+	h, err := ascon.NewCXOF128(size, customization)
+	if err != nil {
+		fatalError(fmt.Sprintf("ascon-cxof128 size is not positive: %d", size))
+	}
+
+	return h
+}
+
+func Xoodyak(size int) hash.Hash {
+	if size == 0 {
+		size = xoodyak.Size
+	}
+
+	h, err := xoodyak.NewXOF(size)
+	if err != nil {
+		fatalError(fmt.Sprintf("xoodyak size is not positive: %d", size))
+	}
+
+	return h
+}
+
 func KMacType128(key, customization []byte, size int) hash.Hash {
 	if len(key) < 16 {
 		fatalError("kmac-128 key is less than 16 bytes")
@@ -203,162 +301,801 @@ func RipeMdType320() hash.Hash {
 	return ripemd.New320()
 }
 
-func Blake2SType128(key []byte) hash.Hash {
-	if len(key) == 0 {
-		fatalError(fmt.Sprintf("blake2s-128 key is empty"))
+func Whirlpool() hash.Hash {
+	return whirlpool.New()
+}
+
+// tigerFunc returns the constructor of the Tiger sub type, which is either "tiger" or "tiger2".
+func tigerFunc(subType string, newTiger, newTiger2 func() hash.Hash) hash.Hash {
+	switch subType {
+	case "", "tiger":
+		return newTiger()
+	case "tiger2":
+		return newTiger2()
 	}
 
-	if len(key) > blake2s.Size {
-		fatalError(fmt.Sprintf("blake2s-128 key is greater than %d bytes", blake2s.Size))
+	fatalError(fmt.Sprintf("unknown sub type for tiger: %s", subType))
+
+	return nil
+}
+
+func TigerType192(subType string) hash.Hash {
+	return tigerFunc(subType, tiger.New, tiger.New2)
+}
+
+func TigerType160(subType string) hash.Hash {
+	return tigerFunc(subType, tiger.NewSize160, tiger.New2Size160)
+}
+
+func TigerType128(subType string) hash.Hash {
+	return tigerFunc(subType, tiger.NewSize128, tiger.New2Size128)
+}
+
+func Sm3() hash.Hash {
+	return sm3.New()
+}
+
+func StreebogType256() hash.Hash {
+	return streebog.New256()
+}
+
+func StreebogType512() hash.Hash {
+	return streebog.New512()
+}
+
+func Gost94(subType string) hash.Hash {
+	if subType == "" {
+		subType = "test"
 	}
 
-	h, err := blake2s.New128(key)
-	if err != nil {
-		panic(err)
+	var sBox *gost94.SBox
+	switch subType {
+	case "test":
+		sBox = &gost94.TestParamSet
+	case "cryptopro":
+		sBox = &gost94.CryptoProParamSet
+	default:
+		fatalError(fmt.Sprintf("unknown sub type for gost-94: %s", subType))
 	}
 
-	return h
+	return gost94.New(sBox)
+}
+
+func SkeinType256(size int, key, personalization []byte) hash.Hash {
+	return skeinHash("skein-256", skein.New256, skein.StateSize256, size, key, personalization)
+}
+
+func SkeinType512(size int, key, personalization []byte) hash.Hash {
+	return skeinHash("skein-512", skein.New512, skein.StateSize512, size, key, personalization)
+}
+
+func SkeinType1024(size int, key, personalization []byte) hash.Hash {
+	return skeinHash("skein-1024", skein.New1024, skein.StateSize1024, size, key, personalization)
 }
 
-func Blake2SType256(key []byte) hash.Hash {
-	if len(key) > blake2s.Size {
-		fatalError(fmt.Sprintf("blake2s-256 key is greater than %d bytes", blake2s.Size))
+// skeinHash creates a Skein hash.Hash of the size in bytes, which is the state size if it is zero.
+func skeinHash(hashType string, newSkein func(int, []byte, []byte) (hash.Hash, error), stateSize, size int, key, personalization []byte) hash.Hash {
+	if size == 0 {
+		size = stateSize
 	}
 
-	h, err := blake2s.New256(key)
+	h, err := newSkein(size, key, personalization)
 	if err != nil {
-		panic(err)
+		fatalError(fmt.Sprintf("%s size is not positive: %d", hashType, size))
 	}
 
 	return h
 }
 
-func Blake2BType256(key []byte) hash.Hash {
-	if len(key) == 0 {
-		fatalError(fmt.Sprintf("blake2b-256 key is empty"))
+func GroestlType224() hash.Hash {
+	return groestl.New224()
+}
+
+func GroestlType256() hash.Hash {
+	return groestl.New256()
+}
+
+func GroestlType384() hash.Hash {
+	return groestl.New384()
+}
+
+func GroestlType512() hash.Hash {
+	return groestl.New512()
+}
+
+func JhType224() hash.Hash {
+	return jh.New224()
+}
+
+func JhType256() hash.Hash {
+	return jh.New256()
+}
+
+func JhType384() hash.Hash {
+	return jh.New384()
+}
+
+func JhType512() hash.Hash {
+	return jh.New512()
+}
+
+func BlakeType256() hash.Hash {
+	return blake.New256()
+}
+
+func BlakeType512() hash.Hash {
+	return blake.New512()
+}
+
+func Hash160() hash.Hash {
+	return composite.New160()
+}
+
+func Sha2Type256Double() hash.Hash {
+	return composite.New256()
+}
+
+func KeccakType256OfPublicKey() hash.Hash {
+	return composite.NewAddress()
+}
+
+func XxHashType32(seed uint64) hash.Hash {
+	return xxhash.New32(seed32("xxh32", seed))
+}
+
+func XxHashType64(seed uint64) hash.Hash {
+	return xxhash.New64(seed)
+}
+
+func XxHash3Type64(seed uint64) hash.Hash {
+	return xxhash.New3Type64(seed)
+}
+
+func XxHash3Type128(seed uint64) hash.Hash {
+	return xxhash.New3Type128(seed)
+}
+
+func MurmurHash3X86Type32(seed uint64) hash.Hash {
+	return murmur3.New32(seed32("murmur3-x86-32", seed))
+}
+
+func MurmurHash3X86Type128(seed uint64) hash.Hash {
+	return murmur3.New128x86(seed32("murmur3-x86-128", seed))
+}
+
+func MurmurHash3X64Type128(seed uint64) hash.Hash {
+	return murmur3.New128x64(seed32("murmur3-x64-128", seed))
+}
+
+// seed32 returns the seed of the hash types of a 32-bit seed, XXH32 and every variant of MurmurHash3.
+func seed32(hashType string, seed uint64) uint32 {
+	if seed > math.MaxUint32 {
+		fatalError(fmt.Sprintf("%s seed is greater than 32 bits", hashType))
+	}
+
+	return uint32(seed)
+}
+
+func Fnv1Type32() hash.Hash {
+	return fnv.New32()
+}
+
+func Fnv1aType32() hash.Hash {
+	return fnv.New32a()
+}
+
+func Fnv1Type64() hash.Hash {
+	return fnv.New64()
+}
+
+func Fnv1aType64() hash.Hash {
+	return fnv.New64a()
+}
+
+func Fnv1Type128() hash.Hash {
+	return fnv.New128()
+}
+
+func Fnv1aType128() hash.Hash {
+	return fnv.New128a()
+}
+
+func CityHashType64() hash.Hash {
+	return cityhash.New64()
+}
+
+func CityHashType128() hash.Hash {
+	return cityhash.New128()
+}
+
+func SipHash24Type64(key []byte) hash.Hash {
+	return sipHash("siphash-2-4-64", key, siphash.CompressionRounds24, siphash.FinalizationRounds24, siphash.Size64)
+}
+
+func SipHash24Type128(key []byte) hash.Hash {
+	return sipHash("siphash-2-4-128", key, siphash.CompressionRounds24, siphash.FinalizationRounds24, siphash.Size128)
+}
+
+func SipHash13Type64(key []byte) hash.Hash {
+	return sipHash("siphash-1-3-64", key, siphash.CompressionRounds13, siphash.FinalizationRounds13, siphash.Size64)
+}
+
+func SipHash13Type128(key []byte) hash.Hash {
+	return sipHash("siphash-1-3-128", key, siphash.CompressionRounds13, siphash.FinalizationRounds13, siphash.Size128)
+}
+
+// sipHash creates a SipHash-c-d hash.Hash of the size, the key must be 16 bytes.
+func sipHash(hashType string, key []byte, c, d, size int) hash.Hash {
+	if len(key) != siphash.KeySize {
+		fatalError(fmt.Sprintf("%s key is not %d bytes", hashType, siphash.KeySize))
 	}
 
-	if len(key) > blake2b.Size256 {
-		fatalError(fmt.Sprintf("blake2b-256 key is greater than %d bytes", blake2b.Size256))
+	var (
+		h   hash.Hash
+		err error
+	)
+
+	if size == siphash.Size64 {
+		h, err = siphash.New64(key, c, d)
+	} else {
+		h, err = siphash.New128(key, c, d)
 	}
 
-	h, err := blake2b.New256(key)
 	if err != nil {
-		panic(err)
+		fatalError(fmt.Sprintf("%s parameters are invalid: %s", hashType, err))
 	}
 
 	return h
 }
 
-func Blake2BType384(key []byte) hash.Hash {
-	if len(key) == 0 {
-		fatalError(fmt.Sprintf("blake2b-384 key is empty"))
-	}
+func Blake2SType128() hash.Hash {
+	return Blake2S(&blake2.Params{Size: 16})
+}
 
-	if len(key) > blake2b.Size384 {
-		fatalError(fmt.Sprintf("blake2b-384 key is greater than %d bytes", blake2b.Size384))
-	}
+func Blake2SType256() hash.Hash {
+	return Blake2S(&blake2.Params{Size: 32})
+}
+
+func Blake2BType256() hash.Hash {
+	return Blake2B(&blake2.Params{Size: 32})
+}
+
+func Blake2BType384() hash.Hash {
+	return Blake2B(&blake2.Params{Size: 48})
+}
+
+func Blake2BType512() hash.Hash {
+	return Blake2B(&blake2.Params{Size: 64})
+}
+
+func Blake2B(params *blake2.Params) hash.Hash {
+	return blake2Hash("blake2b", blake2.NewB, params)
+}
+
+func Blake2S(params *blake2.Params) hash.Hash {
+	return blake2Hash("blake2s", blake2.NewS, params)
+}
+
+func Blake2BP() hash.Hash {
+	return blake2Hash("blake2bp", newBlake2BP, &blake2.Params{})
+}
+
+func Blake2SP() hash.Hash {
+	return blake2Hash("blake2sp", newBlake2SP, &blake2.Params{})
+}
+
+func Blake2XB(params *blake2.Params) hash.Hash {
+	return blake2Hash("blake2xb", newBlake2XB, params)
+}
+
+func Blake2XS(params *blake2.Params) hash.Hash {
+	return blake2Hash("blake2xs", newBlake2XS, params)
+}
+
+func Blake2SMacType128(key []byte) hash.Hash {
+	return blake2Mac("blake2s-128-mac", blake2.NewS, key, &blake2.Params{Size: 16})
+}
+
+func Blake2SMacType256(key []byte) hash.Hash {
+	return blake2Mac("blake2s-256-mac", blake2.NewS, key, &blake2.Params{Size: 32})
+}
+
+func Blake2BMacType256(key []byte) hash.Hash {
+	return blake2Mac("blake2b-256-mac", blake2.NewB, key, &blake2.Params{Size: 32})
+}
+
+func Blake2BMacType384(key []byte) hash.Hash {
+	return blake2Mac("blake2b-384-mac", blake2.NewB, key, &blake2.Params{Size: 48})
+}
+
+func Blake2BMacType512(key []byte) hash.Hash {
+	return blake2Mac("blake2b-512-mac", blake2.NewB, key, &blake2.Params{Size: 64})
+}
 
-	h, err := blake2b.New384(key)
+func Blake2BMac(key []byte, params *blake2.Params) hash.Hash {
+	return blake2Mac("blake2b-mac", blake2.NewB, key, params)
+}
+
+func Blake2SMac(key []byte, params *blake2.Params) hash.Hash {
+	return blake2Mac("blake2s-mac", blake2.NewS, key, params)
+}
+
+func Blake2BPMac(key []byte) hash.Hash {
+	return blake2Mac("blake2bp-mac", newBlake2BP, key, &blake2.Params{})
+}
+
+func Blake2SPMac(key []byte) hash.Hash {
+	return blake2Mac("blake2sp-mac", newBlake2SP, key, &blake2.Params{})
+}
+
+func Blake2XBMac(key []byte, params *blake2.Params) hash.Hash {
+	return blake2Mac("blake2xb-mac", newBlake2XB, key, params)
+}
+
+func Blake2XSMac(key []byte, params *blake2.Params) hash.Hash {
+	return blake2Mac("blake2xs-mac", newBlake2XS, key, params)
+}
+
+// blake2Hash creates a BLAKE2 hash.Hash of the parameter block, the size is the maximum size if it is zero.
+func blake2Hash(hashType string, newBlake2 func(*blake2.Params) (hash.Hash, error), params *blake2.Params) hash.Hash {
+	h, err := newBlake2(params)
 	if err != nil {
-		panic(err)
+		fatalError(fmt.Sprintf("%s parameters are invalid: %s", hashType, err))
 	}
 
 	return h
 }
 
-func Blake2BType512(key []byte) hash.Hash {
+// blake2Mac creates a keyed BLAKE2 hash.Hash of the parameter block, the key must not be empty.
+func blake2Mac(hashType string, newBlake2 func(*blake2.Params) (hash.Hash, error), key []byte, params *blake2.Params) hash.Hash {
 	if len(key) == 0 {
-		fatalError(fmt.Sprintf("blake2b-512 key is empty"))
+		fatalError(fmt.Sprintf("%s key is empty", hashType))
+	}
+
+	keyed := *params
+	keyed.Key = key
+
+	return blake2Hash(hashType, newBlake2, &keyed)
+}
+
+// newBlake2BP creates a BLAKE2bp hash.Hash, only the key of the parameters is used.
+func newBlake2BP(params *blake2.Params) (hash.Hash, error) {
+	return blake2.NewBP(params.Key)
+}
+
+// newBlake2SP creates a BLAKE2sp hash.Hash, only the key of the parameters is used.
+func newBlake2SP(params *blake2.Params) (hash.Hash, error) {
+	return blake2.NewSP(params.Key)
+}
+
+// newBlake2XB creates a BLAKE2Xb XOF as a hash.Hash.
+func newBlake2XB(params *blake2.Params) (hash.Hash, error) {
+	return blake2.NewXB(params)
+}
+
+// newBlake2XS creates a BLAKE2Xs XOF as a hash.Hash.
+func newBlake2XS(params *blake2.Params) (hash.Hash, error) {
+	return blake2.NewXS(params)
+}
+
+func CMacAesType128(key []byte) hash.Hash {
+	return cMac("cmac-aes128", aes.NewCipher, key)
+}
+
+func CMacAesType192(key []byte) hash.Hash {
+	return cMac("cmac-aes192", aes.NewCipher, key)
+}
+
+func CMacAesType256(key []byte) hash.Hash {
+	return cMac("cmac-aes256", aes.NewCipher, key)
+}
+
+func CMacTDes(key []byte) hash.Hash {
+	return cMac("cmac-tdes", des.NewTripleDESCipher, key)
+}
+
+func GMacAesType128(key, nonce []byte) hash.Hash {
+	return gMac("gmac-aes128", key, nonce)
+}
+
+func GMacAesType192(key, nonce []byte) hash.Hash {
+	return gMac("gmac-aes192", key, nonce)
+}
+
+func GMacAesType256(key, nonce []byte) hash.Hash {
+	return gMac("gmac-aes256", key, nonce)
+}
+
+func CbcMacAesType128(key []byte, subType string) hash.Hash {
+	return cbcMac("cbcmac-aes128", aes.NewCipher, key, subType)
+}
+
+func CbcMacAesType192(key []byte, subType string) hash.Hash {
+	return cbcMac("cbcmac-aes192", aes.NewCipher, key, subType)
+}
+
+func CbcMacAesType256(key []byte, subType string) hash.Hash {
+	return cbcMac("cbcmac-aes256", aes.NewCipher, key, subType)
+}
+
+func CbcMacDes(key []byte, subType string) hash.Hash {
+	return cbcMac("cbcmac-des", des.NewCipher, key, subType)
+}
+
+// RetailMacDes creates the DES retail MAC of ANSI X9.19, the key is the two DES keys of 8 bytes.
+func RetailMacDes(key []byte, subType string) hash.Hash {
+	newHalf := func(half []byte) cipher.Block {
+		block, err := des.NewCipher(half)
+		if err != nil {
+			fatalError(fmt.Sprintf("retail-mac-des key is invalid: %s", err))
+		}
+
+		return block
 	}
 
-	if len(key) > blake2b.Size {
-		fatalError(fmt.Sprintf("blake2b-512 key is greater than %d bytes", blake2b.Size))
+	key = checkKeySize("retail-mac-des", key)
+	h, err := cbcmac.NewRetail(newHalf(key[:8]), newHalf(key[8:]), cbcMacPadding(subType))
+
+	return checkMac("retail-mac-des", h, err)
+}
+
+// Poly1305 creates a Poly1305 hash.Hash, the one-time key must be 32 bytes.
+func Poly1305(key []byte) hash.Hash {
+	h, err := poly1305.New(checkKeySize("poly1305", key))
+
+	return checkMac("poly1305", h, err)
+}
+
+// cMac creates a CMAC hash.Hash of the block cipher.
+func cMac(hashType string, newCipher func([]byte) (cipher.Block, error), key []byte) hash.Hash {
+	h, err := cmac.New(newBlockCipher(hashType, newCipher, key))
+
+	return checkMac(hashType, h, err)
+}
+
+// gMac creates an AES-GMAC hash.Hash with the nonce.
+func gMac(hashType string, key, nonce []byte) hash.Hash {
+	h, err := gmac.New(newBlockCipher(hashType, aes.NewCipher, key), nonce)
+
+	return checkMac(hashType, h, err)
+}
+
+// cbcMac creates a CBC-MAC hash.Hash of the block cipher, with the padding of the sub type.
+func cbcMac(hashType string, newCipher func([]byte) (cipher.Block, error), key []byte, subType string) hash.Hash {
+	h, err := cbcmac.New(newBlockCipher(hashType, newCipher, key), cbcMacPadding(subType))
+
+	return checkMac(hashType, h, err)
+}
+
+// cbcMacPadding returns the padding of CBC-MAC of the sub type, zero or bit, the padding methods 1 and 2 of
+// ISO/IEC 9797-1.
+func cbcMacPadding(subType string) cbcmac.Padding {
+	switch subType {
+	case "", "zero":
+		return cbcmac.ZeroPadding
+	case "bit":
+		return cbcmac.BitPadding
 	}
 
-	h, err := blake2b.New512(key)
+	fatalError(fmt.Sprintf("unknown sub type for cbc-mac: %s", subType))
+
+	return 0
+}
+
+// newBlockCipher creates the block cipher of the MAC of the hash type with the key of the size of keySizes.
+func newBlockCipher(hashType string, newCipher func([]byte) (cipher.Block, error), key []byte) cipher.Block {
+	block, err := newCipher(checkKeySize(hashType, key))
 	if err != nil {
-		panic(err)
+		fatalError(fmt.Sprintf("%s key is invalid: %s", hashType, err))
+	}
+
+	return block
+}
+
+// checkKeySize returns the key of the hash type, or exits if it is not of the size of keySizes.
+func checkKeySize(hashType string, key []byte) []byte {
+	if size := keySizes[hashType]; len(key) != size {
+		fatalError(fmt.Sprintf("%s key is not %d bytes", hashType, size))
+	}
+
+	return key
+}
+
+// checkMac returns the MAC of the hash type, or exits with the error of its constructor.
+func checkMac(hashType string, h hash.Hash, err error) hash.Hash {
+	if err != nil {
+		fatalError(fmt.Sprintf("%s parameters are invalid: %s", hashType, err))
 	}
 
 	return h
 }
 
+// hashFuncs stores the hash constructors by their hash type.
+var hashFuncs = map[string]func(options *Options) hash.Hash{
+	"crc-16":              func(o *Options) hash.Hash { return Crc16(o.SubType) },
+	"crc-32":              func(o *Options) hash.Hash { return Crc32(o.SubType) },
+	"crc-64":              func(o *Options) hash.Hash { return Crc64(o.SubType) },
+	"adler-32":            func(*Options) hash.Hash { return Adler32() },
+	"fletcher-16":         func(*Options) hash.Hash { return Fletcher16() },
+	"fletcher-32":         func(*Options) hash.Hash { return Fletcher32() },
+	"fletcher-64":         func(*Options) hash.Hash { return Fletcher64() },
+	"bsd-sum":             func(*Options) hash.Hash { return BsdSum() },
+	"sysv-sum":            func(*Options) hash.Hash { return SysVSum() },
+	"md2":                 func(*Options) hash.Hash { return Md2() },
+	"md4":                 func(*Options) hash.Hash { return Md4() },
+	"md5":                 func(*Options) hash.Hash { return Md5() },
+	"sha1":                func(*Options) hash.Hash { return Sha1() },
+	"sha2-256":            func(*Options) hash.Hash { return Sha2Type256() },
+	"sha2-256-224":        func(*Options) hash.Hash { return Sha2Type256Length224() },
+	"sha2-512":            func(*Options) hash.Hash { return Sha2Type256Length512() },
+	"sha2-512-224":        func(*Options) hash.Hash { return Sha2Type512Length224() },
+	"sha2-512-256":        func(*Options) hash.Hash { return Sha2Type512Length256() },
+	"sha2-512-384":        func(*Options) hash.Hash { return Sha2Type512Length384() },
+	"sha3-224":            func(*Options) hash.Hash { return Sha3Type224() },
+	"sha3-256":            func(*Options) hash.Hash { return Sha3Type256() },
+	"sha3-384":            func(*Options) hash.Hash { return Sha3Type384() },
+	"sha3-512":            func(*Options) hash.Hash { return Sha3Type512() },
+	"keccak-224":          func(*Options) hash.Hash { return KeccakType224() },
+	"keccak-256":          func(*Options) hash.Hash { return KeccakType256() },
+	"keccak-384":          func(*Options) hash.Hash { return KeccakType384() },
+	"keccak-512":          func(*Options) hash.Hash { return KeccakType512() },
+	"shake-128":           func(*Options) hash.Hash { return ShakeType128() },
+	"shake-256":           func(*Options) hash.Hash { return ShakeType256() },
+	"cshake-128":          func(o *Options) hash.Hash { return CShakeType128(o.FunctionName, o.Customization) },
+	"cshake-256":          func(o *Options) hash.Hash { return CShakeType256(o.FunctionName, o.Customization) },
+	"ascon-hash256":       func(*Options) hash.Hash { return AsconHash256() },
+	"ascon-xof128":        func(o *Options) hash.Hash { return AsconXofType128(o.Size) },
+	"ascon-cxof128":       func(o *Options) hash.Hash { return AsconCXofType128(o.Size, o.Customization) },
+	"xoodyak":             func(o *Options) hash.Hash { return Xoodyak(o.Size) },
+	"kmac-128":            func(o *Options) hash.Hash { return KMacType128(o.Key, o.Customization, o.KMac128Size) },
+	"kmac-256":            func(o *Options) hash.Hash { return KMacType256(o.Key, o.Customization, o.KMac256Size) },
+	"ripemd-128":          func(*Options) hash.Hash { return RipeMdType128() },
+	"ripemd-160":          func(*Options) hash.Hash { return RipeMdType160() },
+	"ripemd-256":          func(*Options) hash.Hash { return RipeMdType256() },
+	"ripemd-320":          func(*Options) hash.Hash { return RipeMdType320() },
+	"whirlpool":           func(*Options) hash.Hash { return Whirlpool() },
+	"tiger-192":           func(o *Options) hash.Hash { return TigerType192(o.SubType) },
+	"tiger-160":           func(o *Options) hash.Hash { return TigerType160(o.SubType) },
+	"tiger-128":           func(o *Options) hash.Hash { return TigerType128(o.SubType) },
+	"sm3":                 func(*Options) hash.Hash { return Sm3() },
+	"streebog-256":        func(*Options) hash.Hash { return StreebogType256() },
+	"streebog-512":        func(*Options) hash.Hash { return StreebogType512() },
+	"gost-94":             func(o *Options) hash.Hash { return Gost94(o.SubType) },
+	"skein-256":           func(o *Options) hash.Hash { return SkeinType256(o.Size, o.Key, o.Customization) },
+	"skein-512":           func(o *Options) hash.Hash { return SkeinType512(o.Size, o.Key, o.Customization) },
+	"skein-1024":          func(o *Options) hash.Hash { return SkeinType1024(o.Size, o.Key, o.Customization) },
+	"groestl-224":         func(*Options) hash.Hash { return GroestlType224() },
+	"groestl-256":         func(*Options) hash.Hash { return GroestlType256() },
+	"groestl-384":         func(*Options) hash.Hash { return GroestlType384() },
+	"groestl-512":         func(*Options) hash.Hash { return GroestlType512() },
+	"jh-224":              func(*Options) hash.Hash { return JhType224() },
+	"jh-256":              func(*Options) hash.Hash { return JhType256() },
+	"jh-384":              func(*Options) hash.Hash { return JhType384() },
+	"jh-512":              func(*Options) hash.Hash { return JhType512() },
+	"blake-256":           func(*Options) hash.Hash { return BlakeType256() },
+	"blake-512":           func(*Options) hash.Hash { return BlakeType512() },
+	"hash160":             func(*Options) hash.Hash { return Hash160() },
+	"sha256d":             func(*Options) hash.Hash { return Sha2Type256Double() },
+	"keccak256-of-pubkey": func(*Options) hash.Hash { return KeccakType256OfPublicKey() },
+	"xxh32":               func(o *Options) hash.Hash { return XxHashType32(o.Seed) },
+	"xxh64":               func(o *Options) hash.Hash { return XxHashType64(o.Seed) },
+	"xxh3-64":             func(o *Options) hash.Hash { return XxHash3Type64(o.Seed) },
+	"xxh3-128":            func(o *Options) hash.Hash { return XxHash3Type128(o.Seed) },
+	"murmur3-x86-32":      func(o *Options) hash.Hash { return MurmurHash3X86Type32(o.Seed) },
+	"murmur3-x86-128":     func(o *Options) hash.Hash { return MurmurHash3X86Type128(o.Seed) },
+	"murmur3-x64-128":     func(o *Options) hash.Hash { return MurmurHash3X64Type128(o.Seed) },
+	"fnv1-32":             func(*Options) hash.Hash { return Fnv1Type32() },
+	"fnv1a-32":            func(*Options) hash.Hash { return Fnv1aType32() },
+	"fnv1-64":             func(*Options) hash.Hash { return Fnv1Type64() },
+	"fnv1a-64":            func(*Options) hash.Hash { return Fnv1aType64() },
+	"fnv1-128":            func(*Options) hash.Hash { return Fnv1Type128() },
+	"fnv1a-128":           func(*Options) hash.Hash { return Fnv1aType128() },
+	"cityhash-64":         func(*Options) hash.Hash { return CityHashType64() },
+	"cityhash-128":        func(*Options) hash.Hash { return CityHashType128() },
+	"siphash-2-4-64":      func(o *Options) hash.Hash { return SipHash24Type64(o.Key) },
+	"siphash-2-4-128":     func(o *Options) hash.Hash { return SipHash24Type128(o.Key) },
+	"siphash-1-3-64":      func(o *Options) hash.Hash { return SipHash13Type64(o.Key) },
+	"siphash-1-3-128":     func(o *Options) hash.Hash { return SipHash13Type128(o.Key) },
+	"blake2s-128":         func(o *Options) hash.Hash { o.checkBlake2Key("blake2s-128"); return Blake2SType128() },
+	"blake2s-256":         func(o *Options) hash.Hash { o.checkBlake2Key("blake2s-256"); return Blake2SType256() },
+	"blake2b-256":         func(o *Options) hash.Hash { o.checkBlake2Key("blake2b-256"); return Blake2BType256() },
+	"blake2b-384":         func(o *Options) hash.Hash { o.checkBlake2Key("blake2b-384"); return Blake2BType384() },
+	"blake2b-512":         func(o *Options) hash.Hash { o.checkBlake2Key("blake2b-512"); return Blake2BType512() },
+	"blake2b":             func(o *Options) hash.Hash { return Blake2B(o.unkeyedBlake2Params("blake2b")) },
+	"blake2s":             func(o *Options) hash.Hash { return Blake2S(o.unkeyedBlake2Params("blake2s")) },
+	"blake2bp":            func(o *Options) hash.Hash { o.checkBlake2Key("blake2bp"); return Blake2BP() },
+	"blake2sp":            func(o *Options) hash.Hash { o.checkBlake2Key("blake2sp"); return Blake2SP() },
+	"blake2xb":            func(o *Options) hash.Hash { return Blake2XB(o.unkeyedBlake2Params("blake2xb")) },
+	"blake2xs":            func(o *Options) hash.Hash { return Blake2XS(o.unkeyedBlake2Params("blake2xs")) },
+	"blake2s-128-mac":     func(o *Options) hash.Hash { return Blake2SMacType128(o.Key) },
+	"blake2s-256-mac":     func(o *Options) hash.Hash { return Blake2SMacType256(o.Key) },
+	"blake2b-256-mac":     func(o *Options) hash.Hash { return Blake2BMacType256(o.Key) },
+	"blake2b-384-mac":     func(o *Options) hash.Hash { return Blake2BMacType384(o.Key) },
+	"blake2b-512-mac":     func(o *Options) hash.Hash { return Blake2BMacType512(o.Key) },
+	"blake2b-mac":         func(o *Options) hash.Hash { return Blake2BMac(o.Key, o.blake2Params()) },
+	"blake2s-mac":         func(o *Options) hash.Hash { return Blake2SMac(o.Key, o.blake2Params()) },
+	"blake2bp-mac":        func(o *Options) hash.Hash { return Blake2BPMac(o.Key) },
+	"blake2sp-mac":        func(o *Options) hash.Hash { return Blake2SPMac(o.Key) },
+	"blake2xb-mac":        func(o *Options) hash.Hash { return Blake2XBMac(o.Key, o.blake2Params()) },
+	"blake2xs-mac":        func(o *Options) hash.Hash { return Blake2XSMac(o.Key, o.blake2Params()) },
+	"cmac-aes128":         func(o *Options) hash.Hash { return CMacAesType128(o.Key) },
+	"cmac-aes192":         func(o *Options) hash.Hash { return CMacAesType192(o.Key) },
+	"cmac-aes256":         func(o *Options) hash.Hash { return CMacAesType256(o.Key) },
+	"cmac-tdes":           func(o *Options) hash.Hash { return CMacTDes(o.Key) },
+	"gmac-aes128":         func(o *Options) hash.Hash { return GMacAesType128(o.Key, o.Nonce) },
+	"gmac-aes192":         func(o *Options) hash.Hash { return GMacAesType192(o.Key, o.Nonce) },
+	"gmac-aes256":         func(o *Options) hash.Hash { return GMacAesType256(o.Key, o.Nonce) },
+	"cbcmac-aes128":       func(o *Options) hash.Hash { return CbcMacAesType128(o.Key, o.SubType) },
+	"cbcmac-aes192":       func(o *Options) hash.Hash { return CbcMacAesType192(o.Key, o.SubType) },
+	"cbcmac-aes256":       func(o *Options) hash.Hash { return CbcMacAesType256(o.Key, o.SubType) },
+	"cbcmac-des":          func(o *Options) hash.Hash { return CbcMacDes(o.Key, o.SubType) },
+	"retail-mac-des":      func(o *Options) hash.Hash { return RetailMacDes(o.Key, o.SubType) },
+	"poly1305":            func(o *Options) hash.Hash { return Poly1305(o.Key) },
+}
+
+// keyedHashTypes are the registered hash types which require a key.
+var keyedHashTypes = map[string]bool{
+	"kmac-128":        true,
+	"kmac-256":        true,
+	"siphash-2-4-64":  true,
+	"siphash-2-4-128": true,
+	"siphash-1-3-64":  true,
+	"siphash-1-3-128": true,
+	"blake2s-128-mac": true,
+	"blake2s-256-mac": true,
+	"blake2b-256-mac": true,
+	"blake2b-384-mac": true,
+	"blake2b-512-mac": true,
+	"blake2b-mac":     true,
+	"blake2s-mac":     true,
+	"blake2bp-mac":    true,
+	"blake2sp-mac":    true,
+	"blake2xb-mac":    true,
+	"blake2xs-mac":    true,
+	"cmac-aes128":     true,
+	"cmac-aes192":     true,
+	"cmac-aes256":     true,
+	"cmac-tdes":       true,
+	"gmac-aes128":     true,
+	"gmac-aes192":     true,
+	"gmac-aes256":     true,
+	"cbcmac-aes128":   true,
+	"cbcmac-aes192":   true,
+	"cbcmac-aes256":   true,
+	"cbcmac-des":      true,
+	"retail-mac-des":  true,
+	"poly1305":        true,
+}
+
+// keySizes are the sizes in bytes of the keys of the keyed hash types which require a key of a fixed size.
+var keySizes = map[string]int{
+	"siphash-2-4-64":  siphash.KeySize,
+	"siphash-2-4-128": siphash.KeySize,
+	"siphash-1-3-64":  siphash.KeySize,
+	"siphash-1-3-128": siphash.KeySize,
+	"cmac-aes128":     16,
+	"cmac-aes192":     24,
+	"cmac-aes256":     32,
+	"cmac-tdes":       24,
+	"gmac-aes128":     16,
+	"gmac-aes192":     24,
+	"gmac-aes256":     32,
+	"cbcmac-aes128":   16,
+	"cbcmac-aes192":   24,
+	"cbcmac-aes256":   32,
+	"cbcmac-des":      8,
+	"retail-mac-des":  16,
+	"poly1305":        poly1305.KeySize,
+}
+
+// hMacHashTypes are the registered hash types which can be used with HMAC, the cryptographic hash functions of
+// a fixed output size, without the checksums, the non-cryptographic hashes and the XOFs.
+var hMacHashTypes = map[string]bool{
+	"md2":           true,
+	"md4":           true,
+	"md5":           true,
+	"sha1":          true,
+	"sha2-256":      true,
+	"sha2-256-224":  true,
+	"sha2-512":      true,
+	"sha2-512-224":  true,
+	"sha2-512-256":  true,
+	"sha2-512-384":  true,
+	"sha3-224":      true,
+	"sha3-256":      true,
+	"sha3-384":      true,
+	"sha3-512":      true,
+	"keccak-224":    true,
+	"keccak-256":    true,
+	"keccak-384":    true,
+	"keccak-512":    true,
+	"ascon-hash256": true,
+	"ripemd-128":    true,
+	"ripemd-160":    true,
+	"ripemd-256":    true,
+	"ripemd-320":    true,
+	"whirlpool":     true,
+	"tiger-192":     true,
+	"tiger-160":     true,
+	"tiger-128":     true,
+	"sm3":           true,
+	"streebog-256":  true,
+	"streebog-512":  true,
+	"gost-94":       true,
+	"skein-256":     true,
+	"skein-512":     true,
+	"skein-1024":    true,
+	"groestl-224":   true,
+	"groestl-256":   true,
+	"groestl-384":   true,
+	"groestl-512":   true,
+	"jh-224":        true,
+	"jh-256":        true,
+	"jh-384":        true,
+	"jh-512":        true,
+	"blake-256":     true,
+	"blake-512":     true,
+	"hash160":       true,
+	"sha256d":       true,
+	"blake2s-128":   true,
+	"blake2s-256":   true,
+	"blake2b-256":   true,
+	"blake2b-384":   true,
+	"blake2b-512":   true,
+	"blake2bp":      true,
+	"blake2sp":      true,
+}
+
+// HashTypes returns the sorted list of all registered hash types.
+func HashTypes() []string {
+	return sortedKeys(hashFuncs)
+}
+
+// IsKeyed reports whether the hash type requires a key.
+func IsKeyed(hashType string) bool {
+	return keyedHashTypes[strings.ToLower(hashType)]
+}
+
+// KeySize returns the size in bytes of the key required by the hash type, or 0 if it accepts the keys of other sizes
+// or no key.
+func KeySize(hashType string) int {
+	return keySizes[strings.ToLower(hashType)]
+}
+
+// HMacHashFunc returns the constructor of the registered hash type with the default options, to be used with HMAC.
+// It returns an error if the hash type is not a cryptographic hash function of a fixed output size.
+func HMacHashFunc(hashType string) (func() hash.Hash, error) {
+	hashType = strings.ToLower(hashType)
+
+	if !hMacHashTypes[hashType] {
+		return nil, fmt.Errorf("hash type cannot be used with hmac: %s", hashType)
+	}
+
+	return getHashFunc(DefaultOptions(hashType)), nil
+}
+
 func getHashFunc(options *Options) func() hash.Hash {
-	switch strings.ToLower(options.HashType) {
-	case "crc-16":
-		return func() hash.Hash { return Crc16(options.SubType) }
-	case "crc-32":
-		return func() hash.Hash { return Crc32(options.SubType) }
-	case "crc-64":
-		return func() hash.Hash { return Crc64(options.SubType) }
-	case "md2":
-		return Md2
-	case "md4":
-		return Md4
-	case "md5":
-		return Md5
-	case "sha1":
-		return Sha1
-	case "sha2-256":
-		return Sha2Type256
-	case "sha2-256-224":
-		return Sha2Type256Length224
-	case "sha2-512":
-		return Sha2Type256Length512
-	case "sha2-512-224":
-		return Sha2Type512Length224
-	case "sha2-512-256":
-		return Sha2Type512Length256
-	case "sha2-512-384":
-		return Sha2Type512Length384
-	case "sha3-224":
-		return Sha3Type224
-	case "sha3-256":
-		return Sha3Type256
-	case "sha3-384":
-		return Sha3Type384
-	case "sha3-512":
-		return Sha3Type512
-	case "keccak-224":
-		return KeccakType224
-	case "keccak-256":
-		return KeccakType256
-	case "keccak-384":
-		return KeccakType384
-	case "keccak-512":
-		return KeccakType512
-	case "shake-128":
-		return ShakeType128
-	case "shake-256":
-		return ShakeType256
-	case "cshake-128":
-		return func() hash.Hash { return CShakeType128(options.FunctionName, options.Customization) }
-	case "cshake-256":
-		return func() hash.Hash { return CShakeType256(options.FunctionName, options.Customization) }
-	case "kmac-128":
-		return func() hash.Hash { return KMacType128(options.Key, options.Customization, options.KMac128Size) }
-	case "kmac-256":
-		return func() hash.Hash { return KMacType256(options.Key, options.Customization, options.KMac256Size) }
-	case "ripemd-128":
-		return RipeMdType128
-	case "ripemd-160":
-		return RipeMdType160
-	case "ripemd-256":
-		return RipeMdType256
-	case "ripemd-320":
-		return RipeMdType320
-	case "blake2s-128":
-		return func() hash.Hash { return Blake2SType128(options.Key) }
-	case "blake2s-256":
-		return func() hash.Hash { return Blake2SType256(options.Key) }
-	case "blake2b-256":
-		return func() hash.Hash { return Blake2BType256(options.Key) }
-	case "blake2b-384":
-		return func() hash.Hash { return Blake2BType384(options.Key) }
-	case "blake2b-512":
-		return func() hash.Hash { return Blake2BType512(options.Key) }
-	default:
+	hashType := strings.ToLower(options.HashType)
+
+	if composite.IsSpec(hashType) {
+		// the hash types of the specification share the other options
+		f, err := composite.Parse(hashType, func(name string) func() hash.Hash {
+			o := *options
+			o.HashType = name
+
+			return getHashFunc(&o)
+		})
+		if err != nil {
+			fatalError(err.Error())
+		}
+
+		return f
+	}
+
+	f, found := hashFuncs[hashType]
+	if !found {
 		return nil
 	}
+
+	return func() hash.Hash { return f(options) }
 }
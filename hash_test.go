@@ -1,55 +1,151 @@
 package hashed
 
 import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"os"
+	"os/exec"
 	"strings"
 	"testing"
 )
 
+// testNonce is the nonce used by the tests for GMAC.
+var testNonce = []byte("b61f4c998037")
+
+// testKey returns the key used by the tests for the hash type, the types of a fixed key size like SipHash take the
+// first bytes of it, and the unkeyed BLAKE2 types which reject a key take no key.
+func testKey(hashType string) []byte {
+	if strings.HasPrefix(hashType, "blake2") && !IsKeyed(hashType) {
+		return nil
+	}
+
+	key := []byte("46cf18a9b447991b450cad3facf5937e")
+	if size := KeySize(hashType); size > 0 {
+		return key[:size]
+	}
+
+	return key
+}
+
 func TestSums(t *testing.T) {
 	input := "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz"
 	expectedByHash := map[string]string{
-		"crc-16":       "5b66",             // ARC
-		"crc-32":       "d6213adc",         // IEEE
-		"crc-64":       "2fe68fc47360100f", // ISO
-		"md2":          "e822ce79446eff3d9afb4ac6d406dac9",
-		"md4":          "ecdf9914cbc00bf5d82f1bc002d0058f",
-		"md5":          "46cf18a9b447991b450cad3facf5937e",
-		"sha1":         "57b5a033a37d0276ea970639cc3b63cab29442fe",
-		"sha2-256":     "a58bba2cc561bddbc30505632528c8aec0c367b859555462f52fe4476dc4d4bb",
-		"sha2-256-224": "e3dd1cb48541549e27a4ab69142e1c287ddb4560faf715955ea717c7",
-		"sha2-512":     "466efae469c6833ea2fd977fa080271bb2f7a562163269eef2222636b50b7d27cde699368905bcfeb44adc9693323943b427548f5ca609a1b100b50e211762ec",
-		"sha2-512-224": "bbb18070c91bfd34e4bfe08c3f5b350257d4d50347e1bc0c045d7930",
-		"sha2-512-256": "8997c25266c1937435a2a916b89f54c09383820adbcedb1d809e5b878b8b5825",
-		"sha2-512-384": "61ec802aa2b6cbc2a4037e5cd15e0141be68bbc2644cbcaa35e62ba6224d1178525fb059d4462aac2c01faa44c79f90a",
-		"sha3-224":     "e39bb86280697e16ee67f8c0941305ed168f0c55dec87d12b277515c",
-		"sha3-256":     "43722f9b1954d61ff7e937458f12d61a7eb4eabd8b744b6a7ea2983612711084",
-		"sha3-384":     "d766b9c9bbf7dce0de23917bf753bbf19132a68c8ab6be606893c6e00538753bb60381d11d6b9a6af9971333c954ee43",
-		"sha3-512":     "8a08b4b733f8143c1b676a327b90b3b98efc86c20703ae09159ac4e2cc8f536a6b5489a4402d87fe66dfdcd0d189a4b323acd4ada8961eb2803fabb8befbb70c",
-		"keccak-224":   "1975ce9fa3191efcd0cc85cb553d7f28a8632ef955383e48c9d4d0fd",
-		"keccak-256":   "33d9df9ae4694da1d3647cf8409438f820f95fc6310c13bbd681e60c98f13e09",
-		"keccak-384":   "cd576e7288361c9d8749e2d9abc506b8be45cd83f3abf6e0fb79b597b7aaf28b35bf8c366fe1906f3732ebf628b60e60",
-		"keccak-512":   "67ca8046f7b00be66680a22e4f234a4a0822ef0e314ceaad53cb6ec0ec26f5bb6a9ed4ac75814941b2433eee5a9c43a6a234381f8f22a311e4edac36afd95628",
-		"shake-128":    "4ab6f22ebe2e71ce53964b4950a39db25681832a754bca66c3f241797e4ad78f",
-		"shake-256":    "125b77eb566466caebecf357365c9f0b918d26f4bc00b23e896e6d5c13dc875bcb63b44b63e61c02da175ef7b6f6858005b4da7ffcd7692ccded962312fa3b86",
-		"cshake-128":   "79ed336386926373c53cbf97b43ae7498b6cdf93750ad5e4bc3286d0a7b45821",
-		"cshake-256":   "bda664b322e0cdd1594ac26bc2c3dcefe9d793fdb6f68bbd8905ee5ef34077cd23e329562eb8ce931c047f30261600c5223a81cfba33d8a44dce5faeadb1d8b5",
-		"kmac-128":     "2988caaecedc1cb7c84c520c8ba32b88bd59da3434d5bf87d5817e019580ee4e",
-		"kmac-256":     "fe82a26a8dde099e916b9b70e8835abf1c9e67e1e1ae062a0c997f1635dd40e6c32079e0db9592087f3840ba803636b4adeee21ec6f6ff14c130c88038c04bcf",
-		"ripemd-128":   "b4328f031ccb7750865e3ee986f5ee9a",
-		"ripemd-160":   "d9b27c4dda5b353363352e08a0e112f8c1e0738c",
-		"ripemd-256":   "5ad114f0ccf88d0d5a5784f842d0b86884a233c8e8eb6dd3fd23745cc17090fa",
-		"ripemd-320":   "265044d981c72af8c31a1c016ab7afced26808e9e34a1b537e054c2ca6c08e71609e6cd4141d85d8",
-		"blake2s-128":  "4fd31f3310d8b8c052b764c3167dc1db",
-		"blake2s-256":  "8412d52439599e6afd799de2f4a87a5022d1714063763c7f474142ec9d46a972",
-		"blake2b-256":  "a679bb73edac2d362c522fa6c631b4aefb76cbf47cdfe2b60d2c95a9365690ca",
-		"blake2b-384":  "6307b3240154f70e166f628b397f9061f98e059db425522b2713fde806bed80754c6456bbc528155bb24c6a7414a1c6a",
-		"blake2b-512":  "c82412da330c6f8e76d33fe1fd3f8c028673defc1e037f4566c50cf604781425fee4f568f05fc0a8c5304d997d6eabae212a73f2365a64412b5ae14ec10f5534",
+		"crc-16":                  "5b66",             // ARC
+		"crc-32":                  "d6213adc",         // IEEE
+		"crc-64":                  "2fe68fc47360100f", // ISO
+		"adler-32":                "3793150c",
+		"fletcher-16":             "7020",
+		"fletcher-32":             "fb0c9f80",
+		"fletcher-64":             "eb63f46d25167a6a",
+		"bsd-sum":                 "1c81",
+		"sysv-sum":                "150b",
+		"md2":                     "e822ce79446eff3d9afb4ac6d406dac9",
+		"md4":                     "ecdf9914cbc00bf5d82f1bc002d0058f",
+		"md5":                     "46cf18a9b447991b450cad3facf5937e",
+		"sha1":                    "57b5a033a37d0276ea970639cc3b63cab29442fe",
+		"sha2-256":                "a58bba2cc561bddbc30505632528c8aec0c367b859555462f52fe4476dc4d4bb",
+		"sha2-256-224":            "e3dd1cb48541549e27a4ab69142e1c287ddb4560faf715955ea717c7",
+		"sha2-512":                "466efae469c6833ea2fd977fa080271bb2f7a562163269eef2222636b50b7d27cde699368905bcfeb44adc9693323943b427548f5ca609a1b100b50e211762ec",
+		"sha2-512-224":            "bbb18070c91bfd34e4bfe08c3f5b350257d4d50347e1bc0c045d7930",
+		"sha2-512-256":            "8997c25266c1937435a2a916b89f54c09383820adbcedb1d809e5b878b8b5825",
+		"sha2-512-384":            "61ec802aa2b6cbc2a4037e5cd15e0141be68bbc2644cbcaa35e62ba6224d1178525fb059d4462aac2c01faa44c79f90a",
+		"sha3-224":                "e39bb86280697e16ee67f8c0941305ed168f0c55dec87d12b277515c",
+		"sha3-256":                "43722f9b1954d61ff7e937458f12d61a7eb4eabd8b744b6a7ea2983612711084",
+		"sha3-384":                "d766b9c9bbf7dce0de23917bf753bbf19132a68c8ab6be606893c6e00538753bb60381d11d6b9a6af9971333c954ee43",
+		"sha3-512":                "8a08b4b733f8143c1b676a327b90b3b98efc86c20703ae09159ac4e2cc8f536a6b5489a4402d87fe66dfdcd0d189a4b323acd4ada8961eb2803fabb8befbb70c",
+		"keccak-224":              "1975ce9fa3191efcd0cc85cb553d7f28a8632ef955383e48c9d4d0fd",
+		"keccak-256":              "33d9df9ae4694da1d3647cf8409438f820f95fc6310c13bbd681e60c98f13e09",
+		"keccak-384":              "cd576e7288361c9d8749e2d9abc506b8be45cd83f3abf6e0fb79b597b7aaf28b35bf8c366fe1906f3732ebf628b60e60",
+		"keccak-512":              "67ca8046f7b00be66680a22e4f234a4a0822ef0e314ceaad53cb6ec0ec26f5bb6a9ed4ac75814941b2433eee5a9c43a6a234381f8f22a311e4edac36afd95628",
+		"shake-128":               "4ab6f22ebe2e71ce53964b4950a39db25681832a754bca66c3f241797e4ad78f",
+		"shake-256":               "125b77eb566466caebecf357365c9f0b918d26f4bc00b23e896e6d5c13dc875bcb63b44b63e61c02da175ef7b6f6858005b4da7ffcd7692ccded962312fa3b86",
+		"cshake-128":              "79ed336386926373c53cbf97b43ae7498b6cdf93750ad5e4bc3286d0a7b45821",
+		"cshake-256":              "bda664b322e0cdd1594ac26bc2c3dcefe9d793fdb6f68bbd8905ee5ef34077cd23e329562eb8ce931c047f30261600c5223a81cfba33d8a44dce5faeadb1d8b5",
+		"ascon-hash256":           "a2e734bd370d83df365d41e978e49d5cc403af9e0a476d93808f8c401cc8987e",
+		"ascon-xof128":            "4edcdab0803303eb36ed7e0571b39008bd420c5557c3e6c8f7411d60a2831059",
+		"ascon-cxof128":           "92f6af83bb3979c9d1eb4ec355f3ee3a426e3e324ed6008c4f14bd16f0008591",
+		"xoodyak":                 "a8fca213d445c87bc1f27e6ae39aba4330b3683e0cddea10c3faa3ece9591626",
+		"kmac-128":                "2988caaecedc1cb7c84c520c8ba32b88bd59da3434d5bf87d5817e019580ee4e",
+		"kmac-256":                "fe82a26a8dde099e916b9b70e8835abf1c9e67e1e1ae062a0c997f1635dd40e6c32079e0db9592087f3840ba803636b4adeee21ec6f6ff14c130c88038c04bcf",
+		"ripemd-128":              "b4328f031ccb7750865e3ee986f5ee9a",
+		"ripemd-160":              "d9b27c4dda5b353363352e08a0e112f8c1e0738c",
+		"ripemd-256":              "5ad114f0ccf88d0d5a5784f842d0b86884a233c8e8eb6dd3fd23745cc17090fa",
+		"ripemd-320":              "265044d981c72af8c31a1c016ab7afced26808e9e34a1b537e054c2ca6c08e71609e6cd4141d85d8",
+		"whirlpool":               "ee6744e3718f2a7ae979942ca1c375da7d3b65581ff1d1660a6c855bfbf9140a4a230a2e1058f333d26aae4a78b7b513dfb7ac3edf5f4b1ca563bf82b35b9242",
+		"tiger-192":               "1f77488228309f75d6d981e641529a6ccfc5a5f0baf9cb3b",
+		"tiger-160":               "1f77488228309f75d6d981e641529a6ccfc5a5f0",
+		"tiger-128":               "1f77488228309f75d6d981e641529a6c",
+		"sm3":                     "378951368fd1befd3ea1a8ec26d00bdac840be07177094e3e1a3ee58212cb0d2",
+		"streebog-256":            "1e83088d1ac141cf3af661208517ff54aa2bfa34a28e7850d7210e2bbf41893a",
+		"streebog-512":            "3b03d0e5c32c38ee757e191238c30f199ad3739b7e918b59311b277cc7e1512a77ade591a6731e3aacab16357cb2d4911c85569db4b5e316b5fdf2a74cee61e9",
+		"gost-94":                 "116f07661b0300cfbfdf48ace0083703a820b0a3d6d15f78500b007f0fc3dd18",
+		"skein-256":               "5b50612f00bcf38167fd66c30c4778ae3ef0101762d2999d74018a9c9aef986a",
+		"skein-512":               "a673e351934a6629767d73def86a26388be23dea7392f6338c5f36d03273d2f414a7d27e8956c646d3d0b8247f31bf2fa18a9e38fdc1e0912b055c566403a5f8",
+		"skein-1024":              "36e4864f030fa3278642d219320000893a19880cdc576c3899604f7aac58033681c487e14c6a8f82177ce03973dd7ecbd8841be0cc79ba3b8dff07cd183aea4881eb766eb56bae624f9cc1b9b08a012e14c93c529f553084c198db66daddda081486acf11fbed79aad8feb0b3dd34947707a86ad9fae78c4184cdb57978b1164",
+		"groestl-224":             "8f0f80363ca56298012ddf2c66b2170f920b6e4afbcb25bd871d881f",
+		"groestl-256":             "e57e7dc9a7d5007f55a562239ff7c03296b616833bcf66128970ddfbba78e5cc",
+		"groestl-384":             "cbf616c66e9d4afc20c2e0489ed31cc9653a2a812575d42b1fab40e5ff1af527c5331220a606c581cee38d910bfdb3dd",
+		"groestl-512":             "6e13a80af7466b0709ca19ec066118f2700f071f281477595193c3760511b24d80572bb32ceb6ed8f2bdc569c4c7a7134c653b71515eb3681a9d46a558de2bc7",
+		"jh-224":                  "ef66b855ef466fe56bd6e26260995530e31c67f870f6228db537cc09",
+		"jh-256":                  "efe60e75c3a6b7239e5aaeca46953ad078fd4ef1a41c92df984ef6a31168729d",
+		"jh-384":                  "d9e4173328d9b5a1257ab1c0575d5b147e38970dc2b44efdeb1df11d8cab1add0c9f17979156b67743f181385066581b",
+		"jh-512":                  "3f397842392e389a9ca31a799d45aa245df56bb91367e0f70105b0cac85020d4e2b0dbdd114d52025e0f52020b2374a57c65af980f7d055c74c4d3e5112b688c",
+		"blake-256":               "6c57aeadf9cc6eceb25439c923b7f968cd36584fcf59f542673dd623481ceee1",
+		"blake-512":               "cf96524cb20a21a1ca3ab9b357bcb1f4adf31690ec65bdef5748a52481b73e74daa8dad2f8c2d3f3116f8e1e0c46085ae83aa1f95bb55a55ea3f9a8e07acc92c",
+		"hash160":                 "2c9a327db935bc4af55f5ab7960c5a5a59f2ba07",
+		"sha256d":                 "89fa55564bd6682fffb3842dfd5ba77a5357471ecf52bc96a1447274d7daa42c",
+		"keccak256-of-pubkey":     "409438f820f95fc6310c13bbd681e60c98f13e09",
+		"sha2-256(ripemd-160(x))": "a7aa539771954ecee9e5a783ff8ac78d3dbeb7fc5af672f341eb4a8fa275c2b7",
+		"sha2-256^1000(x)":        "b470c5da4aa720da3a4b002c56ba7165562501a036ffab9464a70d772a927ad3",
+		"md5(x)||sha1(x)":         "46cf18a9b447991b450cad3facf5937e57b5a033a37d0276ea970639cc3b63cab29442fe",
+		"xxh32":                   "e65bcbe0",
+		"xxh64":                   "b394422cf6201ad6",
+		"xxh3-64":                 "5f824803521392e0",
+		"xxh3-128":                "3c822afdc172f856dd0170d01cbde6cf",
+		"murmur3-x86-32":          "4be90535",
+		"murmur3-x86-128":         "0cbef11471e6aaf13631b8eed72d1daa",
+		"murmur3-x64-128":         "a4aa00275114bff640228b77e3f32291",
+		"fnv1-32":                 "238565e8",
+		"fnv1a-32":                "5105292e",
+		"fnv1-64":                 "31083359193fa488",
+		"fnv1a-64":                "c26fed1da355722e",
+		"fnv1-128":                "8581a57ba138670a1b29fdff406dd860",
+		"fnv1a-128":               "caf3f0461475e294ddf53b546d15e91e",
+		"cityhash-64":             "0000d12a8adefb5c",
+		"cityhash-128":            "a42fb75657b1d0887860795c3810ba0e",
+		"siphash-2-4-64":          "149dcf6dfe34436b",
+		"siphash-2-4-128":         "3e67ca3278380e15609f109296aeda88",
+		"siphash-1-3-64":          "851b582a93c3c9ee",
+		"siphash-1-3-128":         "a164493a53b408795b5af100b51369f1",
+		"blake2s-128":             "a9449bd4a82d391ae5db2dad91e7d5f4",
+		"blake2s-256":             "05134ad3a72203e6a22ffff99ebb5498a3defe49a14423da10cc94de96644ce8",
+		"blake2b-256":             "b5f1b7c9ed835b6fa1fb4b0b67a8f1bc0da9fa727e1315a4218b401fb2e5cae0",
+		"blake2b-384":             "24d2c5cf30555f61c48b96e9d74874f364d310d96e56d0c1d27c8baeba5cab44b97812b255ea87f615f2d846bfd506bb",
+		"blake2b-512":             "fbdb4a08d1e157ba2644bce6208d3ee4ec1c87237ffa08f732a4d70afd18ec7d5c7be2c53a72076135d0be2e2e308f6c2c1485d8bbd4e2412f89547009078936",
+		"blake2b":                 "fbdb4a08d1e157ba2644bce6208d3ee4ec1c87237ffa08f732a4d70afd18ec7d5c7be2c53a72076135d0be2e2e308f6c2c1485d8bbd4e2412f89547009078936",
+		"blake2s":                 "05134ad3a72203e6a22ffff99ebb5498a3defe49a14423da10cc94de96644ce8",
+		"blake2bp":                "1d190a9b5d1c8105af7cade0b021e34e03717d24097c768dd9c66a4b032d2824b97db73a00220ba4888b7a65bba1b8a4d9ea7fe0f4592cd6b8322fcd393f518b",
+		"blake2sp":                "ecb3c03906c9467042036d6eb136fbc3a87258e2a50c85ecd3acc8917c180e21",
+		"blake2xb":                "9b6cffbfc5b64b431307293f29c80e8b94a77b980247de6ab955051fa1cf5bd623f1e63da401b45e3667ee0b93859b1a7fda75a87bcee82afe0e693605ca7c02",
+		"blake2xs":                "8f43574081adc814f52ccacd545611d7bbb5cbdcc6f42c17bf0be5abe0ba3d6a",
+		"blake2s-128-mac":         "4fd31f3310d8b8c052b764c3167dc1db",
+		"blake2s-256-mac":         "8412d52439599e6afd799de2f4a87a5022d1714063763c7f474142ec9d46a972",
+		"blake2b-256-mac":         "a679bb73edac2d362c522fa6c631b4aefb76cbf47cdfe2b60d2c95a9365690ca",
+		"blake2b-384-mac":         "6307b3240154f70e166f628b397f9061f98e059db425522b2713fde806bed80754c6456bbc528155bb24c6a7414a1c6a",
+		"blake2b-512-mac":         "c82412da330c6f8e76d33fe1fd3f8c028673defc1e037f4566c50cf604781425fee4f568f05fc0a8c5304d997d6eabae212a73f2365a64412b5ae14ec10f5534",
+		"blake2b-mac":             "c82412da330c6f8e76d33fe1fd3f8c028673defc1e037f4566c50cf604781425fee4f568f05fc0a8c5304d997d6eabae212a73f2365a64412b5ae14ec10f5534",
+		"blake2s-mac":             "8412d52439599e6afd799de2f4a87a5022d1714063763c7f474142ec9d46a972",
+		"blake2bp-mac":            "715dd6ab55424af57cda8670a7cce1381e78d0e61c9837e53c8310bf00bee788f2f78cbb7d6506e31e0a26c52bd1368e88918925039fcebb07347437d954b0a9",
+		"blake2sp-mac":            "7c4bfbccc14b89af8f9c047e9f847d24df3920e8ecec31c5ad0d77b3bb26b2ff",
+		"blake2xb-mac":            "1793ac0e073fff29491e9bfab83fd6df7419323e292e6b681735be308b9d019799edb50361ae264eca551bdca5d74ec27f9567fb9aa37e6b83605e7d8f070768",
+		"blake2xs-mac":            "f963412eaa51c089998f85389719112123cd7d23e8205b0c924b91077bb186da",
 	}
 
 	for _, hashType := range sortedKeys(expectedByHash) {
 		expected := expectedByHash[hashType]
 		h := New(DefaultOptions(hashType).
-			SetKey([]byte("46cf18a9b447991b450cad3facf5937e")).
+			SetKey(testKey(hashType)).
 			SetFunctionName([]byte("b61f4c9980370150e1dcf7aa770c58dc")).
 			SetCustomization([]byte("8df75ae53e4bdf7b5ae9c09bd0baffb1")))
 
@@ -59,3 +155,128 @@ func TestSums(t *testing.T) {
 		}
 	}
 }
+
+func TestHMac(t *testing.T) {
+	// the key and the data of the HMAC examples of RFC 7836, which gives the streebog values, the gost-94 value is
+	// the HMAC_GOSTR3411_CP vector of the libgcrypt tests (tests/basic.c) on the CryptoPro parameters and the sm3
+	// value is of "openssl mac -digest SM3 HMAC" of OpenSSL 3.0
+	key, _ := hex.DecodeString("000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f")
+	data, _ := hex.DecodeString("0126bdb87800af214341456563780100")
+
+	cases := []struct {
+		hashType string
+		subType  string
+		expected string
+	}{
+		{"streebog-256", "", "a1aa5f7de402d7b3d323f2991c8d4534013137010a83754fd0af6d7cd4922ed9"},
+		{"streebog-512", "", "a59bab22ecae19c65fbde6e5f4e9f5d8549d31f037f9df9b905500e171923a773d5f1530f2ed7e964cb2eedc29e9ad2f3afe93b2814f79f5000ffc0366c251e6"},
+		{"gost-94", "cryptopro", "bad70b61c41095bc47e1141cfaed42726a5ceebd62ce75dbbb9ad76cda9f72f7"},
+		{"sm3", "", "a41f1afc983622ce2ff66cf6aec29c4f3aa53d83d3ae910b895107dac0e4eed6"},
+	}
+
+	for _, c := range cases {
+		h := New(DefaultOptions(c.hashType).SetSubType(c.subType))
+		h.HMac(key)
+
+		if output := h.GetSumHex(bytes.NewReader(data), false); output != c.expected {
+			t.Errorf("'%s/%s' hmac is wrong:\n\texpected \"%s\"\n\tgot \"%s\"", c.hashType, c.subType, c.expected, output)
+		}
+	}
+}
+
+func TestMacs(t *testing.T) {
+	decode := func(s string) []byte {
+		b, _ := hex.DecodeString(s)
+		return b
+	}
+
+	msg := decode("6bc1bee22e409f96e93d7e117393172aae2d8a571e03ac9c9eb76fac45af8e51")
+
+	// the examples of RFC 4493, SP 800-38B, GCM, ANSI X9.9, X9.19 and RFC 8439
+	cases := []struct {
+		hashType string
+		key      []byte
+		options  func(*Options)
+		data     []byte
+		expected string
+	}{
+		{"cmac-aes128", decode("2b7e151628aed2a6abf7158809cf4f3c"), nil, msg[:16], "070a16b46b4d4144f79bdd9dd04a287c"},
+		{"cmac-tdes", decode("8aa83bf8cbda10620bc1bf19fbb6cd58bc313d4a371ca8b5"), nil, msg[:16], "286d394673448197"},
+		{"gmac-aes128", make([]byte, 16), func(o *Options) { o.SetNonce(make([]byte, 12)) }, nil, "58e2fccefa7e3061367f1d57a4e7455a"},
+		{"cbcmac-aes128", decode("2b7e151628aed2a6abf7158809cf4f3c"), func(o *Options) { o.SetSubType("bit") }, msg[:16], "0539bda30b3f7634466a75d98418bf65"},
+		{"cbcmac-des", decode("0123456789abcdef"), nil, []byte("Now is the time for all "), "70a30640cc76dd8b"},
+		{"retail-mac-des", decode("0123456789abcdeffedcba9876543210"), nil, []byte("Now is the time for all "), "a1c72e74ea3fa9b6"},
+		{"poly1305", decode("85d6be7857556d337f4452fe42d506a80103808afb0db2fd4abff6af4149f51b"), nil, []byte("Cryptographic Forum Research Group"), "a8061dc1305136c6c22b8baf0c0127a9"},
+	}
+
+	for _, c := range cases {
+		options := DefaultOptions(c.hashType).SetKey(c.key)
+		if c.options != nil {
+			c.options(options)
+		}
+
+		if output := New(options).GetSumHex(bytes.NewReader(c.data), false); output != c.expected {
+			t.Errorf("'%s' is wrong:\n\texpected \"%s\"\n\tgot \"%s\"", c.hashType, c.expected, output)
+		}
+	}
+}
+
+func TestForge(t *testing.T) {
+	data := []byte("0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz")
+	cases := []struct {
+		hashType string
+		subType  string
+		target   string
+	}{
+		{"crc-16", "arc", "1234"},
+		{"crc-16", "x-25", "beef"},
+		{"crc-16", "xmodem", "0000"},
+		{"crc-32", "ieee", "deadbeef"},
+		{"crc-32", "castagnoli", "00000000"},
+		{"crc-64", "iso", "0123456789abcdef"},
+		{"crc-64", "ecma", "ffffffffffffffff"},
+	}
+
+	for _, c := range cases {
+		target, _ := hex.DecodeString(c.target)
+
+		for _, offset := range []int{0, 7, len(data) - 8, len(data)} {
+			h := New(DefaultOptions(c.hashType).SetSubType(c.subType))
+
+			forged := h.ForgeInsert(data, offset, target)
+			inserted := append(append(append([]byte{}, data[:offset]...), forged...), data[offset:]...)
+			if output := h.GetSumHex(bytes.NewReader(inserted), false); output != c.target {
+				t.Errorf("'%s/%s' insert at %d is wrong:\n\texpected \"%s\"\n\tgot \"%s\"", c.hashType, c.subType, offset, c.target, output)
+			}
+
+			if offset+len(forged) > len(data) {
+				continue
+			}
+
+			forged = h.ForgePatch(data, offset, target)
+			patched := append(append(append([]byte{}, data[:offset]...), forged...), data[offset+len(forged):]...)
+			if output := h.GetSumHex(bytes.NewReader(patched), false); output != c.target {
+				t.Errorf("'%s/%s' patch at %d is wrong:\n\texpected \"%s\"\n\tgot \"%s\"", c.hashType, c.subType, offset, c.target, output)
+			}
+		}
+	}
+}
+
+func TestWideSeeds(t *testing.T) {
+	// the hash is created in a child process, as the error exits
+	if hashType := os.Getenv("HASHED_WIDE_SEED"); hashType != "" {
+		New(DefaultOptions(hashType).SetSeed(1 << 32))
+		return
+	}
+
+	for _, hashType := range []string{"xxh32", "murmur3-x86-32", "murmur3-x86-128", "murmur3-x64-128"} {
+		cmd := exec.Command(os.Args[0], "-test.run=^TestWideSeeds$")
+		cmd.Env = append(os.Environ(), "HASHED_WIDE_SEED="+hashType)
+		output, err := cmd.Output()
+
+		var exitErr *exec.ExitError
+		if !errors.As(err, &exitErr) || !strings.Contains(string(output), "seed is greater than 32 bits") {
+			t.Errorf("'%s' with a 33-bit seed returned %v: %s", hashType, err, output)
+		}
+	}
+}
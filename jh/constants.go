@@ -0,0 +1,19 @@
+package jh
+
+const (
+	// Size224 is the size of a JH-224 checksum in bytes.
+	Size224 = 28
+	// Size256 is the size of a JH-256 checksum in bytes.
+	Size256 = 32
+	// Size384 is the size of a JH-384 checksum in bytes.
+	Size384 = 48
+	// Size512 is the size of a JH-512 checksum in bytes.
+	Size512 = 64
+	// BlockSize the block size of JH in bytes.
+	BlockSize = 64
+
+	// stateSize is the size of the state in bytes.
+	stateSize = 128
+	// rounds is the number of rounds of the bijective function E8.
+	rounds = 42
+)
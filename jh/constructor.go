@@ -0,0 +1,35 @@
+// Package jh implements the JH hash algorithm of the final round of the SHA-3 competition,
+// with the bitslice implementation of the round function.
+package jh
+
+import "hash"
+
+//go:generate go run gen.go
+
+// New224 creates a new JH-224 hash.Hash.
+func New224() hash.Hash { return newModel(Size224) }
+
+// New256 creates a new JH-256 hash.Hash.
+func New256() hash.Hash { return newModel(Size256) }
+
+// New384 creates a new JH-384 hash.Hash.
+func New384() hash.Hash { return newModel(Size384) }
+
+// New512 creates a new JH-512 hash.Hash.
+func New512() hash.Hash { return newModel(Size512) }
+
+// newModel creates a new JH model of the size.
+func newModel(size int) *model {
+	h := &model{size: size}
+
+	// the initial value is the state with the size in bits in the first two bytes, compressed with a zero block
+	bits := size << 3
+	h.iv[0] = uint64(bits>>8) | uint64(bits&0xff)<<8
+	h.state = h.iv
+	h.compress(make([]byte, BlockSize))
+	h.iv = h.state
+
+	h.Reset()
+
+	return h
+}
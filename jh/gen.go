@@ -0,0 +1,185 @@
+//go:build ignore
+
+// This program generates roundconstants.go, run it with "go generate".
+//
+// The round constants are defined over the nibbles of the reference implementation, where the constant C0 is updated
+// by the round function R6 with the S-box S0. The bitslice implementation keeps the nibbles in four words of bits,
+// the even nibbles in the words 0, 4, 8 and 12 and the odd ones in the words 2, 6, 10 and 14 (each with its next word),
+// and instead of the permutation P8 it only swaps the bits of the odd nibbles, so the nibble at a bit position changes
+// from round to round and the bits of the constants are placed by following them.
+package main
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"go/format"
+	"os"
+)
+
+const rounds = 42
+
+// s0 is the S-box S0, it is the only one used by the update of the round constants.
+var s0 = [16]byte{9, 0, 4, 11, 13, 12, 3, 15, 1, 10, 2, 6, 7, 5, 8, 14}
+
+// permutation returns the permutation P_d of 2^d nibbles, the nibble moved to the position i comes from p[i].
+func permutation(d int) []int {
+	n := 1 << d
+
+	// Pi_d
+	t := make([]int, n)
+	for i := range t {
+		t[i] = i
+	}
+
+	for i := 0; i < n; i += 4 {
+		t[i+2], t[i+3] = t[i+3], t[i+2]
+	}
+
+	// P'_d
+	p := make([]int, n)
+	for i := 0; i < n/2; i++ {
+		p[i] = t[2*i]
+		p[i+n/2] = t[2*i+1]
+	}
+
+	// Phi_d
+	for i := n / 2; i < n; i += 2 {
+		p[i], p[i+1] = p[i+1], p[i]
+	}
+
+	return p
+}
+
+// linear is the linear transformation L, it mixes two nibbles.
+func linear(a, b byte) (byte, byte) {
+	b ^= (a<<1 ^ a>>3 ^ a>>2&2) & 0xf
+	a ^= (b<<1 ^ b>>3 ^ b>>2&2) & 0xf
+
+	return a, b
+}
+
+// constants returns the round constants of the reference implementation, as nibbles.
+func constants() (c [rounds][64]byte) {
+	c0, err := hex.DecodeString("6a09e667f3bcc908b2fb1366ea957d3e3adec17512775099da2f590b0667322a")
+	if err != nil {
+		panic(err)
+	}
+
+	for i, x := range c0 {
+		c[0][2*i], c[0][2*i+1] = x>>4, x&0xf
+	}
+
+	p := permutation(6)
+
+	for r := 1; r < rounds; r++ {
+		var t [64]byte
+		for i, x := range c[r-1] {
+			t[i] = s0[x]
+		}
+
+		for i := 0; i < 64; i += 2 {
+			t[i], t[i+1] = linear(t[i], t[i+1])
+		}
+
+		for i := range c[r] {
+			c[r][i] = t[p[i]]
+		}
+	}
+
+	return c
+}
+
+// position returns the bit position of the bitslice state, the group is 0 for the even nibbles and 1 for the odd ones,
+// the half is the first or the second word of the group.
+func position(group, half, bit int) int { return group<<7 | half<<6 | bit }
+
+// swap returns the position after the swap of the round, only the odd nibbles are swapped.
+func swap(round, pos int) int {
+	if pos>>7 == 0 {
+		return pos
+	}
+
+	if k := round % 7; k < 6 {
+		return pos ^ 1<<k
+	}
+
+	return pos ^ 1<<6
+}
+
+func main() {
+	c := constants()
+
+	// the nibble of the reference implementation at each position of the bitslice state, the words of the state are
+	// loaded in little-endian order and the reference groups the bits i, i + 256, i + 512 and i + 768 of the state
+	var nibbles, initial [256]int
+	for half := 0; half < 2; half++ {
+		for bit := 0; bit < 64; bit++ {
+			i := 8*(8*half+bit/8) + 7 - bit%8
+			nibbles[position(0, half, bit)] = 2 * i
+			nibbles[position(1, half, bit)] = 2*i + 1
+		}
+	}
+
+	initial = nibbles
+
+	// inverse is the position where the permutation P8 moves each nibble
+	p := permutation(8)
+	inverse := make([]int, len(p))
+	for i, x := range p {
+		inverse[x] = i
+	}
+
+	var words [rounds][4]uint64
+
+	for r := 0; r < rounds; r++ {
+		for pos, nibble := range nibbles {
+			// the bit of the constant selects the S-box of the nibble
+			bit := c[r][nibble>>2] >> (3 - nibble&3) & 1
+
+			words[r][pos>>6] |= uint64(bit) << (pos & 63)
+		}
+
+		var next [256]int
+		for pos, nibble := range nibbles {
+			next[swap(r, pos)] = inverse[nibble]
+		}
+
+		// the linear transformation mixes the nibbles at the same position of both groups
+		for pos := 0; pos < 128; pos++ {
+			if next[pos]&1 != 0 || next[pos+128] != next[pos]+1 {
+				panic("the bitslice state does not follow the permutation")
+			}
+		}
+
+		nibbles = next
+	}
+
+	if nibbles != initial {
+		panic("the bitslice state does not end in the initial order")
+	}
+
+	var b bytes.Buffer
+
+	fmt.Fprintln(&b, "// Code generated by gen.go. DO NOT EDIT.")
+	fmt.Fprintln(&b)
+	fmt.Fprintln(&b, "package jh")
+	fmt.Fprintln(&b)
+	fmt.Fprintln(&b, "// roundConstants are the constants of the rounds for the even and the odd nibbles of the bitslice state.")
+	fmt.Fprintln(&b, "var roundConstants = [rounds][4]uint64{")
+
+	for _, w := range words {
+		fmt.Fprintf(&b, "{0x%016x, 0x%016x, 0x%016x, 0x%016x},\n", w[0], w[1], w[2], w[3])
+	}
+
+	fmt.Fprintln(&b, "}")
+
+	src, err := format.Source(b.Bytes())
+	if err != nil {
+		panic(err)
+	}
+
+	if err = os.WriteFile("roundconstants.go", src, 0o644); err != nil {
+		panic(err)
+	}
+}
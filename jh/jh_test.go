@@ -0,0 +1,57 @@
+package jh
+
+import (
+	"encoding/hex"
+	"hash"
+	"strings"
+	"testing"
+)
+
+func TestExamples(t *testing.T) {
+	cases := []struct {
+		newHash  func() hash.Hash
+		input    string
+		expected string
+	}{
+		// the empty message of the known answer tests of the submission
+		{New224, "", "2c99df889b019309051c60fecc2bd285a774940e43175b76b2626630"},
+		{New256, "", "46e64619c18bb0a92a5e87185a47eef83ca747b8fcc8e1412921357e326df434"},
+		{New384, "", "2fe5f71b1b3290d3c017fb3c1a4d02a5cbeb03a0476481e25082434a881994b0ff99e078d2c16b105ad069b569315328"},
+		{New512, "", "90ecf2f76f9d2c8017d979ad5ab96b87d58fc8fc4b83060f3f900774faa2c8fabe69c5f4ff1ec2b61d6b316941cedee117fb04b1f4c5bc1b919ae841c50eec4f"},
+		{New256, "The quick brown fox jumps over the lazy dog", "6a049fed5fc6874acfdc4a08b568a4f8cbac27de933496f031015b38961608a0"},
+		// the messages at the padding boundary of the blocks of 64 bytes, the padding is at least one block,
+		// and the messages of several blocks, computed with an independent implementation of the specification
+		{New224, strings.Repeat("a", 64), "b3ba6a3fb9d90ed21ef62be5dd24da2d1c284a5310808278ecfeb2e1"},
+		{New256, strings.Repeat("a", 63), "16bd79b25403e282b66032c38d43843e97dea89c07a7b32dd3bc8a5e96cb0d18"},
+		{New256, strings.Repeat("a", 64), "05733727efdd236118340ec8f870689c0c9e571d3ff64614cfea082599e56593"},
+		{New256, strings.Repeat("a", 65), "f041374209f5d91e17a3d63f987fe97d3be41e1532a894b82f1e6c4f433792ce"},
+		{New256, strings.Repeat("a", 200), "6880d6d100b306756d8c647254392f27b88c25a3e0c01ea964ddd84d1aa92202"},
+		{New384, strings.Repeat("a", 128), "f6586e28787ff6cc1808b0f11e4b76c3ec5d6477d6a2c933758805df8cf976890d783006a1b5bbe01300c1ba2d9226d9"},
+		{New512, strings.Repeat("a", 64), "c281e8f3175ebcee659630561f38756a033af80c409f517638c3a2e4cdd20687a5dbadcea4ce6c301a7ce4e25817c85b55cab730caa1a33fd103c059097613c9"},
+		{New512, strings.Repeat("a", 300), "40201ff1a2099e13494484610ba59af75c90be47d773c1c63304b677c26fcbb8784d7b21aa6b5556f3f93695a97a868382c839b52ac2d85965b5155a3f5da1fe"},
+	}
+
+	for _, c := range cases {
+		h := c.newHash()
+		h.Write([]byte(c.input))
+
+		if got := hex.EncodeToString(h.Sum(nil)); got != c.expected {
+			t.Errorf("sum of \"%s\" of size %d is wrong:\n\texpected \"%s\"\n\tgot \"%s\"", c.input, h.Size(), c.expected, got)
+		}
+	}
+}
+
+func benchmarkJh(b *testing.B, h hash.Hash) {
+	data := make([]byte, 8192)
+
+	b.SetBytes(int64(len(data)))
+	for i := 0; i < b.N; i++ {
+		h.Reset()
+		h.Write(data)
+		h.Sum(nil)
+	}
+}
+
+func BenchmarkJh256(b *testing.B) { benchmarkJh(b, New256()) }
+
+func BenchmarkJh512(b *testing.B) { benchmarkJh(b, New512()) }
@@ -0,0 +1,174 @@
+package jh
+
+import "encoding/binary"
+
+// model represents a structure for the JH hash.Hash.
+type model struct {
+	size        int
+	iv          [stateSize / 8]uint64
+	state       [stateSize / 8]uint64
+	buffer      [BlockSize]byte
+	bufferIndex int
+	length      uint64
+}
+
+// swapMasks are the masks of the bits swapped with the next ones by the rounds, except the last of every seven rounds
+// which swaps the words.
+var swapMasks = [6]uint64{
+	0x5555555555555555, 0x3333333333333333, 0x0f0f0f0f0f0f0f0f,
+	0x00ff00ff00ff00ff, 0x0000ffff0000ffff, 0x00000000ffffffff,
+}
+
+// implementation of the hash.Hash
+
+// Reset resets the hash.Hash to its initial state.
+func (r *model) Reset() {
+	r.state = r.iv
+	r.bufferIndex = 0
+	r.length = 0
+}
+
+// Size returns the number of bytes Sum will return.
+func (r *model) Size() int { return r.size }
+
+// BlockSize returns the hash's underlying block size.
+// The Write method must be able to accept any amount of data,
+// but it may operate more efficiently if all writes are a multiple of the block size.
+func (r *model) BlockSize() int { return BlockSize }
+
+// Write appends the data to the digest.
+func (r *model) Write(p []byte) (int, error) {
+	n := len(p)
+	r.length += uint64(n)
+
+	if r.bufferIndex > 0 {
+		x := copy(r.buffer[r.bufferIndex:], p)
+		r.bufferIndex += x
+		p = p[x:]
+
+		if r.bufferIndex < BlockSize {
+			return n, nil
+		}
+
+		r.compress(r.buffer[:])
+		r.bufferIndex = 0
+	}
+
+	for ; len(p) >= BlockSize; p = p[BlockSize:] {
+		r.compress(p)
+	}
+
+	r.bufferIndex = copy(r.buffer[:], p)
+
+	return n, nil
+}
+
+// Sum appends the current hash to b and returns the resulting slice.
+// It does not change the underlying hash state.
+func (r *model) Sum(b []byte) []byte {
+	// copy the model to allow other writes to continue and to prevent change of the state
+	s := *r
+
+	// the padding is a one bit and zeros up to a block, which is the last one if the message fills the blocks,
+	// followed by a block of zeros and the length in bits as a 128 bits big-endian number
+	var padding [2 * BlockSize]byte
+	padding[0] = 0x80
+
+	n := 0
+	if s.bufferIndex > 0 {
+		n = BlockSize - s.bufferIndex
+	}
+
+	binary.BigEndian.PutUint64(padding[n+BlockSize-16:], s.length>>61)
+	binary.BigEndian.PutUint64(padding[n+BlockSize-8:], s.length<<3)
+	_, _ = s.Write(padding[:n+BlockSize])
+
+	// the output is the last bytes of the state
+	var output [stateSize]byte
+	for i, x := range s.state {
+		binary.LittleEndian.PutUint64(output[8*i:], x)
+	}
+
+	return append(b, output[stateSize-s.size:]...)
+}
+
+// private
+
+// compress compresses a block into the state by the compression function F8.
+func (r *model) compress(block []byte) {
+	var m [BlockSize / 8]uint64
+	for i := range m {
+		m[i] = binary.LittleEndian.Uint64(block[8*i:])
+		r.state[i] ^= m[i]
+	}
+
+	r.permute()
+
+	for i, x := range m {
+		r.state[len(m)+i] ^= x
+	}
+}
+
+// permute applies the bijective function E8 to the state.
+// The even nibbles are in the words 0, 4, 8 and 12 and the odd ones in the words 2, 6, 10 and 14, each with its next
+// word, the bits of a nibble are at the same position of the four words.
+func (r *model) permute() {
+	x := &r.state
+
+	for round := 0; round < rounds; round++ {
+		c := &roundConstants[round]
+		k := round % 7
+
+		for i := 0; i < 2; i++ {
+			a0, a1, a2, a3 := sBox(x[i], x[4+i], x[8+i], x[12+i], c[i])
+			b0, b1, b2, b3 := sBox(x[2+i], x[6+i], x[10+i], x[14+i], c[2+i])
+
+			// the linear transformation L of the even and the odd nibbles
+			b0 ^= a1
+			b1 ^= a2
+			b2 ^= a0 ^ a3
+			b3 ^= a0
+			a0 ^= b1
+			a1 ^= b2
+			a2 ^= b0 ^ b3
+			a3 ^= b0
+
+			// the permutation swaps the odd nibbles
+			if k < 6 {
+				b0, b1, b2, b3 = swap(b0, k), swap(b1, k), swap(b2, k), swap(b3, k)
+			}
+
+			x[i], x[4+i], x[8+i], x[12+i] = a0, a1, a2, a3
+			x[2+i], x[6+i], x[10+i], x[14+i] = b0, b1, b2, b3
+		}
+
+		if k == 6 {
+			x[2], x[3] = x[3], x[2]
+			x[6], x[7] = x[7], x[6]
+			x[10], x[11] = x[11], x[10]
+			x[14], x[15] = x[15], x[14]
+		}
+	}
+}
+
+// sBox applies the S-boxes to the nibbles of the four words, the bit of the constant selects S1 over S0.
+func sBox(m0, m1, m2, m3, c uint64) (uint64, uint64, uint64, uint64) {
+	m3 = ^m3
+	m0 ^= ^m2 & c
+	t := c ^ m0&m1
+	m0 ^= m2 & m3
+	m3 ^= ^m1 & m2
+	m1 ^= m0 & m2
+	m2 ^= m0 & ^m3
+	m0 ^= m1 | m3
+	m3 ^= m1 & m2
+	m1 ^= t & m0
+	m2 ^= t
+
+	return m0, m1, m2, m3
+}
+
+// swap swaps the groups of 2^k bits with the next ones.
+func swap(x uint64, k int) uint64 {
+	return x&swapMasks[k]<<(1<<k) | x>>(1<<k)&swapMasks[k]
+}
@@ -0,0 +1,314 @@
+package hashed
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/hex"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+
+	"hashed/keccak"
+)
+
+// rspRecord represents a record of a response file, including the parameters of its section.
+type rspRecord map[string]string
+
+// hex returns the decoded value of the hex field.
+func (r rspRecord) hex(t *testing.T, field string) []byte {
+	b, err := hex.DecodeString(r[field])
+	if err != nil {
+		t.Fatalf("invalid hex in field %s: %s", field, err)
+	}
+
+	return b
+}
+
+// int returns the value of the integer field.
+func (r rspRecord) int(t *testing.T, field string) int {
+	n, err := strconv.Atoi(r[field])
+	if err != nil {
+		t.Fatalf("invalid integer in field %s: %s", field, err)
+	}
+
+	return n
+}
+
+// message returns the Msg field truncated to Len bits, ok is false for messages which are not byte-oriented.
+func (r rspRecord) message(t *testing.T) (msg []byte, ok bool) {
+	msg = r.hex(t, "Msg")
+
+	if _, found := r["Len"]; !found {
+		return msg, true
+	}
+
+	bitLen := r.int(t, "Len")
+	if bitLen%8 != 0 {
+		return nil, false
+	}
+
+	return msg[:bitLen/8], true
+}
+
+// parseRsp parses a NIST CAVP style response file.
+// Records are blocks of "key = value" lines separated by empty lines,
+// and "[key = value]" lines set the parameters of the following records.
+func parseRsp(t *testing.T, path string) []rspRecord {
+	f, err := os.Open(path)
+	if err != nil {
+		t.Fatalf("cannot open %s: %s", path, err)
+	}
+	defer f.Close()
+
+	var (
+		records []rspRecord
+		record  rspRecord
+		params  = make(map[string]string)
+		scanner = bufio.NewScanner(f)
+	)
+
+	scanner.Buffer(nil, 1<<24)
+
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+
+		switch {
+		case line == "":
+			record = nil
+		case strings.HasPrefix(line, "#"):
+		case strings.HasPrefix(line, "["):
+			key, value, found := strings.Cut(strings.Trim(line, "[]"), "=")
+			if found {
+				params[strings.TrimSpace(key)] = strings.TrimSpace(value)
+			}
+		default:
+			key, value, found := strings.Cut(line, "=")
+			if !found {
+				t.Fatalf("invalid line in %s: %s", path, line)
+			}
+
+			if record == nil {
+				record = make(rspRecord)
+				for k, v := range params {
+					record[k] = v
+				}
+
+				records = append(records, record)
+			}
+
+			record[strings.TrimSpace(key)] = strings.TrimSpace(value)
+		}
+	}
+
+	if err = scanner.Err(); err != nil {
+		t.Fatalf("cannot read %s: %s", path, err)
+	}
+
+	if len(records) == 0 {
+		t.Fatalf("no records in %s", path)
+	}
+
+	return records
+}
+
+// katSum returns the sum of msg by the registered hash type.
+func katSum(options *Options, msg []byte) []byte {
+	return New(options).GetSum(bytes.NewReader(msg))
+}
+
+// checkMessages runs the Msg/MD records of the response file against the hash type.
+func checkMessages(t *testing.T, path, hashType string) {
+	for i, record := range parseRsp(t, path) {
+		msg, ok := record.message(t)
+		if !ok {
+			continue
+		}
+
+		expected := record.hex(t, "MD")
+		if output := katSum(DefaultOptions(hashType), msg); !bytes.Equal(expected, output) {
+			t.Errorf("'%s' record %d of %s is wrong:\n\texpected \"%x\"\n\tgot \"%x\"", hashType, i, path, expected, output)
+		}
+	}
+}
+
+// checkMonte runs the Monte Carlo records of the response file against the hash type.
+// SHA-2 chains the last three digests as the message, SHA-3 uses the last digest only.
+func checkMonte(t *testing.T, path, hashType string, sha3 bool) {
+	records := parseRsp(t, path)
+	seed := records[0].hex(t, "Seed")
+	options := DefaultOptions(hashType)
+
+	for _, record := range records[1:] {
+		if sha3 {
+			for i := 0; i < 1000; i++ {
+				seed = katSum(options, seed)
+			}
+		} else {
+			md := [3][]byte{seed, seed, seed}
+			for i := 0; i < 1000; i++ {
+				msg := append(append(append([]byte{}, md[0]...), md[1]...), md[2]...)
+				md = [3][]byte{md[1], md[2], katSum(options, msg)}
+			}
+
+			seed = md[2]
+		}
+
+		expected := record.hex(t, "MD")
+		if !bytes.Equal(expected, seed) {
+			t.Fatalf("'%s' count %s of %s is wrong:\n\texpected \"%x\"\n\tgot \"%x\"", hashType, record["COUNT"], path, expected, seed)
+		}
+	}
+}
+
+// checkVariableOut runs the variable output records of the response file against the XOF hash type.
+func checkVariableOut(t *testing.T, path, hashType string) {
+	for _, record := range parseRsp(t, path) {
+		h := New(DefaultOptions(hashType))
+		_, _ = h.Write(record.hex(t, "Msg"))
+
+		reader, ok := h.Hash.(io.Reader)
+		if !ok {
+			t.Fatalf("'%s' is not an extendable-output function", hashType)
+		}
+
+		output := make([]byte, record.int(t, "Outputlen")/8)
+		_, _ = reader.Read(output)
+
+		expected := record.hex(t, "Output")
+		if !bytes.Equal(expected, output) {
+			t.Errorf("'%s' count %s of %s is wrong:\n\texpected \"%x\"\n\tgot \"%x\"", hashType, record["COUNT"], path, expected, output)
+		}
+	}
+}
+
+// checkCustomized runs the Msg/Z/MD records of the known answer test file against the XOF hash type,
+// with the customization string Z and the size of the MD.
+func checkCustomized(t *testing.T, path, hashType string) {
+	for i, record := range parseRsp(t, path) {
+		expected := record.hex(t, "MD")
+		options := DefaultOptions(hashType).
+			SetCustomization(record.hex(t, "Z")).
+			SetSize(len(expected))
+
+		if output := katSum(options, record.hex(t, "Msg")); !bytes.Equal(expected, output) {
+			t.Errorf("'%s' record %d of %s is wrong:\n\texpected \"%x\"\n\tgot \"%x\"", hashType, i, path, expected, output)
+		}
+	}
+}
+
+// checkSponge runs the Msg/MD records of the response file against sponge hashing with the parameters of the records.
+func checkSponge(t *testing.T, path string) {
+	for i, record := range parseRsp(t, path) {
+		params := keccak.Params{
+			Width:    record.int(t, "Width"),
+			Capacity: record.int(t, "Capacity"),
+			Domain:   keccak.DomainNone,
+			Rounds:   record.int(t, "Rounds"),
+		}
+
+		h, err := keccak.NewHash(params, record.int(t, "L")/8)
+		if err != nil {
+			t.Fatalf("invalid parameters %+v in %s: %s", params, path, err)
+		}
+
+		msg, _ := record.message(t)
+		_, _ = h.Write(msg)
+
+		expected := record.hex(t, "MD")
+		if output := h.Sum(nil); !bytes.Equal(expected, output) {
+			t.Errorf("record %d of %s is wrong:\n\texpected \"%x\"\n\tgot \"%x\"", i, path, expected, output)
+		}
+	}
+}
+
+func TestCAVP(t *testing.T) {
+	// the response files hold the records of the NIST files, not every hash type has LongMsg and Monte records
+	vectors := []struct {
+		name     string
+		hashType string
+		long     bool
+		monte    bool
+	}{
+		{"SHA224", "sha2-256-224", true, true},
+		{"SHA256", "sha2-256", true, true},
+		{"SHA384", "sha2-512-384", true, false},
+		{"SHA512", "sha2-512", true, true},
+		{"SHA512_224", "sha2-512-224", false, true},
+		{"SHA512_256", "sha2-512-256", false, false},
+		{"SHA3_224", "sha3-224", true, false},
+		{"SHA3_256", "sha3-256", true, false},
+		{"SHA3_384", "sha3-384", false, false},
+		{"SHA3_512", "sha3-512", false, false},
+	}
+
+	for _, v := range vectors {
+		sha3 := strings.HasPrefix(v.name, "SHA3")
+
+		t.Run(v.name, func(t *testing.T) {
+			checkMessages(t, filepath.Join("testdata", "cavp", v.name+"ShortMsg.rsp"), v.hashType)
+
+			if v.long {
+				checkMessages(t, filepath.Join("testdata", "cavp", v.name+"LongMsg.rsp"), v.hashType)
+			}
+
+			if v.monte {
+				checkMonte(t, filepath.Join("testdata", "cavp", v.name+"Monte.rsp"), v.hashType, sha3)
+			}
+		})
+	}
+
+	checkVariableOut(t, filepath.Join("testdata", "cavp", "SHAKE128VariableOut.rsp"), "shake-128")
+	checkVariableOut(t, filepath.Join("testdata", "cavp", "SHAKE256VariableOut.rsp"), "shake-256")
+}
+
+func TestReferenceVectors(t *testing.T) {
+	checkMessages(t, filepath.Join("testdata", "rfc1319", "MD2.rsp"), "md2")
+	checkMessages(t, filepath.Join("testdata", "keccak", "Keccak224.rsp"), "keccak-224")
+	checkMessages(t, filepath.Join("testdata", "keccak", "Keccak256.rsp"), "keccak-256")
+	checkMessages(t, filepath.Join("testdata", "keccak", "Keccak384.rsp"), "keccak-384")
+	checkMessages(t, filepath.Join("testdata", "keccak", "Keccak512.rsp"), "keccak-512")
+	checkMessages(t, filepath.Join("testdata", "ripemd", "RMD128.rsp"), "ripemd-128")
+	checkMessages(t, filepath.Join("testdata", "ripemd", "RMD160.rsp"), "ripemd-160")
+	checkMessages(t, filepath.Join("testdata", "ripemd", "RMD256.rsp"), "ripemd-256")
+	checkMessages(t, filepath.Join("testdata", "ripemd", "RMD320.rsp"), "ripemd-320")
+	checkMessages(t, filepath.Join("testdata", "nessie", "Whirlpool.rsp"), "whirlpool")
+	checkMessages(t, filepath.Join("testdata", "nessie", "Tiger192.rsp"), "tiger-192")
+	checkMessages(t, filepath.Join("testdata", "nessie", "Tiger160.rsp"), "tiger-160")
+	checkMessages(t, filepath.Join("testdata", "nessie", "Tiger128.rsp"), "tiger-128")
+}
+
+func TestLightweightVectors(t *testing.T) {
+	checkMessages(t, filepath.Join("testdata", "lwc", "AsconHash256.rsp"), "ascon-hash256")
+	checkMessages(t, filepath.Join("testdata", "lwc", "AsconXOF128.rsp"), "ascon-xof128")
+	checkCustomized(t, filepath.Join("testdata", "lwc", "AsconCXOF128.rsp"), "ascon-cxof128")
+	checkMessages(t, filepath.Join("testdata", "lwc", "Xoodyak.rsp"), "xoodyak")
+}
+
+func TestKeccakWidths(t *testing.T) {
+	checkSponge(t, filepath.Join("testdata", "keccak", "KeccakP200.rsp"))
+	checkSponge(t, filepath.Join("testdata", "keccak", "KeccakP400.rsp"))
+	checkSponge(t, filepath.Join("testdata", "keccak", "KeccakP800.rsp"))
+	checkSponge(t, filepath.Join("testdata", "keccak", "KeccakP1600.rsp"))
+}
+
+func TestKMacSamples(t *testing.T) {
+	for _, hashType := range []string{"kmac-128", "kmac-256"} {
+		path := filepath.Join("testdata", "sp800-185", strings.ToUpper(strings.ReplaceAll(hashType, "-", ""))+".rsp")
+
+		for _, record := range parseRsp(t, path) {
+			options := DefaultOptions(hashType).
+				SetKey(record.hex(t, "Key")).
+				SetCustomization([]byte(record["S"]))
+			options.KMac128Size = record.int(t, "L") / 8
+			options.KMac256Size = record.int(t, "L") / 8
+
+			expected := record.hex(t, "Outputval")
+			if output := katSum(options, record.hex(t, "Data")); !bytes.Equal(expected, output) {
+				t.Errorf("'%s' sample %s is wrong:\n\texpected \"%x\"\n\tgot \"%x\"", hashType, record["Sample"], expected, output)
+			}
+		}
+	}
+}
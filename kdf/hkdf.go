@@ -0,0 +1,47 @@
+package kdf
+
+import (
+	"crypto/hmac"
+	"hash"
+)
+
+// HKDF derives a key of the length from the secret with the salt and the info, it is Expand of Extract.
+func HKDF(newHash func() hash.Hash, secret, salt, info []byte, length int) ([]byte, error) {
+	return Expand(newHash, Extract(newHash, secret, salt), info, length)
+}
+
+// Extract returns the pseudorandom key of the secret, which is HMAC of it with the salt as the key.
+// The salt is a string of zeros of the size of the hash if it is empty.
+func Extract(newHash func() hash.Hash, secret, salt []byte) []byte {
+	if len(salt) == 0 {
+		salt = make([]byte, newHash().Size())
+	}
+
+	mac := hmac.New(newHash, salt)
+	_, _ = mac.Write(secret)
+
+	return mac.Sum(nil)
+}
+
+// Expand derives a key of the length from the pseudorandom key and the info, the blocks are HMAC of the previous
+// block, the info and the counter. The length is up to 255 blocks.
+func Expand(newHash func() hash.Hash, prk, info []byte, length int) ([]byte, error) {
+	mac := hmac.New(newHash, prk)
+	if err := checkLength(length, mac.Size(), 255); err != nil {
+		return nil, err
+	}
+
+	out := make([]byte, 0, length+mac.Size())
+
+	var block []byte
+	for i := byte(1); len(out) < length; i++ {
+		mac.Reset()
+		_, _ = mac.Write(block)
+		_, _ = mac.Write(info)
+		_, _ = mac.Write([]byte{i})
+		block = mac.Sum(block[:0])
+		out = append(out, block...)
+	}
+
+	return out[:length], nil
+}
@@ -0,0 +1,65 @@
+// Package kdf implements the key derivation functions HKDF of RFC 5869, the key-based KDFs in the counter, feedback
+// and double-pipeline iteration modes of NIST SP 800-108 and the one-step KDF of NIST SP 800-56C over the hash
+// functions, HMAC and KMAC.
+package kdf
+
+import (
+	"crypto/hmac"
+	"errors"
+	"fmt"
+	"hash"
+
+	"hashed/kmac"
+)
+
+var (
+	// ErrLength is returned when the length of the derived key is not positive or too long for the function.
+	ErrLength = errors.New("kdf: invalid key length")
+	// ErrKeySize is returned when the key of KMAC is shorter than its security strength.
+	ErrKeySize = errors.New("kdf: invalid key size")
+	// ErrMode is returned when the iteration mode is not one of Counter, Feedback and DoublePipeline.
+	ErrMode = errors.New("kdf: invalid mode")
+	// ErrStrength is returned when the security strength of KMAC is not 128 or 256.
+	ErrStrength = errors.New("kdf: invalid security strength")
+	// ErrCounterSize is returned when the size of the counter is not from 1 to 4 bytes.
+	ErrCounterSize = errors.New("kdf: invalid counter size")
+)
+
+// PRF returns a pseudorandom function keyed with the key, as a hash.Hash.
+type PRF func(key []byte) (hash.Hash, error)
+
+// HMAC returns the PRF of HMAC of the hash function.
+func HMAC(newHash func() hash.Hash) PRF {
+	return func(key []byte) (hash.Hash, error) {
+		return hmac.New(newHash, key), nil
+	}
+}
+
+// KMAC128 is the PRF of KMAC128 of 32 bytes without customization, the key is 16 bytes at least.
+func KMAC128(key []byte) (hash.Hash, error) {
+	if len(key) < 16 {
+		return nil, fmt.Errorf("%w: %d", ErrKeySize, len(key))
+	}
+
+	return kmac.New128(key, kmac.Size128, nil), nil
+}
+
+// KMAC256 is the PRF of KMAC256 of 64 bytes without customization, the key is 32 bytes at least.
+func KMAC256(key []byte) (hash.Hash, error) {
+	if len(key) < 32 {
+		return nil, fmt.Errorf("%w: %d", ErrKeySize, len(key))
+	}
+
+	return kmac.New256(key, kmac.Size256, nil), nil
+}
+
+// private
+
+// checkLength returns an error if the length is not positive or needs more than max blocks of the size.
+func checkLength(length, size int, max uint64) error {
+	if length < 1 || uint64((length+size-1)/size) > max {
+		return fmt.Errorf("%w: %d", ErrLength, length)
+	}
+
+	return nil
+}
@@ -0,0 +1,214 @@
+package kdf
+
+import (
+	"bytes"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/hex"
+	"errors"
+	"hash"
+	"testing"
+)
+
+func decodeHex(s string) []byte {
+	b, err := hex.DecodeString(s)
+	if err != nil {
+		panic(err)
+	}
+
+	return b
+}
+
+func TestHKDF(t *testing.T) {
+	cases := []struct {
+		newHash  func() hash.Hash
+		secret   string
+		salt     string
+		info     string
+		prk      string
+		expected string
+	}{
+		// the test cases 1, 3 and 4 of RFC 5869
+		{
+			sha256.New,
+			"0b0b0b0b0b0b0b0b0b0b0b0b0b0b0b0b0b0b0b0b0b0b",
+			"000102030405060708090a0b0c",
+			"f0f1f2f3f4f5f6f7f8f9",
+			"077709362c2e32df0ddc3f0dc47bba6390b6c73bb50f9c3122ec844ad7c2b3e5",
+			"3cb25f25faacd57a90434f64d0362f2a2d2d0a90cf1a5a4c5db02d56ecc4c5bf34007208d5b887185865",
+		},
+		{
+			sha256.New,
+			"0b0b0b0b0b0b0b0b0b0b0b0b0b0b0b0b0b0b0b0b0b0b",
+			"",
+			"",
+			"19ef24a32c717b167f33a91d6f648bdf96596776afdb6377ac434c1c293ccb04",
+			"8da4e775a563c18f715f802a063c5a31b8a11f5c5ee1879ec3454e5f3c738d2d9d201395faa4b61a96c8",
+		},
+		{
+			sha1.New,
+			"0b0b0b0b0b0b0b0b0b0b0b",
+			"000102030405060708090a0b0c",
+			"f0f1f2f3f4f5f6f7f8f9",
+			"9b6c18c432a7bf8f0e71c8eb88f4b30baa2ba243",
+			"085a01ea1b10f36933068b56efa5ad81a4f14b822f5b091568a9cdd4f155fda2c22e422478d305f3f896",
+		},
+	}
+
+	for _, c := range cases {
+		secret, salt, info := decodeHex(c.secret), decodeHex(c.salt), decodeHex(c.info)
+
+		if got := hex.EncodeToString(Extract(c.newHash, secret, salt)); got != c.prk {
+			t.Errorf("PRK of %s is wrong:\n\texpected \"%s\"\n\tgot \"%s\"", c.secret, c.prk, got)
+		}
+
+		key, err := HKDF(c.newHash, secret, salt, info, len(c.expected)/2)
+		if err != nil {
+			t.Fatalf("HKDF of %s failed: %s", c.secret, err)
+		}
+
+		if got := hex.EncodeToString(key); got != c.expected {
+			t.Errorf("OKM of %s is wrong:\n\texpected \"%s\"\n\tgot \"%s\"", c.secret, c.expected, got)
+		}
+	}
+}
+
+func TestKBKDF(t *testing.T) {
+	key := []byte{0, 1, 2}
+	label, context := []byte("label"), []byte("context")
+
+	cases := []struct {
+		name     string
+		params   Params
+		key      []byte
+		expected string
+	}{
+		// the keys of KBKDF of OpenSSL
+		{
+			"counter",
+			Params{Mode: Counter, PRF: HMAC(sha256.New)},
+			key,
+			"5d2ccd947958c824cf32a1a5b42eaf8f63aa3e30cafa3c1e8c3b88410700dc5e81d007588b84596b9c83e3f4ef48f374aae1",
+		},
+		{
+			"feedback",
+			Params{Mode: Feedback, PRF: HMAC(sha256.New), IV: bytes.Repeat([]byte{0xaa}, 32)},
+			key,
+			"7212d4d4fec104d17959af56c9a8ffedebe3e7e0dbaf9dacf2307d50e7931544adc79a8338c686f8b09ca20fe0988c5f30e0",
+		},
+		// the keys computed by the definitions with the MACs of Python and OpenSSL
+		{
+			"double-pipeline",
+			Params{Mode: DoublePipeline, PRF: HMAC(sha256.New)},
+			key,
+			"3f017e0e7ee73802fc710767af8793a3b2f8cb83ac5f63107d60f446b38cb1163db31e137c6f2065551c7324d362d068dbaa",
+		},
+		{
+			"counter of 8 bits",
+			Params{Mode: Counter, PRF: HMAC(sha512.New), CounterSize: 1},
+			key,
+			"c944c0308c8fc4cb71d65eeaa052c6c295fb62026a7342841db26b7d30535672be3163b9c3ac728a8d9f5b50270cebd78b4a1ec6" +
+				"124741b3c00794745a7e89e09901823c7d13670ca6c340ec6397765dbbc216a3892edca77e758b504f46f65164e4b8c2",
+		},
+		{
+			"counter with kmac128",
+			Params{Mode: Counter, PRF: KMAC128},
+			decodeHex("000102030405060708090a0b0c0d0e0f"),
+			"83f66ab6a489a5c26844e08da5a627dc49f22f755e52807bbffde87d969819479fc91c2090bab6854a709d81294e3787",
+		},
+	}
+
+	for _, c := range cases {
+		c.params.Label, c.params.Context = label, context
+
+		got, err := KBKDF(c.key, len(c.expected)/2, &c.params)
+		if err != nil {
+			t.Fatalf("%s failed: %s", c.name, err)
+		}
+
+		if hex.EncodeToString(got) != c.expected {
+			t.Errorf("%s is wrong:\n\texpected \"%s\"\n\tgot \"%x\"", c.name, c.expected, got)
+		}
+	}
+}
+
+func TestOneStep(t *testing.T) {
+	secret, fixedInfo := []byte{0, 1, 2}, []byte("context")
+
+	kmac128, _ := SaltedKMAC(128, []byte("saltsaltsaltsalt"), 40)
+	kmac256, _ := SaltedKMAC(256, nil, 40)
+	kmac128Long, _ := SaltedKMAC(128, nil, 100)
+
+	cases := []struct {
+		name     string
+		h        func() hash.Hash
+		expected string
+	}{
+		// the keys of SSKDF of OpenSSL
+		{"sha2-256", sha256.New, "00651c02cdfda9ef7e943ae43e91ff0884e78dc606a4e31c6d60759329cc9ea8f72f08f41443dd26"},
+		{"hmac", SaltedHMAC(sha256.New, nil), "40d172d2f3e41630437100a2c602df2042a1ccec73de93b7c1b5124013d533bbb1ab2c3fed8ab40c"},
+		{"hmac with salt", SaltedHMAC(sha256.New, []byte("saltsalt")), "685de0ab89cfec2735393dbe4ae6e0f3ccd3dce7fff92911979b521cc58f597e7b0f962a4a4a1457"},
+		{"kmac128", kmac128, "cdb6c8f3fe3d94507330f8349e3b31fd2d608be227245cf1f51e66a854fc6a13c12d174345b99ff7"},
+		{"kmac256", kmac256, "fa94b0bedb12405c27600ed2f099f9187fc4b7170c724c964574d023248342a817a6b47193529e3a"},
+		{
+			"kmac128 of 100 bytes",
+			kmac128Long,
+			"26f0f970b3a29e1a5655869ab12b7bae9ee6d45559e2f3ffed8f8624f05e0f54e97576be093ee539bf4c9380d99d1577da91d076ea" +
+				"c0d69b99c1c9c86e6e58f64e3c39ad5f9999245487807495c802e5d76aad590c1e519627273d56bc60264de616b34b",
+		},
+	}
+
+	for _, c := range cases {
+		got, err := OneStep(c.h, secret, fixedInfo, len(c.expected)/2)
+		if err != nil {
+			t.Fatalf("%s failed: %s", c.name, err)
+		}
+
+		if hex.EncodeToString(got) != c.expected {
+			t.Errorf("%s is wrong:\n\texpected \"%s\"\n\tgot \"%x\"", c.name, c.expected, got)
+		}
+	}
+}
+
+func TestErrors(t *testing.T) {
+	if _, err := HKDF(sha256.New, []byte("secret"), nil, nil, 255*sha256.Size+1); !errors.Is(err, ErrLength) {
+		t.Errorf("HKDF of 256 blocks returned %v, expected %v", err, ErrLength)
+	}
+
+	cases := []struct {
+		name     string
+		params   Params
+		length   int
+		expected error
+	}{
+		{"mode", Params{Mode: 3, PRF: HMAC(sha256.New)}, 32, ErrMode},
+		{"counter size", Params{PRF: HMAC(sha256.New), CounterSize: 5}, 32, ErrCounterSize},
+		{"length", Params{PRF: HMAC(sha256.New)}, 0, ErrLength},
+		{"length of the counter", Params{PRF: HMAC(sha256.New), CounterSize: 1}, 255*sha256.Size + 1, ErrLength},
+		{"kmac key", Params{PRF: KMAC256}, 32, ErrKeySize},
+	}
+
+	for _, c := range cases {
+		if _, err := KBKDF(make([]byte, 16), c.length, &c.params); !errors.Is(err, c.expected) {
+			t.Errorf("invalid %s returned %v, expected %v", c.name, err, c.expected)
+		}
+	}
+
+	if _, err := SaltedKMAC(192, nil, 32); !errors.Is(err, ErrStrength) {
+		t.Errorf("KMAC192 returned %v, expected %v", err, ErrStrength)
+	}
+
+	if _, err := SaltedKMAC(128, []byte("salt"), 32); !errors.Is(err, ErrKeySize) {
+		t.Errorf("KMAC128 with a short salt returned %v, expected %v", err, ErrKeySize)
+	}
+}
+
+func BenchmarkHKDF(b *testing.B) {
+	secret := make([]byte, 32)
+
+	b.SetBytes(8192)
+	for i := 0; i < b.N; i++ {
+		_, _ = HKDF(sha256.New, secret, nil, nil, 8160)
+	}
+}
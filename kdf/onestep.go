@@ -0,0 +1,83 @@
+package kdf
+
+import (
+	"crypto/hmac"
+	"encoding/binary"
+	"fmt"
+	"hash"
+
+	"hashed/kmac"
+)
+
+// OneStep derives a key of the length from the shared secret and the fixed info with the auxiliary function H of
+// the one-step KDF of SP 800-56C, which is a hash function, SaltedHMAC or SaltedKMAC. The blocks are
+// H(counter || secret || fixedInfo) with a 32-bit big-endian counter from 1.
+func OneStep(h func() hash.Hash, secret, fixedInfo []byte, length int) ([]byte, error) {
+	aux := h()
+	if err := checkLength(length, aux.Size(), 1<<32-1); err != nil {
+		return nil, err
+	}
+
+	// the length in bits is a 32-bit number
+	if length >= 1<<29 {
+		return nil, fmt.Errorf("%w: %d", ErrLength, length)
+	}
+
+	var counter [4]byte
+
+	out := make([]byte, 0, length+aux.Size())
+	for i := uint32(1); len(out) < length; i++ {
+		aux.Reset()
+
+		binary.BigEndian.PutUint32(counter[:], i)
+		_, _ = aux.Write(counter[:])
+		_, _ = aux.Write(secret)
+		_, _ = aux.Write(fixedInfo)
+
+		out = aux.Sum(out)
+	}
+
+	return out[:length], nil
+}
+
+// SaltedHMAC returns the auxiliary function of HMAC of the hash function with the salt as the key,
+// the salt is a string of zeros of the block size of the hash if it is empty.
+func SaltedHMAC(newHash func() hash.Hash, salt []byte) func() hash.Hash {
+	if len(salt) == 0 {
+		salt = make([]byte, newHash().BlockSize())
+	}
+
+	return func() hash.Hash {
+		return hmac.New(newHash, salt)
+	}
+}
+
+// SaltedKMAC returns the auxiliary function of KMAC128 or KMAC256 of the length in bytes with the salt as the key and
+// the customization KDF, so the whole key is a single block. The salt is a string of zeros of the block size of KMAC
+// minus 4 bytes if it is empty, else it is 16 bytes at least for KMAC128 and 32 bytes for KMAC256.
+func SaltedKMAC(bits int, salt []byte, length int) (func() hash.Hash, error) {
+	newKmac, blockSize, minKey := kmac.New128, kmac.BlockSize128, 16
+	switch bits {
+	case 128:
+	case 256:
+		newKmac, blockSize, minKey = kmac.New256, kmac.BlockSize256, 32
+	default:
+		return nil, fmt.Errorf("%w: %d", ErrStrength, bits)
+	}
+
+	if len(salt) == 0 {
+		salt = make([]byte, blockSize-4)
+	}
+
+	if len(salt) < minKey {
+		return nil, fmt.Errorf("%w: %d", ErrKeySize, len(salt))
+	}
+
+	if length < 8 {
+		return nil, fmt.Errorf("%w: %d", ErrLength, length)
+	}
+
+	return func() hash.Hash {
+		return newKmac(salt, length, []byte("KDF"))
+	}, nil
+}
@@ -0,0 +1,116 @@
+package kdf
+
+import (
+	"encoding/binary"
+	"fmt"
+)
+
+// Mode is the iteration mode of the key-based KDFs of SP 800-108.
+type Mode int
+
+const (
+	// Counter computes every block from the counter and the fixed input data.
+	Counter Mode = iota
+	// Feedback computes every block from the previous block, the counter and the fixed input data.
+	Feedback
+	// DoublePipeline computes every block from a block of a first pipeline, the counter and the fixed input data,
+	// the blocks of the first pipeline are chained from the fixed input data.
+	DoublePipeline
+)
+
+// String returns the name of the mode.
+func (r Mode) String() string {
+	switch r {
+	case Counter:
+		return "counter"
+	case Feedback:
+		return "feedback"
+	case DoublePipeline:
+		return "double-pipeline"
+	}
+
+	return fmt.Sprintf("mode(%d)", int(r))
+}
+
+// Params represents the parameters of the key-based KDFs of SP 800-108.
+type Params struct {
+	Mode Mode
+	PRF  PRF
+	// Label and Context are the fixed input data, Label || 0x00 || Context || the length of the key in bits.
+	Label   []byte
+	Context []byte
+	// IV is the initial value of the feedback mode, the block before the first one.
+	IV []byte
+	// CounterSize is the size of the big-endian counter in bytes from 1 to 4, 0 for 4.
+	CounterSize int
+}
+
+// KBKDF derives a key of the length from the key with the parameters, the counter precedes the fixed input data
+// in every mode.
+func KBKDF(key []byte, length int, params *Params) ([]byte, error) {
+	if params.Mode < Counter || params.Mode > DoublePipeline {
+		return nil, fmt.Errorf("%w: %d", ErrMode, params.Mode)
+	}
+
+	counterSize := params.CounterSize
+	if counterSize == 0 {
+		counterSize = 4
+	}
+
+	if counterSize < 1 || counterSize > 4 {
+		return nil, fmt.Errorf("%w: %d", ErrCounterSize, counterSize)
+	}
+
+	prf, err := params.PRF(key)
+	if err != nil {
+		return nil, err
+	}
+
+	// the counter does not wrap
+	if err = checkLength(length, prf.Size(), 1<<(8*counterSize)-1); err != nil {
+		return nil, err
+	}
+
+	// the length in bits is a 32-bit number
+	if length >= 1<<29 {
+		return nil, fmt.Errorf("%w: %d", ErrLength, length)
+	}
+
+	fixed := make([]byte, 0, len(params.Label)+len(params.Context)+5)
+	fixed = append(fixed, params.Label...)
+	fixed = append(fixed, 0)
+	fixed = append(fixed, params.Context...)
+	fixed = binary.BigEndian.AppendUint32(fixed, uint32(length*8))
+
+	var counter [4]byte
+
+	out := make([]byte, 0, length+prf.Size())
+	block := params.IV
+	a := fixed
+
+	for i := uint32(1); len(out) < length; i++ {
+		if params.Mode == DoublePipeline {
+			prf.Reset()
+			_, _ = prf.Write(a)
+			a = prf.Sum(nil)
+		}
+
+		prf.Reset()
+
+		switch params.Mode {
+		case Feedback:
+			_, _ = prf.Write(block)
+		case DoublePipeline:
+			_, _ = prf.Write(a)
+		}
+
+		binary.BigEndian.PutUint32(counter[:], i)
+		_, _ = prf.Write(counter[4-counterSize:])
+		_, _ = prf.Write(fixed)
+
+		block = prf.Sum(nil)
+		out = append(out, block...)
+	}
+
+	return out[:length], nil
+}
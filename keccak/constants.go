@@ -22,11 +22,22 @@ const (
 	DomainSHA3  = 0x06
 	DomainSHAKE = 0x1f
 
-	rounds = 24
+	// Rounds200 is the number of rounds of Keccak-f[200].
+	Rounds200 = 18
+	// Rounds400 is the number of rounds of Keccak-f[400].
+	Rounds400 = 20
+	// Rounds800 is the number of rounds of Keccak-f[800].
+	Rounds800 = 22
+	// Rounds1600 is the number of rounds of Keccak-f[1600].
+	Rounds1600 = 24
+
+	rounds = Rounds1600
+	// maxBlockSize is the size of the whole state, which is more than any block size.
+	maxBlockSize = 200
 )
 
 var (
-	roundConstants = []uint64{
+	roundConstants = [rounds]uint64{
 		0x0000000000000001, 0x0000000000008082,
 		0x800000000000808A, 0x8000000080008000,
 		0x000000000000808B, 0x0000000080000001,
@@ -1,7 +1,22 @@
 // Package keccak implements the KECCAK hash algorithm.
+//
+// Besides the hash functions, it exposes the underlying Sponge and Duplex constructions
+// with configurable permutation width, capacity, domain separation and number of rounds,
+// on Keccak-p[200], Keccak-p[400], Keccak-p[800] and Keccak-p[1600].
+//
+// The permutation is a fully unrolled Keccak-f[1600] generated by gen.go,
+// with an assembly version on amd64 which can be disabled by the purego build tag.
 package keccak
 
-import "hash"
+import (
+	"errors"
+	"hash"
+)
+
+// ErrSize is returned when the output size of a sponge hash is not positive.
+var ErrSize = errors.New("keccak: size must be at least 1 byte")
+
+//go:generate go run gen.go
 
 // New224 creates a new KECCAK-224 hash.Hash.
 func New224() hash.Hash { return newKeccak(Size224, BlockSize224, DomainNone) }
@@ -15,12 +30,29 @@ func New384() hash.Hash { return newKeccak(Size384, BlockSize384, DomainNone) }
 // New512 creates a new KECCAK-512 hash.Hash.
 func New512() hash.Hash { return newKeccak(Size512, BlockSize512, DomainNone) }
 
+// NewHash creates a new hash.Hash of size bytes over a sponge with the given parameters,
+// which allows hashing on the smaller Keccak-p permutations with any rate and capacity.
+func NewHash(params Params, size int) (hash.Hash, error) {
+	if err := params.validate(); err != nil {
+		return nil, err
+	}
+
+	if size < 1 {
+		return nil, ErrSize
+	}
+
+	h := new(model)
+	h.size = size
+	h.sponge.params = params
+
+	return h, nil
+}
+
 // newKeccak creates a new KECCAK hash.Hash.
 func newKeccak(size, blockSize int, domain byte) hash.Hash {
 	h := new(model)
 	h.size = size
-	h.blockSize = blockSize
-	h.domain = domain
+	h.sponge.params = Params{Capacity: 8 * (maxBlockSize - blockSize), Domain: domain, Rounds: rounds}
 
 	return h
 }
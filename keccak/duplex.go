@@ -0,0 +1,55 @@
+package keccak
+
+// Duplex represents a duplex construction, where every duplexing call absorbs an input block
+// and returns output which depends on all the previous inputs.
+type Duplex struct {
+	sponge Sponge
+}
+
+// NewDuplex creates a new Duplex with the given parameters.
+func NewDuplex(params Params) (*Duplex, error) {
+	if err := params.validate(); err != nil {
+		return nil, err
+	}
+
+	return &Duplex{sponge: Sponge{params: params}}, nil
+}
+
+// Params returns the parameters of the Duplex.
+func (r *Duplex) Params() Params {
+	return r.sponge.params
+}
+
+// MaxInput returns the maximum length of the input of a duplexing call.
+func (r *Duplex) MaxInput() int {
+	return r.sponge.params.Rate() - 1
+}
+
+// Reset resets the Duplex to its initial state.
+func (r *Duplex) Reset() {
+	r.sponge.Reset()
+}
+
+// Clone returns a copy of the Duplex in its current state.
+func (r *Duplex) Clone() *Duplex {
+	c := *r
+	return &c
+}
+
+// Duplexing absorbs the padded input, permutes the state and fills output from the beginning of the state.
+// Input must be shorter than the rate and output must not be longer than the rate.
+func (r *Duplex) Duplexing(input, output []byte) error {
+	if len(input) > r.MaxInput() {
+		return ErrDuplexInput
+	}
+
+	if len(output) > r.sponge.params.Rate() {
+		return ErrDuplexOutput
+	}
+
+	copy(r.sponge.buf[:], input)
+	r.sponge.absorb(r.sponge.pad(len(input)))
+	r.sponge.extract(output)
+
+	return nil
+}
@@ -0,0 +1,328 @@
+//go:build ignore
+
+// This program generates keccakf.go and keccakf_amd64.s, run it with "go generate".
+//
+// The generated permutations keep the lanes in complementedLanes complemented during the rounds,
+// so that chi can be computed with at most one NOT per lane. The chi formula of every lane is found
+// by searching the equivalent forms of b0 ^ (^b1 & b2) for the complement pattern of its inputs and output.
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"os"
+)
+
+// complementedLanes are the lanes complemented during the rounds.
+var complementedLanes = map[int]bool{1: true, 2: true, 8: true, 12: true, 17: true, 20: true}
+
+// rotations are the rho offsets indexed by [x][y].
+var rotations = [5][5]int{
+	{0, 36, 3, 41, 18},
+	{1, 44, 10, 45, 2},
+	{62, 6, 43, 15, 61},
+	{28, 55, 25, 21, 56},
+	{27, 20, 39, 8, 14},
+}
+
+var roundConstants = [24]uint64{
+	0x0000000000000001, 0x0000000000008082,
+	0x800000000000808A, 0x8000000080008000,
+	0x000000000000808B, 0x0000000080000001,
+	0x8000000080008081, 0x8000000000008009,
+	0x000000000000008A, 0x0000000000000088,
+	0x0000000080008009, 0x000000008000000A,
+	0x000000008000808B, 0x800000000000008B,
+	0x8000000000008089, 0x8000000000008003,
+	0x8000000000008002, 0x8000000000000080,
+	0x000000000000800A, 0x800000008000000A,
+	0x8000000080008081, 0x8000000000008080,
+	0x0000000080000001, 0x8000000080008008,
+}
+
+// chi represents the formula of a chi output: [^]p ^ ([^]q op [^]r).
+type chi struct {
+	notP, notQ, notR bool
+	or               bool
+}
+
+func lane(x, y int) int { return (x+5)%5 + 5*((y+5)%5) }
+
+func bit(b bool) int {
+	if b {
+		return 1
+	}
+
+	return 0
+}
+
+// source returns the coordinates of the lane moved to x, y by rho and pi.
+func source(x, y int) (int, int) {
+	return ((y - 3*x + 15) * 3) % 5, x
+}
+
+// complementedD reports whether D of column x is complemented, it depends on the parity of the columns.
+func complementedD(x int) bool {
+	column := func(x int) bool {
+		c := false
+		for y := 0; y < 5; y++ {
+			c = c != complementedLanes[lane(x, y)]
+		}
+
+		return c
+	}
+
+	return column((x+4)%5) != column((x+1)%5)
+}
+
+// complementedB reports whether B at x, y is complemented.
+func complementedB(x, y int) bool {
+	sx, sy := source(x, y)
+	return complementedLanes[lane(sx, sy)] != complementedD(sx)
+}
+
+// findChi returns the chi formula with the least NOTs for the output lane at x, y.
+func findChi(x, y int) chi {
+	c := [3]int{bit(complementedB(x, y)), bit(complementedB(x+1, y)), bit(complementedB(x+2, y))}
+	out := bit(complementedLanes[lane(x, y)])
+
+	best, bestCost := chi{}, 4
+	for i := 0; i < 16; i++ {
+		f := chi{notP: i&1 != 0, notQ: i&2 != 0, notR: i&4 != 0, or: i&8 != 0}
+		cost := bit(f.notP) + bit(f.notQ) + bit(f.notR)
+
+		valid := true
+		for v := 0; v < 8; v++ {
+			b := [3]int{v & 1, v >> 1 & 1, v >> 2 & 1}
+			p, q, r := b[0]^c[0]^bit(f.notP), b[1]^c[1]^bit(f.notQ), b[2]^c[2]^bit(f.notR)
+
+			g := q & r
+			if f.or {
+				g = q | r
+			}
+
+			if p^g != b[0]^((1-b[1])&b[2])^out {
+				valid = false
+				break
+			}
+		}
+
+		if valid && cost < bestCost {
+			best, bestCost = f, cost
+		}
+	}
+
+	if bestCost > 1 {
+		panic(fmt.Sprintf("no chi formula with a single NOT for lane %d", lane(x, y)))
+	}
+
+	return best
+}
+
+func not(b bool) string {
+	if b {
+		return "^"
+	}
+
+	return ""
+}
+
+func main() {
+	write("keccakf.go", generateGo(), true)
+	write("keccakf_amd64.s", generateAsm(), false)
+}
+
+func write(name string, b []byte, gofmt bool) {
+	if gofmt {
+		var err error
+		if b, err = format.Source(b); err != nil {
+			panic(err)
+		}
+	}
+
+	if err := os.WriteFile(name, b, 0644); err != nil {
+		panic(err)
+	}
+}
+
+func generateGo() []byte {
+	var b bytes.Buffer
+	w := func(format string, a ...any) { fmt.Fprintf(&b, format+"\n", a...) }
+
+	w("// Code generated by gen.go. DO NOT EDIT.")
+	w("")
+	w("package keccak")
+	w("")
+	w(`import "math/bits"`)
+	w("")
+	w("// keccakF1600Generic applies the Keccak-f[1600] permutation to the state in pure Go.")
+	w("// All 24 rounds are unrolled and the lanes 1, 2, 8, 12, 17 and 20 are kept complemented during the rounds.")
+	w("func keccakF1600Generic(a *[25]uint64) {")
+	w("var (")
+	w("b0, b1, b2, b3, b4 uint64")
+	w("c0, c1, c2, c3, c4 uint64")
+	w("d0, d1, d2, d3, d4 uint64")
+	for i := 0; i < 25; i += 5 {
+		w("e%d, e%d, e%d, e%d, e%d uint64", i, i+1, i+2, i+3, i+4)
+	}
+	w(")")
+	w("")
+	for i := 0; i < 25; i += 5 {
+		w("a%d, a%d, a%d, a%d, a%d := %sa[%d], %sa[%d], %sa[%d], %sa[%d], %sa[%d]", i, i+1, i+2, i+3, i+4,
+			not(complementedLanes[i]), i, not(complementedLanes[i+1]), i+1, not(complementedLanes[i+2]), i+2,
+			not(complementedLanes[i+3]), i+3, not(complementedLanes[i+4]), i+4)
+	}
+
+	for round := 0; round < 24; round++ {
+		src, dst := "a", "e"
+		if round%2 == 1 {
+			src, dst = "e", "a"
+		}
+
+		w("")
+		w("// round %d", round+1)
+		for x := 0; x < 5; x++ {
+			w("c%d = %s%d ^ %s%d ^ %s%d ^ %s%d ^ %s%d", x, src, lane(x, 0), src, lane(x, 1), src, lane(x, 2), src, lane(x, 3), src, lane(x, 4))
+		}
+		for x := 0; x < 5; x++ {
+			w("d%d = c%d ^ bits.RotateLeft64(c%d, 1)", x, (x+4)%5, (x+1)%5)
+		}
+		for y := 0; y < 5; y++ {
+			for x := 0; x < 5; x++ {
+				sx, sy := source(x, y)
+				if r := rotations[sx][sy]; r != 0 {
+					w("b%d = bits.RotateLeft64(%s%d^d%d, %d)", x, src, lane(sx, sy), sx, r)
+				} else {
+					w("b%d = %s%d ^ d%d", x, src, lane(sx, sy), sx)
+				}
+			}
+			for x := 0; x < 5; x++ {
+				f := findChi(x, y)
+				op := "&"
+				if f.or {
+					op = "|"
+				}
+
+				iota := ""
+				if x == 0 && y == 0 {
+					iota = fmt.Sprintf(" ^ roundConstants[%d]", round)
+				}
+
+				w("%s%d = %sb%d ^ (%sb%d %s %sb%d)%s", dst, lane(x, y), not(f.notP), x, not(f.notQ), (x+1)%5, op, not(f.notR), (x+2)%5, iota)
+			}
+		}
+	}
+
+	w("")
+	for i := 0; i < 25; i += 5 {
+		w("a[%d], a[%d], a[%d], a[%d], a[%d] = %sa%d, %sa%d, %sa%d, %sa%d, %sa%d", i, i+1, i+2, i+3, i+4,
+			not(complementedLanes[i]), i, not(complementedLanes[i+1]), i+1, not(complementedLanes[i+2]), i+2,
+			not(complementedLanes[i+3]), i+3, not(complementedLanes[i+4]), i+4)
+	}
+	w("}")
+
+	return b.Bytes()
+}
+
+func generateAsm() []byte {
+	var b bytes.Buffer
+	w := func(format string, a ...any) { fmt.Fprintf(&b, format+"\n", a...) }
+
+	c := [5]string{"R8", "R9", "R10", "R11", "R12"}
+	d := [5]string{"AX", "BX", "CX", "DX", "SI"}
+	r := c // B reuses the registers of C after D is computed
+
+	w("// Code generated by gen.go. DO NOT EDIT.")
+	w("")
+	w("//go:build amd64 && !purego")
+	w("")
+	w(`#include "textflag.h"`)
+	w("")
+	w("// The rounds alternate between the state and a temporary state on the stack,")
+	w("// so the result of the last round ends up in the state.")
+	w("// The lanes 1, 2, 8, 12, 17 and 20 are kept complemented during the rounds.")
+	w("//")
+	w("// DI: state, R8-R12: C and B, AX, BX, CX, DX, SI: D, R13 and R14: temporary")
+	w("")
+	w("// func keccakF1600(a *[25]uint64)")
+	w("TEXT ·keccakF1600(SB), NOSPLIT, $200-8")
+	w("\tMOVQ a+0(FP), DI")
+
+	complement := func() {
+		w("")
+		for i := 0; i < 25; i++ {
+			if complementedLanes[i] {
+				w("\tNOTQ %d(DI)", i*8)
+			}
+		}
+	}
+
+	complement()
+	for round := 0; round < 24; round++ {
+		src, dst := "DI", "SP"
+		if round%2 == 1 {
+			src, dst = "SP", "DI"
+		}
+
+		w("")
+		w("\t// round %d", round+1)
+		for x := 0; x < 5; x++ {
+			w("\tMOVQ %d(%s), %s", lane(x, 0)*8, src, c[x])
+			for y := 1; y < 5; y++ {
+				w("\tXORQ %d(%s), %s", lane(x, y)*8, src, c[x])
+			}
+		}
+		for x := 0; x < 5; x++ {
+			w("\tMOVQ %s, %s", c[(x+1)%5], d[x])
+			w("\tROLQ $1, %s", d[x])
+			w("\tXORQ %s, %s", c[(x+4)%5], d[x])
+		}
+		for y := 0; y < 5; y++ {
+			for x := 0; x < 5; x++ {
+				sx, sy := source(x, y)
+				w("\tMOVQ %d(%s), %s", lane(sx, sy)*8, src, r[x])
+				w("\tXORQ %s, %s", d[sx], r[x])
+				if rot := rotations[sx][sy]; rot != 0 {
+					w("\tROLQ $%d, %s", rot, r[x])
+				}
+			}
+			for x := 0; x < 5; x++ {
+				f := findChi(x, y)
+				op := "ANDQ"
+				if f.or {
+					op = "ORQ"
+				}
+
+				if f.notR {
+					w("\tMOVQ %s, R13", r[(x+2)%5])
+					w("\tNOTQ R13")
+					w("\t%s %s, R13", op, r[(x+1)%5])
+				} else {
+					w("\tMOVQ %s, R13", r[(x+1)%5])
+					if f.notQ {
+						w("\tNOTQ R13")
+					}
+					w("\t%s %s, R13", op, r[(x+2)%5])
+				}
+
+				w("\tXORQ %s, R13", r[x])
+				if f.notP {
+					w("\tNOTQ R13")
+				}
+
+				if x == 0 && y == 0 {
+					w("\tMOVQ $0x%016x, R14", roundConstants[round])
+					w("\tXORQ R14, R13")
+				}
+
+				w("\tMOVQ R13, %d(%s)", lane(x, y)*8, dst)
+			}
+		}
+	}
+
+	complement()
+	w("\tRET")
+
+	return b.Bytes()
+}
@@ -0,0 +1,9 @@
+//go:build amd64 && !purego
+
+package keccak
+
+// keccakF1600 applies the Keccak-f[1600] permutation to the state.
+// It is implemented in keccakf_amd64.s.
+//
+//go:noescape
+func keccakF1600(a *[25]uint64)
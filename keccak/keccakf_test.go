@@ -0,0 +1,96 @@
+package keccak
+
+import (
+	"encoding/hex"
+	"math/rand"
+	"testing"
+)
+
+func TestKeccakF1600(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+
+	for i := 0; i < 1000; i++ {
+		var expected [25]uint64
+		for j := range expected {
+			expected[j] = rnd.Uint64()
+		}
+
+		output := expected
+		keccakF1600Generic(&expected)
+		keccakF1600(&output)
+
+		if expected != output {
+			t.Fatalf("permutation %d is wrong:\n\texpected %x\n\tgot %x", i, expected, output)
+		}
+	}
+}
+
+func BenchmarkKeccakF1600(b *testing.B) {
+	var a [25]uint64
+
+	b.SetBytes(int64(len(a) * 8))
+	for i := 0; i < b.N; i++ {
+		keccakF1600(&a)
+	}
+}
+
+func BenchmarkKeccakF1600Generic(b *testing.B) {
+	var a [25]uint64
+
+	b.SetBytes(int64(len(a) * 8))
+	for i := 0; i < b.N; i++ {
+		keccakF1600Generic(&a)
+	}
+}
+
+func TestKeccakP(t *testing.T) {
+	// Keccak-f applied to the all-zero state, as in the intermediate values of the Keccak team
+	vectors := []struct {
+		width    int
+		expected string
+	}{
+		{200, "3c2826841cb35c171eaae9b811134ceaa3852c69d2c5abafea"},
+		{400, "f509ac40a90ff5149fe8a0ecd15b7078f0ef8fbf3703526075dcc90e76e74652a159815d956d146e3e63ee58ff714c718eb3"},
+		{800, "5dd431e5fbc604f499bfa0232f45f8f142d0ff5178f539e5a7800bf0643697af4cf35abf24247a22152717888458689f" +
+			"54d05cb10efcf41b91fa66619a599e1a1f0a97a3879665ab688dabaf15104be7981a0034f3ef1941760e0a937080b28796e9ef11"},
+		{1600, "e7dde140798f25f18a47c033f9ccd584eea95aa61e2698d54d49806f304715bd57d05362054e288bd46f8e7f2da497ff" +
+			"c44746a4a0e5fe90762e19d60cda5b8c9c05191bf7a630ad64fc8fd0b75a933035d617233fa95aeb0321710d26e6a6a95f" +
+			"55cfdb167ca58126c84703cd31b8439f56a5111a2ff20161aed9215a63e505f270c98cf2febe641166c47b95703661cb0e" +
+			"d04f555a7cb8c832cf1c8ae83e8c14263aae22790c94e409c5a224f94118c26504e72635f5163ba1307fe944f67549a2ec" +
+			"5c7bfff1ea"},
+	}
+
+	for _, v := range vectors {
+		params := Params{Width: v.width, Capacity: 8, Domain: DomainNone}
+		params.Rounds = params.maxRounds()
+
+		sponge := Sponge{params: params}
+		sponge.permute()
+
+		output := make([]byte, v.width/8)
+		sponge.extract(output)
+
+		if got := hex.EncodeToString(output); got != v.expected {
+			t.Errorf("keccak-f[%d] is wrong:\n\texpected \"%s\"\n\tgot \"%s\"", v.width, v.expected, got)
+		}
+	}
+}
+
+func TestKeccakPGeneric(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+
+	for i := 0; i < 100; i++ {
+		var expected [25]uint64
+		for j := range expected {
+			expected[j] = rnd.Uint64()
+		}
+
+		output := expected
+		keccakF1600Generic(&expected)
+		keccakP(&output, rounds)
+
+		if expected != output {
+			t.Fatalf("permutation %d is wrong:\n\texpected %x\n\tgot %x", i, expected, output)
+		}
+	}
+}
@@ -0,0 +1,85 @@
+package keccak
+
+import "math/bits"
+
+// rotations are the rho offsets of the lanes, indexed by x + 5*y.
+// They are taken modulo the lane width for the smaller permutations.
+var rotations = [25]int{
+	0, 1, 62, 28, 27,
+	36, 44, 6, 55, 20,
+	3, 10, 43, 25, 39,
+	41, 45, 15, 21, 8,
+	18, 2, 61, 56, 14,
+}
+
+// lane represents the lane of a Keccak-p permutation, 8 bits for Keccak-p[200] up to 64 bits for Keccak-p[1600].
+type lane interface {
+	~uint8 | ~uint16 | ~uint32 | ~uint64
+}
+
+// keccakP1600 applies the Keccak-p[1600, n] permutation to the state, which is the last n rounds of Keccak-f[1600].
+func keccakP1600(a *[25]uint64, n int) {
+	if n == rounds {
+		keccakF1600(a)
+		return
+	}
+
+	keccakP(a, n)
+}
+
+// keccakPLanes applies the Keccak-p[25*w, n] permutation to the state which holds lanes of w bits in uint64 words.
+func keccakPLanes[T lane](a *[25]uint64, n int) {
+	var b [25]T
+	for i := range a {
+		b[i] = T(a[i])
+	}
+
+	keccakP(&b, n)
+
+	for i := range a {
+		a[i] = uint64(b[i])
+	}
+}
+
+// keccakP applies the Keccak-p[25*w, n] permutation to the state, which is the last n rounds of Keccak-f[25*w].
+// The round constants are truncated and the rotations are reduced to the lane width w.
+func keccakP[T lane](a *[25]T, n int) {
+	w := bits.OnesCount64(uint64(^T(0)))
+	last := 12 + 2*bits.TrailingZeros(uint(w))
+
+	var b [25]T
+	var c, d [5]T
+
+	for round := last - n; round < last; round++ {
+		// theta
+		for x := 0; x < 5; x++ {
+			c[x] = a[x] ^ a[x+5] ^ a[x+10] ^ a[x+15] ^ a[x+20]
+		}
+
+		for x := 0; x < 5; x++ {
+			d[x] = c[(x+4)%5] ^ rotateLeft(c[(x+1)%5], 1, w)
+		}
+
+		// rho pi
+		for x := 0; x < 5; x++ {
+			for y := 0; y < 5; y++ {
+				b[y+5*((2*x+3*y)%5)] = rotateLeft(a[x+5*y]^d[x], rotations[x+5*y]%w, w)
+			}
+		}
+
+		// chi
+		for y := 0; y < 25; y += 5 {
+			for x := 0; x < 5; x++ {
+				a[y+x] = b[y+x] ^ (^b[y+(x+1)%5] & b[y+(x+2)%5])
+			}
+		}
+
+		// iota
+		a[0] ^= T(roundConstants[round])
+	}
+}
+
+// rotateLeft rotates the lane x of w bits left by k bits, where 0 <= k < w.
+func rotateLeft[T lane](x T, k, w int) T {
+	return x<<k | x>>(w-k)
+}
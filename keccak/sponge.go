@@ -0,0 +1,261 @@
+package keccak
+
+import (
+	"encoding/binary"
+	"errors"
+)
+
+var (
+	// ErrWidth is returned when the width is not one of the supported permutation widths.
+	ErrWidth = errors.New("keccak: width must be 200, 400, 800 or 1600 bits")
+	// ErrCapacity is returned when the capacity leaves no room for the rate in the state.
+	ErrCapacity = errors.New("keccak: capacity must be a positive multiple of 8 bits and leave at least 16 bits of rate")
+	// ErrRounds is returned when the number of rounds is out of range.
+	ErrRounds = errors.New("keccak: rounds must be between 1 and the rounds of Keccak-f for the width")
+	// ErrDomain is returned when the domain separation byte has no padding bit.
+	ErrDomain = errors.New("keccak: domain must not be zero")
+	// ErrWriteAfterRead is returned when a sponge is written to after it started squeezing.
+	ErrWriteAfterRead = errors.New("keccak: write after read")
+	// ErrDuplexInput is returned when a duplexing call has more input than the rate allows.
+	ErrDuplexInput = errors.New("keccak: duplex input must be shorter than the rate")
+	// ErrDuplexOutput is returned when a duplexing call asks for more output than the rate.
+	ErrDuplexOutput = errors.New("keccak: duplex output must not be longer than the rate")
+)
+
+// Params represents the parameters of a sponge or duplex construction on Keccak-p.
+type Params struct {
+	// Width is the width of the permutation in bits, 200, 400, 800 or 1600.
+	// Zero means 1600.
+	Width int
+	// Capacity is the capacity in bits, a multiple of 8, the rate is the rest of the Width bits state.
+	Capacity int
+	// Domain is the domain separation byte with the first bit of the padding,
+	// like DomainNone for KECCAK, DomainSHA3 for SHA-3 and DomainSHAKE for SHAKE.
+	Domain byte
+	// Rounds is the number of rounds of the permutation, up to the rounds of Keccak-f for the width,
+	// like Rounds1600 for Keccak-f[1600].
+	Rounds int
+}
+
+// Rate returns the rate in bytes.
+func (r Params) Rate() int {
+	return (r.width() - r.Capacity) / 8
+}
+
+// width returns the width of the permutation in bits.
+func (r Params) width() int {
+	if r.Width == 0 {
+		return 1600
+	}
+
+	return r.Width
+}
+
+// laneSize returns the size of a lane in bytes.
+func (r Params) laneSize() int {
+	return r.width() / 200
+}
+
+// maxRounds returns the number of rounds of Keccak-f for the width.
+func (r Params) maxRounds() int {
+	switch r.width() {
+	case 200:
+		return Rounds200
+	case 400:
+		return Rounds400
+	case 800:
+		return Rounds800
+	case 1600:
+		return Rounds1600
+	}
+
+	return 0
+}
+
+// validate returns an error if the parameters are out of range.
+// The rate must hold the domain byte and the last padding bit.
+func (r Params) validate() error {
+	if r.maxRounds() == 0 {
+		return ErrWidth
+	}
+
+	if r.Capacity < 8 || r.Capacity%8 != 0 || r.Rate() < 2 {
+		return ErrCapacity
+	}
+
+	if r.Rounds < 1 || r.Rounds > r.maxRounds() {
+		return ErrRounds
+	}
+
+	if r.Domain == 0 {
+		return ErrDomain
+	}
+
+	return nil
+}
+
+// Sponge represents a sponge construction which absorbs any amount of data and squeezes any amount of output.
+// It implements io.Writer for absorbing and io.Reader for squeezing.
+type Sponge struct {
+	params    Params
+	state     [25]uint64
+	buf       [maxBlockSize]byte
+	bufLen    int
+	squeezing bool
+}
+
+// NewSponge creates a new Sponge with the given parameters.
+func NewSponge(params Params) (*Sponge, error) {
+	if err := params.validate(); err != nil {
+		return nil, err
+	}
+
+	return &Sponge{params: params}, nil
+}
+
+// Params returns the parameters of the Sponge.
+func (r *Sponge) Params() Params {
+	return r.params
+}
+
+// Reset resets the Sponge to its initial state.
+func (r *Sponge) Reset() {
+	r.state = [25]uint64{}
+	r.bufLen = 0
+	r.squeezing = false
+}
+
+// Clone returns a copy of the Sponge in its current state.
+func (r *Sponge) Clone() *Sponge {
+	c := *r
+	return &c
+}
+
+// Write absorbs the data into the sponge.
+// It returns ErrWriteAfterRead if the sponge has already been read from.
+func (r *Sponge) Write(p []byte) (int, error) {
+	if r.squeezing {
+		return 0, ErrWriteAfterRead
+	}
+
+	n := len(p)
+	rate := r.params.Rate()
+
+	if r.bufLen > 0 {
+		x := copy(r.buf[r.bufLen:rate], p)
+		r.bufLen += x
+		p = p[x:]
+
+		if r.bufLen < rate {
+			return n, nil
+		}
+
+		r.absorb(r.buf[:rate])
+		r.bufLen = 0
+	}
+
+	for len(p) >= rate {
+		r.absorb(p[:rate])
+		p = p[rate:]
+	}
+
+	r.bufLen = copy(r.buf[:], p)
+
+	return n, nil
+}
+
+// Read squeezes output from the sponge, the first call pads and completes the absorbing phase.
+// It always fills p and never returns an error.
+func (r *Sponge) Read(p []byte) (int, error) {
+	rate := r.params.Rate()
+
+	if !r.squeezing {
+		r.finalize()
+		r.squeezing = true
+		r.bufLen = rate
+	}
+
+	n := len(p)
+	for len(p) > 0 {
+		if r.bufLen == rate {
+			r.extract(r.buf[:rate])
+			r.bufLen = 0
+		}
+
+		x := copy(p, r.buf[r.bufLen:rate])
+		r.bufLen += x
+		p = p[x:]
+
+		if r.bufLen == rate {
+			r.permute()
+		}
+	}
+
+	return n, nil
+}
+
+// private
+
+// permute applies the permutation to the state.
+func (r *Sponge) permute() {
+	switch r.params.width() {
+	case 200:
+		keccakPLanes[uint8](&r.state, r.params.Rounds)
+	case 400:
+		keccakPLanes[uint16](&r.state, r.params.Rounds)
+	case 800:
+		keccakPLanes[uint32](&r.state, r.params.Rounds)
+	default:
+		keccakP1600(&r.state, r.params.Rounds)
+	}
+}
+
+// absorb adds one block of rate bytes to the state and permutes it.
+func (r *Sponge) absorb(block []byte) {
+	r.xorIn(block)
+	r.permute()
+}
+
+// xorIn adds the data to the beginning of the state, whose lanes are little-endian.
+func (r *Sponge) xorIn(data []byte) {
+	size := r.params.laneSize()
+
+	n := 0
+	if size == 8 {
+		n = len(data) / 8
+		for i := 0; i < n; i++ {
+			r.state[i] ^= binary.LittleEndian.Uint64(data[i*8:])
+		}
+	}
+
+	for i := n * 8; i < len(data); i++ {
+		r.state[i/size] ^= uint64(data[i]) << (8 * (i % size))
+	}
+}
+
+// extract copies the beginning of the state to data.
+func (r *Sponge) extract(data []byte) {
+	size := r.params.laneSize()
+
+	for i := range data {
+		data[i] = byte(r.state[i/size] >> (8 * (i % size)))
+	}
+}
+
+// pad fills the rest of the block after n bytes of data in buf with the domain byte and the padding.
+func (r *Sponge) pad(n int) []byte {
+	rate := r.params.Rate()
+	block := r.buf[:rate]
+
+	clear(block[n:])
+	block[n] = r.params.Domain
+	block[rate-1] |= 0x80
+
+	return block
+}
+
+// finalize pads the buffered data and absorbs it.
+func (r *Sponge) finalize() {
+	r.absorb(r.pad(r.bufLen))
+	r.bufLen = 0
+}
@@ -0,0 +1,146 @@
+package keccak
+
+import (
+	"bytes"
+	"encoding/hex"
+	"testing"
+
+	"golang.org/x/crypto/sha3"
+)
+
+func TestSponge(t *testing.T) {
+	message := bytes.Repeat([]byte("sponge"), 100)
+
+	sponge, err := NewSponge(Params{Capacity: 512, Domain: DomainSHA3, Rounds: 24})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for i := 0; i < len(message); i += 7 {
+		sponge.Write(message[i:min(i+7, len(message))])
+	}
+
+	output := make([]byte, 32)
+	sponge.Read(output)
+
+	if expected := sha3.Sum256(message); !bytes.Equal(output, expected[:]) {
+		t.Errorf("sha3-256 sponge is wrong:\n\texpected \"%x\"\n\tgot \"%x\"", expected, output)
+	}
+
+	if _, err = sponge.Write(message); err != ErrWriteAfterRead {
+		t.Errorf("write after read error is wrong:\n\texpected \"%v\"\n\tgot \"%v\"", ErrWriteAfterRead, err)
+	}
+}
+
+func TestSpongeChunkedReads(t *testing.T) {
+	message := []byte("The quick brown fox jumps over the lazy dog")
+
+	expected := make([]byte, 1000)
+	sha3.ShakeSum128(expected, message)
+
+	sponge, _ := NewSponge(Params{Capacity: 256, Domain: DomainSHAKE, Rounds: 24})
+	sponge.Write(message)
+
+	output := make([]byte, 0, len(expected))
+	for i := 1; len(output) < len(expected); i++ {
+		chunk := make([]byte, min(i, len(expected)-len(output)))
+		sponge.Read(chunk)
+		output = append(output, chunk...)
+	}
+
+	if !bytes.Equal(output, expected) {
+		t.Errorf("shake128 chunked reads are wrong:\n\texpected \"%x\"\n\tgot \"%x\"", expected, output)
+	}
+}
+
+func TestTurboShake128(t *testing.T) {
+	vectors := []struct {
+		message  []byte
+		domain   byte
+		expected string
+	}{
+		{nil, 0x07, "5a223ad30b3b8c66a243048cfced430f54e7529287d15150b973133adfac6a2f" +
+			"fe2708e73061e09a4000168ba9c8ca1813198f7bbed4984b4185f2c2580ee623"},
+		{[]byte{0xff}, 0x06, "8ec9c66465ed0d4a6c35d13506718d687a25cb05c74cca1e42501abd83874a67"},
+	}
+
+	for _, v := range vectors {
+		sponge, err := NewSponge(Params{Capacity: 256, Domain: v.domain, Rounds: 12})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		sponge.Write(v.message)
+
+		output := make([]byte, len(v.expected)/2)
+		sponge.Read(output)
+
+		if got := hex.EncodeToString(output); got != v.expected {
+			t.Errorf("turboshake128 of '%x' is wrong:\n\texpected \"%s\"\n\tgot \"%s\"", v.message, v.expected, got)
+		}
+	}
+}
+
+func TestParams(t *testing.T) {
+	vectors := []struct {
+		params Params
+		err    error
+	}{
+		{Params{Capacity: 0, Domain: DomainNone, Rounds: 24}, ErrCapacity},
+		{Params{Capacity: 1592, Domain: DomainNone, Rounds: 24}, ErrCapacity},
+		{Params{Capacity: 511, Domain: DomainNone, Rounds: 24}, ErrCapacity},
+		{Params{Capacity: 512, Domain: DomainNone, Rounds: 0}, ErrRounds},
+		{Params{Capacity: 512, Domain: DomainNone, Rounds: 25}, ErrRounds},
+		{Params{Capacity: 512, Domain: 0, Rounds: 24}, ErrDomain},
+		{Params{Capacity: 1584, Domain: DomainNone, Rounds: 1}, nil},
+		{Params{Width: 300, Capacity: 64, Domain: DomainNone, Rounds: 12}, ErrWidth},
+		{Params{Width: 200, Capacity: 192, Domain: DomainNone, Rounds: 18}, ErrCapacity},
+		{Params{Width: 200, Capacity: 64, Domain: DomainNone, Rounds: 19}, ErrRounds},
+		{Params{Width: 800, Capacity: 256, Domain: DomainNone, Rounds: 22}, nil},
+	}
+
+	for _, v := range vectors {
+		if _, err := NewSponge(v.params); err != v.err {
+			t.Errorf("error of %+v is wrong:\n\texpected \"%v\"\n\tgot \"%v\"", v.params, v.err, err)
+		}
+	}
+}
+
+func TestDuplex(t *testing.T) {
+	params := Params{Capacity: 256, Domain: DomainSHAKE, Rounds: 24}
+	duplex, _ := NewDuplex(params)
+
+	inputs := [][]byte{nil, []byte("duplex"), bytes.Repeat([]byte{0xaa}, duplex.MaxInput())}
+	for i, input := range inputs {
+		output := make([]byte, 16)
+		if err := duplex.Duplexing(input, output); err != nil {
+			t.Fatal(err)
+		}
+
+		// the output of a duplexing call equals a sponge over all the padded inputs so far
+		sponge, _ := NewSponge(params)
+		for _, previous := range inputs[:i] {
+			block := make([]byte, params.Rate())
+			copy(block, previous)
+			block[len(previous)] = params.Domain
+			block[len(block)-1] |= 0x80
+			sponge.Write(block)
+		}
+
+		sponge.Write(input)
+		expected := make([]byte, 16)
+		sponge.Read(expected)
+
+		if !bytes.Equal(output, expected) {
+			t.Errorf("duplexing call %d is wrong:\n\texpected \"%x\"\n\tgot \"%x\"", i, expected, output)
+		}
+	}
+
+	if err := duplex.Duplexing(make([]byte, params.Rate()), nil); err != ErrDuplexInput {
+		t.Errorf("long input error is wrong:\n\texpected \"%v\"\n\tgot \"%v\"", ErrDuplexInput, err)
+	}
+
+	if err := duplex.Duplexing(nil, make([]byte, params.Rate()+1)); err != ErrDuplexOutput {
+		t.Errorf("long output error is wrong:\n\texpected \"%v\"\n\tgot \"%v\"", ErrDuplexOutput, err)
+	}
+}
@@ -0,0 +1,99 @@
+package hashed
+
+import (
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// MinTagSize is the minimum size in bytes of the truncated tags, the 32 bits of the lower limit of SP 800-107.
+const MinTagSize = 4
+
+var (
+	// ErrMacMismatch is returned when the tag does not match the message.
+	ErrMacMismatch = errors.New("hashed: tag does not match")
+	// ErrMacType is returned when the hash type cannot be used as the MAC.
+	ErrMacType = errors.New("hashed: invalid mac type")
+	// ErrTagSize is returned when the tag size is less than MinTagSize or greater than the size of the MAC.
+	ErrTagSize = errors.New("hashed: invalid tag size")
+)
+
+// Mac represents a message authentication code, HMAC of a hash type or a keyed hash type like KMAC and the BLAKE2
+// MAC types, whose tags may be truncated.
+type Mac struct {
+	hash    *Hash
+	tagSize int
+}
+
+// NewMac creates a new Mac of the keyed hash type of the options, with the key of the options.
+func NewMac(options *Options) (*Mac, error) {
+	if !keyedHashTypes[strings.ToLower(options.HashType)] {
+		return nil, fmt.Errorf("%w: %s is not keyed", ErrMacType, options.HashType)
+	}
+
+	h := New(options)
+
+	return &Mac{hash: h, tagSize: h.Size()}, nil
+}
+
+// NewHMac creates a new Mac of HMAC of the hash type of the options with the key.
+// The hash type must be a cryptographic hash function of a fixed output size.
+func NewHMac(options *Options, key []byte) (*Mac, error) {
+	if !hMacHashTypes[strings.ToLower(options.HashType)] {
+		return nil, fmt.Errorf("%w: %s cannot be used with hmac", ErrMacType, options.HashType)
+	}
+
+	h := New(options)
+	h.HMac(key)
+
+	return &Mac{hash: h, tagSize: h.Size()}, nil
+}
+
+// Truncate sets the size of the tags in bytes to the leftmost bytes of the MAC, 0 for the full size.
+func (r *Mac) Truncate(size int) error {
+	if size == 0 {
+		size = r.hash.Size()
+	}
+
+	if size < MinTagSize || size > r.hash.Size() {
+		return fmt.Errorf("%w: %d bytes of %d", ErrTagSize, size, r.hash.Size())
+	}
+
+	r.tagSize = size
+
+	return nil
+}
+
+// Size returns the size of the tags in bytes.
+func (r *Mac) Size() int {
+	return r.tagSize
+}
+
+// Tag returns the tag of the message read from the reader.
+func (r *Mac) Tag(reader io.Reader) []byte {
+	return r.hash.GetSum(reader)[:r.tagSize]
+}
+
+// Verify returns nil if the tag matches the message read from the reader, or ErrMacMismatch if it does not. The tags
+// are compared in constant time, and the tags of another size than the configured one do not match.
+func (r *Mac) Verify(reader io.Reader, tag []byte) error {
+	if subtle.ConstantTimeCompare(r.Tag(reader), tag) != 1 {
+		return ErrMacMismatch
+	}
+
+	return nil
+}
+
+// Verbose enables the verbose output of the errors.
+func (r *Mac) Verbose() *Mac {
+	r.hash.Verbose()
+	return r
+}
+
+// Debug enables the debug output of the errors.
+func (r *Mac) Debug() *Mac {
+	r.hash.Debug()
+	return r
+}
@@ -0,0 +1,100 @@
+package hashed
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"testing"
+)
+
+func TestMac(t *testing.T) {
+	decode := func(s string) []byte {
+		b, _ := hex.DecodeString(s)
+		return b
+	}
+
+	blake2Key := make([]byte, 64)
+	for i := range blake2Key {
+		blake2Key[i] = byte(i)
+	}
+
+	// the test cases 2 and 5 of RFC 4231, the sample 1 of KMAC of SP 800-185 and the first keyed vector of BLAKE2b
+	cases := []struct {
+		hashType string
+		hmacKey  []byte
+		key      []byte
+		data     []byte
+		tagSize  int
+		expected string
+	}{
+		{"sha2-256", []byte("Jefe"), nil, []byte("what do ya want for nothing?"), 0, "5bdcc146bf60754e6a042426089575c75a003f089d2739839dec58b964ec3843"},
+		{"sha2-256", bytes.Repeat([]byte{0x0c}, 20), nil, []byte("Test With Truncation"), 16, "a3b6167473100ee06e0c796c2955552b"},
+		{"kmac-128", nil, decode("404142434445464748494a4b4c4d4e4f505152535455565758595a5b5c5d5e5f"), decode("00010203"), 0, "e5780b0d3ea6f7d3a429c5706aa43a00fadbd7d49628839e3187243f456ee14e"},
+		{"kmac-128", nil, decode("404142434445464748494a4b4c4d4e4f505152535455565758595a5b5c5d5e5f"), decode("00010203"), 4, "e5780b0d"},
+		{"blake2b-512-mac", nil, blake2Key, nil, 0, "10ebb67700b1868efb4417987acf4690ae9d972fb7a590c2f02871799aaa4786b5e996e8f0f4eb981fc214b005f42d2ff4233499391653df7aefcbc13fc51568"},
+	}
+
+	for _, c := range cases {
+		var (
+			mac *Mac
+			err error
+		)
+
+		options := DefaultOptions(c.hashType).SetKey(c.key)
+		if c.hmacKey != nil {
+			mac, err = NewHMac(options, c.hmacKey)
+		} else {
+			mac, err = NewMac(options)
+		}
+
+		if err != nil {
+			t.Fatalf("'%s' failed: %s", c.hashType, err)
+		}
+
+		if err = mac.Truncate(c.tagSize); err != nil {
+			t.Fatalf("'%s' cannot be truncated to %d bytes: %s", c.hashType, c.tagSize, err)
+		}
+
+		tag := decode(c.expected)
+		if output := mac.Tag(bytes.NewReader(c.data)); !bytes.Equal(output, tag) {
+			t.Errorf("'%s' tag is wrong:\n\texpected \"%s\"\n\tgot \"%x\"", c.hashType, c.expected, output)
+		}
+
+		if err = mac.Verify(bytes.NewReader(c.data), tag); err != nil {
+			t.Errorf("'%s' tag is not verified: %s", c.hashType, err)
+		}
+
+		// the modified and the truncated tags do not match
+		tag[len(tag)-1] ^= 1
+		if err = mac.Verify(bytes.NewReader(c.data), tag); !errors.Is(err, ErrMacMismatch) {
+			t.Errorf("'%s' modified tag returned %v, expected %v", c.hashType, err, ErrMacMismatch)
+		}
+
+		if err = mac.Verify(bytes.NewReader(c.data), tag[:len(tag)-1]); !errors.Is(err, ErrMacMismatch) {
+			t.Errorf("'%s' short tag returned %v, expected %v", c.hashType, err, ErrMacMismatch)
+		}
+	}
+}
+
+func TestMacErrors(t *testing.T) {
+	if _, err := NewMac(DefaultOptions("sha2-256")); !errors.Is(err, ErrMacType) {
+		t.Errorf("unkeyed hash type returned %v, expected %v", err, ErrMacType)
+	}
+
+	if _, err := NewHMac(DefaultOptions("kmac-128").SetKey(make([]byte, 16)), []byte("key")); !errors.Is(err, ErrMacType) {
+		t.Errorf("hmac of a keyed hash type returned %v, expected %v", err, ErrMacType)
+	}
+
+	for _, hashType := range []string{"crc-32", "xxh64", "shake-128"} {
+		if _, err := NewHMac(DefaultOptions(hashType), []byte("key")); !errors.Is(err, ErrMacType) {
+			t.Errorf("hmac of %s returned %v, expected %v", hashType, err, ErrMacType)
+		}
+	}
+
+	mac, _ := NewHMac(DefaultOptions("sha2-256"), []byte("key"))
+	for _, size := range []int{-1, MinTagSize - 1, 33} {
+		if err := mac.Truncate(size); !errors.Is(err, ErrTagSize) {
+			t.Errorf("tag size of %d bytes returned %v, expected %v", size, err, ErrTagSize)
+		}
+	}
+}
@@ -1,7 +1,5 @@
 package md2
 
-import "crypto/md5"
-
 // model represents a structure for the MD2 hash.Hash.
 type model struct {
 	digest    [Size]byte     // the digest, Size
@@ -39,31 +37,22 @@ func (r *model) BlockSize() int { return BlockSize }
 
 // Write appends the data to the digest.
 func (r *model) Write(p []byte) (int, error) {
-	md5.New().BlockSize()
-	if r.bufferLen > 0 {
-		n := uint8(len(p))
-
-		if (n + r.bufferLen) > Size {
-			n = Size - r.bufferLen
-		}
+	n := len(p)
 
-		var i uint8
-		for i = 0; i < n; i++ {
-			r.buffer[r.bufferLen+i] = p[i]
-		}
-
-		r.bufferLen += n
+	if r.bufferLen > 0 {
+		x := copy(r.buffer[r.bufferLen:], p)
+		r.bufferLen += uint8(x)
+		p = p[x:]
 
-		if r.bufferLen == Size {
-			r.update(r.buffer[0:Size])
-			r.bufferLen = 0
+		if r.bufferLen < Size {
+			return n, nil
 		}
 
-		p = p[n:]
+		r.update(r.buffer[:])
+		r.bufferLen = 0
 	}
 
-	imax := len(p) / Size
-	for i := 0; i < imax; i++ {
+	for len(p) >= Size {
 		r.update(p[:Size])
 		p = p[Size:]
 	}
@@ -72,7 +61,7 @@ func (r *model) Write(p []byte) (int, error) {
 		r.bufferLen = uint8(copy(r.buffer[:], p))
 	}
 
-	return len(p), nil
+	return n, nil
 }
 
 // Sum appends the current hash to b and returns the resulting slice.
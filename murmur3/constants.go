@@ -0,0 +1,23 @@
+package murmur3
+
+const (
+	// Size32 is the size of a MurmurHash3 x86_32 checksum in bytes.
+	Size32 = 4
+	// Size128 is the size of a MurmurHash3 x86_128 or x64_128 checksum in bytes.
+	Size128 = 16
+	// BlockSize32 is the block size of MurmurHash3 x86_32 in bytes.
+	BlockSize32 = 4
+	// BlockSize128 is the block size of MurmurHash3 x86_128 and x64_128 in bytes.
+	BlockSize128 = 16
+
+	c1x86v32 = 0xcc9e2d51
+	c2x86v32 = 0x1b873593
+
+	c1x86v128 = 0x239b961b
+	c2x86v128 = 0xab0e9789
+	c3x86v128 = 0x38b34ae5
+	c4x86v128 = 0xa1e38b93
+
+	c1x64v128 = 0x87c37b91114253d5
+	c2x64v128 = 0x4cf5ad432745937f
+)
@@ -0,0 +1,32 @@
+// Package murmur3 implements the MurmurHash3 non-cryptographic hash algorithms x86_32, x86_128 and x64_128.
+//
+// The x86_32 checksum is the big-endian form of the 32-bit value, like hash/fnv,
+// and the 128-bit checksums are the bytes written by the reference implementation,
+// which are the little-endian halves h1 and h2 as returned by Guava and mmh3.hash_bytes.
+package murmur3
+
+import "hash"
+
+// New32 creates a new MurmurHash3 x86_32 hash.Hash32 with the seed.
+func New32(seed uint32) hash.Hash32 {
+	r := &model32{seed: seed}
+	r.Reset()
+
+	return r
+}
+
+// New128x86 creates a new MurmurHash3 x86_128 hash.Hash with the seed.
+func New128x86(seed uint32) hash.Hash {
+	r := &model128x86{seed: seed}
+	r.Reset()
+
+	return r
+}
+
+// New128x64 creates a new MurmurHash3 x64_128 hash.Hash with the seed.
+func New128x64(seed uint32) hash.Hash {
+	r := &model128x64{seed: seed}
+	r.Reset()
+
+	return r
+}
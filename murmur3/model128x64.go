@@ -0,0 +1,111 @@
+package murmur3
+
+import (
+	"encoding/binary"
+	"math/bits"
+)
+
+// model128x64 represents a structure for the MurmurHash3 x64_128 hash.Hash.
+type model128x64 struct {
+	seed   uint32
+	h      [2]uint64
+	buf    [BlockSize128]byte
+	bufLen int
+	total  uint64
+}
+
+// implementation of the hash.Hash
+
+// Reset resets the hash.Hash to its initial state.
+func (r *model128x64) Reset() {
+	r.h = [2]uint64{uint64(r.seed), uint64(r.seed)}
+	r.bufLen = 0
+	r.total = 0
+}
+
+// Size returns the number of bytes Sum will return.
+func (r *model128x64) Size() int { return Size128 }
+
+// BlockSize returns the hash's underlying block size.
+func (r *model128x64) BlockSize() int { return BlockSize128 }
+
+// Write appends the data to the digest.
+func (r *model128x64) Write(p []byte) (int, error) {
+	n := len(p)
+	r.total += uint64(n)
+
+	if r.bufLen > 0 {
+		x := copy(r.buf[r.bufLen:], p)
+		r.bufLen += x
+		p = p[x:]
+
+		if r.bufLen < BlockSize128 {
+			return n, nil
+		}
+
+		r.blocks(r.buf[:])
+		r.bufLen = 0
+	}
+
+	if len(p) >= BlockSize128 {
+		k := len(p) - len(p)%BlockSize128
+		r.blocks(p[:k])
+		p = p[k:]
+	}
+
+	r.bufLen = copy(r.buf[:], p)
+
+	return n, nil
+}
+
+// Sum appends the current hash to b and returns the resulting slice.
+// It does not change the underlying hash state.
+func (r *model128x64) Sum(b []byte) []byte {
+	h1, h2 := r.h[0], r.h[1]
+
+	var tail [BlockSize128]byte
+	copy(tail[:], r.buf[:r.bufLen])
+	k1 := binary.LittleEndian.Uint64(tail[0:])
+	k2 := binary.LittleEndian.Uint64(tail[8:])
+
+	if r.bufLen > 8 {
+		h2 ^= bits.RotateLeft64(k2*c2x64v128, 33) * c1x64v128
+	}
+
+	if r.bufLen > 0 {
+		h1 ^= bits.RotateLeft64(k1*c1x64v128, 31) * c2x64v128
+	}
+
+	h1 ^= r.total
+	h2 ^= r.total
+
+	h1 += h2
+	h2 += h1
+
+	h1, h2 = fmix64(h1), fmix64(h2)
+
+	h1 += h2
+	h2 += h1
+
+	return binary.LittleEndian.AppendUint64(binary.LittleEndian.AppendUint64(b, h1), h2)
+}
+
+// private
+
+// blocks mixes the blocks of p, whose length is a multiple of the block size, into the hash.
+func (r *model128x64) blocks(p []byte) {
+	h1, h2 := r.h[0], r.h[1]
+
+	for ; len(p) >= BlockSize128; p = p[BlockSize128:] {
+		k1 := binary.LittleEndian.Uint64(p[0:])
+		k2 := binary.LittleEndian.Uint64(p[8:])
+
+		h1 ^= bits.RotateLeft64(k1*c1x64v128, 31) * c2x64v128
+		h1 = (bits.RotateLeft64(h1, 27)+h2)*5 + 0x52dce729
+
+		h2 ^= bits.RotateLeft64(k2*c2x64v128, 33) * c1x64v128
+		h2 = (bits.RotateLeft64(h2, 31)+h1)*5 + 0x38495ab5
+	}
+
+	r.h = [2]uint64{h1, h2}
+}
@@ -0,0 +1,137 @@
+package murmur3
+
+import (
+	"encoding/binary"
+	"math/bits"
+)
+
+// model128x86 represents a structure for the MurmurHash3 x86_128 hash.Hash.
+type model128x86 struct {
+	seed   uint32
+	h      [4]uint32
+	buf    [BlockSize128]byte
+	bufLen int
+	total  uint32
+}
+
+// implementation of the hash.Hash
+
+// Reset resets the hash.Hash to its initial state.
+func (r *model128x86) Reset() {
+	r.h = [4]uint32{r.seed, r.seed, r.seed, r.seed}
+	r.bufLen = 0
+	r.total = 0
+}
+
+// Size returns the number of bytes Sum will return.
+func (r *model128x86) Size() int { return Size128 }
+
+// BlockSize returns the hash's underlying block size.
+func (r *model128x86) BlockSize() int { return BlockSize128 }
+
+// Write appends the data to the digest.
+func (r *model128x86) Write(p []byte) (int, error) {
+	n := len(p)
+	r.total += uint32(n)
+
+	if r.bufLen > 0 {
+		x := copy(r.buf[r.bufLen:], p)
+		r.bufLen += x
+		p = p[x:]
+
+		if r.bufLen < BlockSize128 {
+			return n, nil
+		}
+
+		r.blocks(r.buf[:])
+		r.bufLen = 0
+	}
+
+	if len(p) >= BlockSize128 {
+		k := len(p) - len(p)%BlockSize128
+		r.blocks(p[:k])
+		p = p[k:]
+	}
+
+	r.bufLen = copy(r.buf[:], p)
+
+	return n, nil
+}
+
+// Sum appends the current hash to b and returns the resulting slice.
+// It does not change the underlying hash state.
+func (r *model128x86) Sum(b []byte) []byte {
+	h1, h2, h3, h4 := r.h[0], r.h[1], r.h[2], r.h[3]
+
+	var tail [BlockSize128]byte
+	copy(tail[:], r.buf[:r.bufLen])
+	k1 := binary.LittleEndian.Uint32(tail[0:])
+	k2 := binary.LittleEndian.Uint32(tail[4:])
+	k3 := binary.LittleEndian.Uint32(tail[8:])
+	k4 := binary.LittleEndian.Uint32(tail[12:])
+
+	switch {
+	case r.bufLen > 12:
+		h4 ^= bits.RotateLeft32(k4*c4x86v128, 18) * c1x86v128
+		fallthrough
+	case r.bufLen > 8:
+		h3 ^= bits.RotateLeft32(k3*c3x86v128, 17) * c4x86v128
+		fallthrough
+	case r.bufLen > 4:
+		h2 ^= bits.RotateLeft32(k2*c2x86v128, 16) * c3x86v128
+		fallthrough
+	case r.bufLen > 0:
+		h1 ^= bits.RotateLeft32(k1*c1x86v128, 15) * c2x86v128
+	}
+
+	h1 ^= r.total
+	h2 ^= r.total
+	h3 ^= r.total
+	h4 ^= r.total
+
+	h1 += h2 + h3 + h4
+	h2 += h1
+	h3 += h1
+	h4 += h1
+
+	h1, h2, h3, h4 = fmix32(h1), fmix32(h2), fmix32(h3), fmix32(h4)
+
+	h1 += h2 + h3 + h4
+	h2 += h1
+	h3 += h1
+	h4 += h1
+
+	b = binary.LittleEndian.AppendUint32(b, h1)
+	b = binary.LittleEndian.AppendUint32(b, h2)
+	b = binary.LittleEndian.AppendUint32(b, h3)
+
+	return binary.LittleEndian.AppendUint32(b, h4)
+}
+
+// private
+
+// blocks mixes the blocks of p, whose length is a multiple of the block size, into the hash.
+func (r *model128x86) blocks(p []byte) {
+	h1, h2, h3, h4 := r.h[0], r.h[1], r.h[2], r.h[3]
+
+	for ; len(p) >= BlockSize128; p = p[BlockSize128:] {
+		k1 := binary.LittleEndian.Uint32(p[0:])
+		k2 := binary.LittleEndian.Uint32(p[4:])
+		k3 := binary.LittleEndian.Uint32(p[8:])
+		k4 := binary.LittleEndian.Uint32(p[12:])
+
+		h1 ^= bits.RotateLeft32(k1*c1x86v128, 15) * c2x86v128
+		h1 = (bits.RotateLeft32(h1, 19)+h2)*5 + 0x561ccd1b
+
+		h2 ^= bits.RotateLeft32(k2*c2x86v128, 16) * c3x86v128
+		h2 = (bits.RotateLeft32(h2, 17)+h3)*5 + 0x0bcaa747
+
+		h3 ^= bits.RotateLeft32(k3*c3x86v128, 17) * c4x86v128
+		h3 = (bits.RotateLeft32(h3, 15)+h4)*5 + 0x96cd1c35
+
+		h4 ^= bits.RotateLeft32(k4*c4x86v128, 18) * c1x86v128
+		h4 = (bits.RotateLeft32(h4, 13)+h1)*5 + 0x32ac3b17
+	}
+
+	r.h = [4]uint32{h1, h2, h3, h4}
+}
@@ -0,0 +1,113 @@
+package murmur3
+
+import (
+	"encoding/binary"
+	"math/bits"
+)
+
+// model32 represents a structure for the MurmurHash3 x86_32 hash.Hash32.
+type model32 struct {
+	seed   uint32
+	h      uint32
+	buf    [BlockSize32]byte
+	bufLen int
+	total  uint32
+}
+
+// implementation of the hash.Hash
+
+// Reset resets the hash.Hash to its initial state.
+func (r *model32) Reset() {
+	r.h = r.seed
+	r.bufLen = 0
+	r.total = 0
+}
+
+// Size returns the number of bytes Sum will return.
+func (r *model32) Size() int { return Size32 }
+
+// BlockSize returns the hash's underlying block size.
+func (r *model32) BlockSize() int { return BlockSize32 }
+
+// Write appends the data to the digest.
+func (r *model32) Write(p []byte) (int, error) {
+	n := len(p)
+	r.total += uint32(n)
+
+	if r.bufLen > 0 {
+		x := copy(r.buf[r.bufLen:], p)
+		r.bufLen += x
+		p = p[x:]
+
+		if r.bufLen < BlockSize32 {
+			return n, nil
+		}
+
+		r.h = block32(r.h, binary.LittleEndian.Uint32(r.buf[:]))
+		r.bufLen = 0
+	}
+
+	h := r.h
+	for ; len(p) >= BlockSize32; p = p[BlockSize32:] {
+		h = block32(h, binary.LittleEndian.Uint32(p))
+	}
+
+	r.h = h
+	r.bufLen = copy(r.buf[:], p)
+
+	return n, nil
+}
+
+// Sum appends the current hash to b and returns the resulting slice.
+// It does not change the underlying hash state.
+func (r *model32) Sum(b []byte) []byte {
+	return binary.BigEndian.AppendUint32(b, r.Sum32())
+}
+
+// implementation of the hash.Hash32
+
+// Sum32 returns the current hash.
+func (r *model32) Sum32() uint32 {
+	h := r.h
+
+	var k uint32
+	for i := r.bufLen - 1; i >= 0; i-- {
+		k = k<<8 | uint32(r.buf[i])
+	}
+
+	if r.bufLen > 0 {
+		h ^= bits.RotateLeft32(k*c1x86v32, 15) * c2x86v32
+	}
+
+	return fmix32(h ^ r.total)
+}
+
+// private
+
+// block32 mixes a block into the hash.
+func block32(h, k uint32) uint32 {
+	h ^= bits.RotateLeft32(k*c1x86v32, 15) * c2x86v32
+	return bits.RotateLeft32(h, 13)*5 + 0xe6546b64
+}
+
+// fmix32 is the 32-bit finalization mix.
+func fmix32(h uint32) uint32 {
+	h ^= h >> 16
+	h *= 0x85ebca6b
+	h ^= h >> 13
+	h *= 0xc2b2ae35
+	h ^= h >> 16
+
+	return h
+}
+
+// fmix64 is the 64-bit finalization mix.
+func fmix64(k uint64) uint64 {
+	k ^= k >> 33
+	k *= 0xff51afd7ed558ccd
+	k ^= k >> 33
+	k *= 0xc4ceb9fe1a85ec53
+	k ^= k >> 33
+
+	return k
+}
@@ -0,0 +1,92 @@
+package murmur3
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"hash"
+	"testing"
+)
+
+// verification returns the verification code of SMHasher for the hash,
+// the sum of keys of 0 to 255 bytes with the seed 256-length hashed with the seed 0.
+func verification(newHash func(uint32) hash.Hash, reference func([]byte) []byte) uint32 {
+	key := make([]byte, 256)
+	var hashes []byte
+
+	for i := 0; i < 256; i++ {
+		key[i] = byte(i)
+
+		h := newHash(uint32(256 - i))
+		h.Write(key[:i])
+		hashes = append(hashes, reference(h.Sum(nil))...)
+	}
+
+	h := newHash(0)
+	h.Write(hashes)
+
+	return binary.LittleEndian.Uint32(reference(h.Sum(nil)))
+}
+
+func TestVerification(t *testing.T) {
+	// the x86_32 sum is big-endian while the reference writes the value in little-endian
+	littleEndian := func(sum []byte) []byte { return binary.LittleEndian.AppendUint32(nil, binary.BigEndian.Uint32(sum)) }
+	same := func(sum []byte) []byte { return sum }
+
+	cases := []struct {
+		name      string
+		newHash   func(uint32) hash.Hash
+		reference func([]byte) []byte
+		expected  uint32
+	}{
+		{"x86_32", func(seed uint32) hash.Hash { return New32(seed) }, littleEndian, 0xb0f57ee3},
+		{"x86_128", New128x86, same, 0xb3ece62a},
+		{"x64_128", New128x64, same, 0x6384ba69},
+	}
+
+	for _, c := range cases {
+		if got := verification(c.newHash, c.reference); got != c.expected {
+			t.Errorf("verification code of %s is wrong:\n\texpected \"%08x\"\n\tgot \"%08x\"", c.name, c.expected, got)
+		}
+	}
+}
+
+func TestStrings(t *testing.T) {
+	const fox = "The quick brown fox jumps over the lazy dog"
+
+	vectors := []struct {
+		h        hash.Hash
+		input    string
+		expected string
+	}{
+		{New32(0), "", "00000000"},
+		{New32(1), "", "514e28b7"},
+		{New32(0), "hello", "248bfa47"},
+		{New32(0), fox, "2e4ff723"},
+		{New128x64(0), fox, "6c1b07bc7bbc4be347939ac4a93c437a"},
+	}
+
+	for _, v := range vectors {
+		v.h.Write([]byte(v.input))
+
+		if got := hex.EncodeToString(v.h.Sum(nil)); got != v.expected {
+			t.Errorf("sum of \"%s\" is wrong:\n\texpected \"%s\"\n\tgot \"%s\"", v.input, v.expected, got)
+		}
+	}
+}
+
+func benchmarkMurmur3(b *testing.B, h hash.Hash) {
+	data := make([]byte, 8192)
+
+	b.SetBytes(int64(len(data)))
+	for i := 0; i < b.N; i++ {
+		h.Reset()
+		h.Write(data)
+		h.Sum(nil)
+	}
+}
+
+func BenchmarkMurmur3X86Type32(b *testing.B) { benchmarkMurmur3(b, New32(0)) }
+
+func BenchmarkMurmur3X86Type128(b *testing.B) { benchmarkMurmur3(b, New128x86(0)) }
+
+func BenchmarkMurmur3X64Type128(b *testing.B) { benchmarkMurmur3(b, New128x64(0)) }
@@ -2,28 +2,41 @@ package hashed
 
 import (
 	"fmt"
+	"hashed/blake2"
 	"hashed/kmac"
 )
 
 type Options struct {
-	HashType      string
-	Key           []byte
-	FunctionName  []byte
-	Customization []byte
-	KMac128Size   int
-	KMac256Size   int
-	SubType       string
+	HashType        string
+	Key             []byte
+	FunctionName    []byte
+	Customization   []byte
+	KMac128Size     int
+	KMac256Size     int
+	SubType         string
+	Seed            uint64
+	Size            int
+	Salt            []byte
+	Personalization []byte
+	Tree            blake2.Tree
+	Nonce           []byte
 }
 
 func DefaultOptions(hashType string) *Options {
 	return &Options{
-		HashType:      hashType,
-		Key:           []byte(""),
-		FunctionName:  []byte(""),
-		Customization: []byte(""),
-		KMac128Size:   kmac.Size128,
-		KMac256Size:   kmac.Size256,
-		SubType:       "",
+		HashType:        hashType,
+		Key:             []byte(""),
+		FunctionName:    []byte(""),
+		Customization:   []byte(""),
+		KMac128Size:     kmac.Size128,
+		KMac256Size:     kmac.Size256,
+		SubType:         "",
+		Seed:            0,
+		Size:            0,
+		Salt:            []byte(""),
+		Personalization: []byte(""),
+		Tree:            blake2.Tree{},
+		Nonce:           []byte(""),
 	}
 }
 
@@ -50,3 +63,58 @@ func (r *Options) SetSubType(subType string) *Options {
 	r.SubType = subType
 	return r
 }
+
+func (r *Options) SetSeed(seed uint64) *Options {
+	r.Seed = seed
+	return r
+}
+
+func (r *Options) SetSize(size int) *Options {
+	r.Size = size
+	return r
+}
+
+func (r *Options) SetSalt(salt []byte) *Options {
+	r.Salt = salt
+	return r
+}
+
+func (r *Options) SetPersonalization(personalization []byte) *Options {
+	r.Personalization = personalization
+	return r
+}
+
+func (r *Options) SetTree(tree blake2.Tree) *Options {
+	r.Tree = tree
+	return r
+}
+
+func (r *Options) SetNonce(nonce []byte) *Options {
+	r.Nonce = nonce
+	return r
+}
+
+// blake2Params returns the BLAKE2 parameter block of the options, without the key which is only used by
+// the BLAKE2 MAC types.
+func (r *Options) blake2Params() *blake2.Params {
+	return &blake2.Params{
+		Size:            r.Size,
+		Salt:            r.Salt,
+		Personalization: r.Personalization,
+		Tree:            r.Tree,
+	}
+}
+
+// unkeyedBlake2Params returns the BLAKE2 parameter block of the options for the unkeyed BLAKE2 type.
+func (r *Options) unkeyedBlake2Params(hashType string) *blake2.Params {
+	r.checkBlake2Key(hashType)
+	return r.blake2Params()
+}
+
+// checkBlake2Key exits with an error if a key is given to the unkeyed BLAKE2 type, instead of ignoring it, as the
+// keyed hash is its MAC type.
+func (r *Options) checkBlake2Key(hashType string) {
+	if len(r.Key) > 0 {
+		fatalError(fmt.Sprintf("%s is not keyed, use %s-mac for a keyed hash", hashType, hashType))
+	}
+}
@@ -0,0 +1,91 @@
+package password
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+
+	"hashed/argon2"
+)
+
+// Argon2 represents the parameters of Argon2 password hashing.
+type Argon2 struct {
+	Mode argon2.Mode
+	// Memory is the memory size in KiB.
+	Memory uint32
+	// Time is the number of passes over the memory.
+	Time uint32
+	// Threads is the degree of parallelism.
+	Threads    uint8
+	SaltLength int
+	KeyLength  int
+}
+
+// DefaultArgon2 are the parameters of Argon2id of the second recommended option of RFC 9106, 64 MiB of memory.
+var DefaultArgon2 = Argon2{Mode: argon2.ID, Memory: 64 * 1024, Time: 3, Threads: 4, SaltLength: 16, KeyLength: 32}
+
+// Scheme returns the identifier of the scheme, argon2d, argon2i or argon2id.
+func (r Argon2) Scheme() string { return r.Mode.String() }
+
+// private
+
+func (r Argon2) key(password, salt []byte) ([]byte, error) {
+	if r.KeyLength < argon2.MinKeyLength {
+		return nil, fmt.Errorf("%w: key length %d", ErrParams, r.KeyLength)
+	}
+
+	return argon2.Key(password, salt, &argon2.Params{
+		Mode:      r.Mode,
+		Time:      r.Time,
+		Memory:    r.Memory,
+		Threads:   r.Threads,
+		KeyLength: uint32(r.KeyLength),
+	})
+}
+
+func (r Argon2) encode(salt, key []byte) string {
+	settings := fmt.Sprintf("m=%d,t=%d,p=%d", r.Memory, r.Time, r.Threads)
+
+	return encodePHC(r.Scheme(), strconv.Itoa(argon2.Version), settings, salt, key)
+}
+
+func (r Argon2) newSalt() ([]byte, error) { return randomSalt(r.SaltLength) }
+
+// newArgon2 returns the parameters of the Argon2 scheme with the settings m, t and p.
+func newArgon2(scheme string, values settings, saltLength, keyLength int) (Params, error) {
+	p := DefaultArgon2
+
+	switch scheme {
+	case "argon2d":
+		p.Mode = argon2.D
+	case "argon2i":
+		p.Mode = argon2.I
+	}
+
+	memory, err := values.take("m", uint64(p.Memory), 8, math.MaxUint32)
+	if err != nil {
+		return nil, err
+	}
+
+	time, err := values.take("t", uint64(p.Time), 1, math.MaxUint32)
+	if err != nil {
+		return nil, err
+	}
+
+	threads, err := values.take("p", uint64(p.Threads), 1, math.MaxUint8)
+	if err != nil {
+		return nil, err
+	}
+
+	p.Memory, p.Time, p.Threads = uint32(memory), uint32(time), uint8(threads)
+
+	if saltLength > 0 {
+		p.SaltLength = saltLength
+	}
+
+	if keyLength > 0 {
+		p.KeyLength = keyLength
+	}
+
+	return p, nil
+}
@@ -0,0 +1,126 @@
+package password
+
+import (
+	"encoding/base64"
+	"fmt"
+	"strconv"
+
+	"golang.org/x/crypto/blowfish"
+)
+
+const (
+	// MinBcryptCost is the minimum cost of bcrypt.
+	MinBcryptCost = 4
+	// MaxBcryptCost is the maximum cost of bcrypt.
+	MaxBcryptCost = 31
+	// bcryptSaltLength is the length of the salt of bcrypt in bytes.
+	bcryptSaltLength = 16
+	// bcryptKeyLength is the length of the encoded hash of bcrypt in bytes, the last byte of the ciphertext is dropped.
+	bcryptKeyLength = 23
+	// bcryptMaxPassword is the maximum length of the password, the longer part of it is not used by bcrypt.
+	bcryptMaxPassword = 72
+)
+
+// bcryptEncoding is the base64 encoding of bcrypt, with its own alphabet and without padding.
+var bcryptEncoding = base64.NewEncoding("./ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789").WithPadding(base64.NoPadding)
+
+// bcryptText is the text which is encrypted 64 times by the key schedule of the password.
+var bcryptText = []byte("OrpheanBeholderScryDoubt")
+
+// Bcrypt represents the parameters of bcrypt password hashing.
+type Bcrypt struct {
+	// Cost is the base 2 logarithm of the number of iterations of the key schedule.
+	Cost int
+}
+
+// DefaultBcrypt are the parameters of bcrypt with the cost of 12.
+var DefaultBcrypt = Bcrypt{Cost: 12}
+
+// Scheme returns the identifier of the scheme, bcrypt.
+func (r Bcrypt) Scheme() string { return "bcrypt" }
+
+// private
+
+// key returns the first 23 bytes of the ciphertext of bcrypt, the password is followed by a zero byte as in $2b$.
+// A password longer than 72 bytes is truncated as by OpenBSD and libxcrypt, the new hashes reject it in Hash.
+func (r Bcrypt) key(password, salt []byte) ([]byte, error) {
+	if r.Cost < MinBcryptCost || r.Cost > MaxBcryptCost {
+		return nil, fmt.Errorf("%w: cost %d", ErrParams, r.Cost)
+	}
+
+	password = password[:min(len(password), bcryptMaxPassword)]
+
+	if len(salt) != bcryptSaltLength {
+		return nil, fmt.Errorf("%w: salt length %d", ErrParams, len(salt))
+	}
+
+	key := append(password[:len(password):len(password)], 0)
+
+	c, err := blowfish.NewSaltedCipher(key, salt)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := uint64(0); i < 1<<r.Cost; i++ {
+		blowfish.ExpandKey(key, c)
+		blowfish.ExpandKey(salt, c)
+	}
+
+	text := make([]byte, len(bcryptText))
+	copy(text, bcryptText)
+
+	for i := 0; i < len(text); i += blowfish.BlockSize {
+		for j := 0; j < 64; j++ {
+			c.Encrypt(text[i:i+blowfish.BlockSize], text[i:i+blowfish.BlockSize])
+		}
+	}
+
+	return text[:bcryptKeyLength], nil
+}
+
+func (r Bcrypt) encode(salt, key []byte) string {
+	return fmt.Sprintf("$2b$%02d$%s%s", r.Cost, bcryptEncoding.EncodeToString(salt), bcryptEncoding.EncodeToString(key))
+}
+
+func (r Bcrypt) newSalt() ([]byte, error) { return randomSalt(bcryptSaltLength) }
+
+// newBcrypt returns the parameters of the bcrypt scheme with the setting cost.
+func newBcrypt(values settings) (Params, error) {
+	cost, err := values.take("cost", uint64(DefaultBcrypt.Cost), MinBcryptCost, MaxBcryptCost)
+	if err != nil {
+		return nil, err
+	}
+
+	return Bcrypt{Cost: int(cost)}, nil
+}
+
+// parseBcrypt decodes a hash in the modular crypt format of bcrypt, $2b$cost$ followed by 22 characters of the salt
+// and 31 characters of the hash. The $2a$ and $2y$ prefixes are the same for the passwords up to 72 bytes.
+func parseBcrypt(encoded string) (*Hashed, error) {
+	if len(encoded) != 60 || encoded[3] != '$' || encoded[6] != '$' {
+		return nil, ErrFormat
+	}
+
+	switch encoded[:3] {
+	case "$2a", "$2b", "$2y":
+	default:
+		return nil, fmt.Errorf("%w: unsupported bcrypt version %s", ErrFormat, encoded[1:3])
+	}
+
+	cost, err := strconv.Atoi(encoded[4:6])
+	if err != nil || cost < MinBcryptCost || cost > MaxBcryptCost {
+		return nil, fmt.Errorf("%w: bcrypt cost %s", ErrFormat, encoded[4:6])
+	}
+
+	salt, err := bcryptEncoding.DecodeString(encoded[7:29])
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrFormat, err)
+	}
+
+	key, err := bcryptEncoding.DecodeString(encoded[29:])
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrFormat, err)
+	}
+
+	return &Hashed{Params: Bcrypt{Cost: cost}, Salt: salt, Key: key}, nil
+}
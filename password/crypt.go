@@ -0,0 +1,118 @@
+package password
+
+import (
+	"crypto/rand"
+	"hash"
+	"strings"
+)
+
+// cryptAlphabet is the alphabet of the base64 encoding of crypt(3), which is in another order than the one of bcrypt.
+const cryptAlphabet = "./0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz"
+
+// cryptSalt returns a random salt of the length in characters of the alphabet of crypt(3).
+func cryptSalt(length int) ([]byte, error) {
+	salt := make([]byte, length)
+	if _, err := rand.Read(salt); err != nil {
+		return nil, err
+	}
+
+	// the alphabet has 64 characters, so the low six bits of the random bytes are uniform
+	for i, b := range salt {
+		salt[i] = cryptAlphabet[b&0x3f]
+	}
+
+	return salt, nil
+}
+
+// encodeCrypt returns the bytes of b in the order encoded in the base64 encoding of crypt(3). Every three bytes are a
+// big-endian number which is encoded from its least significant six bits, the last one or two bytes are encoded alike.
+func encodeCrypt(b []byte, order []int) string {
+	var s strings.Builder
+
+	for i := 0; i < len(order); i += 3 {
+		n := min(3, len(order)-i)
+
+		var v uint32
+		for _, j := range order[i : i+n] {
+			v = v<<8 | uint32(b[j])
+		}
+
+		for k := 0; k < (8*n+5)/6; k++ {
+			s.WriteByte(cryptAlphabet[v&0x3f])
+			v >>= 6
+		}
+	}
+
+	return s.String()
+}
+
+// decodeCrypt returns the bytes encoded by encodeCrypt in the order, the unused bits of the last character must be zero.
+func decodeCrypt(s string, order []int) ([]byte, error) {
+	b := make([]byte, len(order))
+
+	for i := 0; i < len(order); i += 3 {
+		n := min(3, len(order)-i)
+		chars := (8*n + 5) / 6
+
+		if len(s) < chars {
+			return nil, ErrFormat
+		}
+
+		var v uint32
+		for k := chars - 1; k >= 0; k-- {
+			c := strings.IndexByte(cryptAlphabet, s[k])
+			if c < 0 {
+				return nil, ErrFormat
+			}
+
+			v = v<<6 | uint32(c)
+		}
+
+		if v>>(8*n) != 0 {
+			return nil, ErrFormat
+		}
+
+		for k := n - 1; k >= 0; k-- {
+			b[order[i+k]] = byte(v)
+			v >>= 8
+		}
+
+		s = s[chars:]
+	}
+
+	if s != "" {
+		return nil, ErrFormat
+	}
+
+	return b, nil
+}
+
+// littleEndianOrder returns the order of n bytes which are encoded as little-endian numbers of three bytes, like the
+// salts and the hashes of yescrypt.
+func littleEndianOrder(n int) []int {
+	order := make([]int, 0, n)
+	for i := 0; i < n; i += 3 {
+		for j := min(i+3, n) - 1; j >= i; j-- {
+			order = append(order, j)
+		}
+	}
+
+	return order
+}
+
+// cryptLength returns the number of bytes encoded in the characters by the base64 encoding of crypt(3), or -1 if
+// no number of bytes is encoded in them.
+func cryptLength(chars int) int {
+	if chars%4 == 1 {
+		return -1
+	}
+
+	return chars/4*3 + max(chars%4-1, 0)
+}
+
+// write writes the inputs to the hash.
+func write(h hash.Hash, inputs ...[]byte) {
+	for _, input := range inputs {
+		_, _ = h.Write(input)
+	}
+}
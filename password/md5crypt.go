@@ -0,0 +1,126 @@
+package password
+
+import (
+	"fmt"
+	"strings"
+
+	"hashed"
+)
+
+const (
+	// md5CryptSaltLength is the maximum length of the salt of MD5 crypt in characters.
+	md5CryptSaltLength = 8
+	// md5CryptRounds is the number of rounds of MD5 crypt.
+	md5CryptRounds = 1000
+)
+
+// md5CryptOrder is the order of the bytes of the hash of MD5 crypt in its encoding.
+var md5CryptOrder = []int{0, 6, 12, 1, 7, 13, 2, 8, 14, 3, 9, 15, 4, 10, 5, 11}
+
+// Md5Crypt represents the parameters of the MD5 based crypt(3) of FreeBSD, $1$, which has no settings.
+type Md5Crypt struct {
+	// Apache selects the variant of the htpasswd files of Apache, $apr1$, which differs only by its prefix.
+	Apache bool
+}
+
+// Scheme returns the identifier of the scheme, md5-crypt or apr1.
+func (r Md5Crypt) Scheme() string {
+	if r.Apache {
+		return "apr1"
+	}
+
+	return "md5-crypt"
+}
+
+// private
+
+// key returns the hash of MD5 crypt, the initial hash of the password, the prefix and the salt is mixed in 1000
+// rounds with the password and the salt.
+func (r Md5Crypt) key(password, salt []byte) ([]byte, error) {
+	if len(salt) > md5CryptSaltLength {
+		return nil, fmt.Errorf("%w: salt length %d", ErrParams, len(salt))
+	}
+
+	h := hashed.Md5()
+	write(h, password, salt, password)
+	alternate := h.Sum(nil)
+
+	h.Reset()
+	write(h, password, []byte(r.prefix()), salt)
+
+	for n := len(password); n > 0; n -= len(alternate) {
+		write(h, alternate[:min(n, len(alternate))])
+	}
+
+	// the bits of the length of the password select a zero byte or its first byte
+	for n := len(password); n > 0; n >>= 1 {
+		if n&1 != 0 {
+			write(h, []byte{0})
+		} else {
+			write(h, password[:1])
+		}
+	}
+
+	sum := h.Sum(nil)
+
+	for i := 0; i < md5CryptRounds; i++ {
+		h.Reset()
+
+		if i&1 != 0 {
+			write(h, password)
+		} else {
+			write(h, sum)
+		}
+
+		if i%3 != 0 {
+			write(h, salt)
+		}
+
+		if i%7 != 0 {
+			write(h, password)
+		}
+
+		if i&1 != 0 {
+			write(h, sum)
+		} else {
+			write(h, password)
+		}
+
+		sum = h.Sum(sum[:0])
+	}
+
+	return sum, nil
+}
+
+func (r Md5Crypt) encode(salt, key []byte) string {
+	return r.prefix() + string(salt) + "$" + encodeCrypt(key, md5CryptOrder)
+}
+
+func (r Md5Crypt) newSalt() ([]byte, error) { return cryptSalt(md5CryptSaltLength) }
+
+// prefix returns the prefix of the encoded hash, $1$ or $apr1$.
+func (r Md5Crypt) prefix() string {
+	if r.Apache {
+		return "$apr1$"
+	}
+
+	return "$1$"
+}
+
+// parseMd5Crypt decodes a hash of MD5 crypt, $1$ or $apr1$ followed by up to 8 characters of the salt, $ and
+// 22 characters of the hash.
+func parseMd5Crypt(encoded string) (*Hashed, error) {
+	params := Md5Crypt{Apache: strings.HasPrefix(encoded, "$apr1$")}
+
+	salt, hash, found := strings.Cut(strings.TrimPrefix(encoded, params.prefix()), "$")
+	if !found || len(salt) > md5CryptSaltLength {
+		return nil, ErrFormat
+	}
+
+	key, err := decodeCrypt(hash, md5CryptOrder)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Hashed{Params: params, Salt: []byte(salt), Key: key}, nil
+}
@@ -0,0 +1,191 @@
+// Package password implements password hashing and verification with Argon2, scrypt, bcrypt and PBKDF2 over the
+// registered hash types, and the formats of crypt(3) of the shadow files, MD5 crypt, SHA crypt and yescrypt.
+//
+// The hashes are encoded in the PHC string format, like $argon2id$v=19$m=65536,t=3,p=4$salt$hash,
+// except bcrypt which uses its modular crypt format $2b$cost$saltHash, and the formats of crypt(3),
+// $1$salt$hash, $apr1$salt$hash, $5$rounds=5000$salt$hash, $6$rounds=5000$salt$hash and $y$params$salt$hash.
+package password
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+var (
+	// ErrMismatch is returned when the password does not match the hash.
+	ErrMismatch = errors.New("password: password does not match the hash")
+	// ErrFormat is returned when the encoded hash is malformed.
+	ErrFormat = errors.New("password: invalid encoded hash")
+	// ErrScheme is returned when the scheme is unknown.
+	ErrScheme = errors.New("password: unknown scheme")
+	// ErrParams is returned when a parameter is unknown to the scheme or out of range.
+	ErrParams = errors.New("password: invalid parameters")
+	// ErrPasswordTooLong is returned when the password of a new bcrypt hash is longer than the 72 bytes which bcrypt uses.
+	ErrPasswordTooLong = errors.New("password: password is longer than 72 bytes")
+)
+
+// Params represents the parameters of a password hashing scheme.
+// The parameters are comparable, two hashes need the same work if their parameters are equal.
+type Params interface {
+	// Scheme returns the identifier of the scheme, like argon2id or pbkdf2-sha2-256.
+	Scheme() string
+	// key derives the key of the password with the salt.
+	key(password, salt []byte) ([]byte, error)
+	// encode returns the encoded hash of the salt and the key.
+	encode(salt, key []byte) string
+	// newSalt returns a random salt of a new hash. The scheme makes the salt, not only its length, because the
+	// salts of MD5 crypt and SHA crypt are strings of the characters of the crypt(3) alphabet, not random bytes.
+	newSalt() ([]byte, error)
+}
+
+// Hashed represents a decoded password hash.
+type Hashed struct {
+	Params Params
+	Salt   []byte
+	Key    []byte
+}
+
+// String returns the encoded hash.
+func (r *Hashed) String() string {
+	return r.Params.encode(r.Salt, r.Key)
+}
+
+// New returns the parameters of the scheme, argon2id, argon2i, argon2d, scrypt, bcrypt, pbkdf2-<hash type>,
+// md5-crypt, apr1, sha256-crypt, sha512-crypt or yescrypt.
+// The settings are comma separated name=value pairs like the parameters of the PHC string format,
+// m, t and p for Argon2, ln, r and p for scrypt, cost for bcrypt, i for PBKDF2, rounds for SHA crypt and
+// ln, r, p and t for yescrypt, the missing ones take their default values.
+func New(scheme, settings string) (Params, error) {
+	values, err := parseSettings(settings)
+	if err != nil {
+		return nil, err
+	}
+
+	return newParams(scheme, values, 0, 0)
+}
+
+// Hash returns the encoded hash of the password with a new random salt.
+// It returns ErrPasswordTooLong for a bcrypt password longer than 72 bytes, as its rest would not be used.
+func Hash(password []byte, params Params) (string, error) {
+	if _, ok := params.(Bcrypt); ok && len(password) > bcryptMaxPassword {
+		return "", ErrPasswordTooLong
+	}
+
+	salt, err := params.newSalt()
+	if err != nil {
+		return "", err
+	}
+
+	key, err := params.key(password, salt)
+	if err != nil {
+		return "", err
+	}
+
+	return params.encode(salt, key), nil
+}
+
+// Verify returns nil if the password matches the encoded hash, or ErrMismatch if it does not.
+// The keys are compared in constant time.
+func Verify(password []byte, encoded string) error {
+	h, err := Parse(encoded)
+	if err != nil {
+		return err
+	}
+
+	key, err := h.Params.key(password, h.Salt)
+	if err != nil {
+		return err
+	}
+
+	if subtle.ConstantTimeCompare(key, h.Key) != 1 {
+		return ErrMismatch
+	}
+
+	return nil
+}
+
+// NeedsRehash reports whether the encoded hash was made with another scheme or other parameters than params,
+// so it should be replaced by a new hash of the password after a successful verification.
+func NeedsRehash(encoded string, params Params) (bool, error) {
+	h, err := Parse(encoded)
+	if err != nil {
+		return false, err
+	}
+
+	return h.Params != params, nil
+}
+
+// Parse decodes the encoded hash, in the PHC string format or one of the formats of crypt(3).
+func Parse(encoded string) (*Hashed, error) {
+	switch {
+	case strings.HasPrefix(encoded, "$2"):
+		return parseBcrypt(encoded)
+	case strings.HasPrefix(encoded, "$1$"), strings.HasPrefix(encoded, "$apr1$"):
+		return parseMd5Crypt(encoded)
+	case strings.HasPrefix(encoded, "$5$"), strings.HasPrefix(encoded, "$6$"):
+		return parseShaCrypt(encoded)
+	case strings.HasPrefix(encoded, "$y$"):
+		return parseYescrypt(encoded)
+	}
+
+	return parsePHC(encoded)
+}
+
+// private
+
+// randomSalt returns a random salt of the length in bytes.
+func randomSalt(length int) ([]byte, error) {
+	if length < 1 {
+		return nil, fmt.Errorf("%w: salt length %d", ErrParams, length)
+	}
+
+	salt := make([]byte, length)
+	if _, err := rand.Read(salt); err != nil {
+		return nil, err
+	}
+
+	return salt, nil
+}
+
+// newParams returns the parameters of the scheme with the settings, the lengths of the salt and the key are the
+// default ones if they are zero.
+func newParams(scheme string, values settings, saltLength, keyLength int) (Params, error) {
+	var (
+		params Params
+		err    error
+	)
+
+	switch {
+	case scheme == "argon2d" || scheme == "argon2i" || scheme == "argon2id":
+		params, err = newArgon2(scheme, values, saltLength, keyLength)
+	case scheme == "scrypt":
+		params, err = newScrypt(values, saltLength, keyLength)
+	case scheme == "bcrypt":
+		params, err = newBcrypt(values)
+	case scheme == "md5-crypt" || scheme == "apr1":
+		params = Md5Crypt{Apache: scheme == "apr1"}
+	case scheme == "sha256-crypt":
+		params, err = newShaCrypt(256, values)
+	case scheme == "sha512-crypt":
+		params, err = newShaCrypt(512, values)
+	case scheme == "yescrypt":
+		params, err = newYescrypt(values)
+	case scheme == "pbkdf2" || strings.HasPrefix(scheme, "pbkdf2-"):
+		params, err = newPbkdf2(strings.TrimPrefix(strings.TrimPrefix(scheme, "pbkdf2"), "-"), values, saltLength, keyLength)
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrScheme, scheme)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if err = values.check(); err != nil {
+		return nil, err
+	}
+
+	return params, nil
+}
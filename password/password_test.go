@@ -0,0 +1,173 @@
+package password
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"hashed/argon2"
+)
+
+func TestVerify(t *testing.T) {
+	cases := []struct {
+		password string
+		encoded  string
+	}{
+		// the example of the reference implementation of Argon2
+		{"password", "$argon2i$v=19$m=65536,t=2,p=4$c29tZXNhbHQ$RdescudvJCsgt3ub+b+dWRWJTmaaJObG"},
+		{"password", "$argon2id$v=19$m=256,t=2,p=1$c29tZXNhbHQ$nf65EOgLrQMR/uIPnA4rEsF5h7TKyQwu9U1bMCHGi/4"},
+		{"password", "$scrypt$ln=10,r=8,p=1$c29tZXNhbHQ$wdXoWEig5T693O7BJbufEPRk+qarG40BYOh1xe9tMAc"},
+		// the test vectors of OpenBSD and RFC 6070
+		{"U*U", "$2b$05$CCCCCCCCCCCCCCCCCCCCC.E5YPO9kmyuRGyh0XouQYb4YMJKvyOeW"},
+		{"U*U", "$2a$05$CCCCCCCCCCCCCCCCCCCCC.E5YPO9kmyuRGyh0XouQYb4YMJKvyOeW"},
+		{"", "$2b$04$......................w74bL5gU7LSJClZClCa.Pkz14aTv/XO"},
+		{strings.Repeat("x", 72), "$2b$04$abcdefghijklmnopqrstuubzadhGtS2zEF.gu0yd0opP6cVzb.e0i"},
+		// a password of 98 bytes hashed by libxcrypt, which uses its first 72 bytes
+		{"0123456789abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789abcdefghijklmnopqrstuvwxyz", "$2b$05$CCCCCCCCCCCCCCCCCCCCC.n2VnrmAaokJwiDSekcCjbZxRIyVngRy"},
+		{"password", "$pbkdf2-sha1$i=2$c2FsdA$6mwBTcctb4zNHtkqzh1B8NjeiVc"},
+		// the test vectors of the specification of SHA crypt, libxcrypt and OpenSSL
+		{"password", "$1$saltstri$qQY4WxjABChYG1ccLpfkz/"},
+		{"", "$1$$qRPK7m23GJusamGpoGLby/"},
+		{"password", "$apr1$saltsalt$yAAkm4libquA.ZWLHbSBq/"},
+		{"", "$apr1$$J/S5FGXXjRRxbhIznTb/E1"},
+		{"Hello world!", "$5$saltstring$5B8vYYiY.CVt1RlTTf8KbXBH3hsxY/GNooZaBBGWEc5"},
+		{"Hello world!", "$5$rounds=10000$saltstringsaltst$3xv.VbSHBb41AL9AvLeujZkZRBAwqFMz2.opqey6IcA"},
+		{"Hello world!", "$6$saltstring$svn8UoSVapNtMuq1ukKS4tPQd8iKwSMHWjl/O817G3uBnIFNjnQJuesI68u4OTLiBFdcbYEdFCoEOfaS35inz1"},
+		{"Hello world!", "$6$rounds=10000$saltstringsaltst$OW1/O6BYHV6BcXZu8QVeXbDWra3Oeqh0sbHbbMCVNSnCM/UrjmM0Dp8vOuZeHBy/YTBmSK6H9qs/y3RnOaw5v."},
+		{"password", "$y$j9T$F5Jx5fExrKuPp53xLKQ..1$tnSYvahCwPBHKZUspmcxMfb0.WiB9W.zEaKlOBL35rC"},
+		{"password", "$y$j7kn$abcdefghijklmnop$QKLDYMSgdtev.8QjctSzpo99FqX8LHLwHsIYGJ95vx."},
+		{"password", "$y$j750..$abcdefghijklmnop$C3efQYp00e85GxcKZhh.LCKK5HKeQof9h2gcVLVq7Q/"},
+		{"password", "$y$/75/0$abcdefghijklmnop$tfASXr/vgg2EC.IuSJb.HrfsJNoeTHKxChwjRAK5aC1"},
+		{"password", "$y$.75$abcdefghijklmnop$zFk6jkVTeadPSCf.2bk9BdBoqWsNZ1hYxJzjHbIEd43"},
+		{"password", "$y$j75$$MY7LY7iSiXDbIK//WLX8B9MRa5LUgGVUicMJCn3sKE1"},
+		{"password", "$pbkdf2-sha2-512$i=1000$c2FsdHNhbHRzYWx0c2FsdA$715rqIr5dXOVPpBhqqsugl037zT5bWJTWYmZtIcK8hBnisKpwfY7kokvwjDrNHqHhF50Pb7MD6HvkJwiDQw4ww"},
+	}
+
+	for _, c := range cases {
+		if err := Verify([]byte(c.password), c.encoded); err != nil {
+			t.Errorf("verification of \"%s\" failed: %s", c.encoded, err)
+		}
+
+		if err := Verify([]byte("wrong password"), c.encoded); !errors.Is(err, ErrMismatch) {
+			t.Errorf("verification of a wrong password of \"%s\" returned %v, expected %v", c.encoded, err, ErrMismatch)
+		}
+
+		// the decoded hash is encoded back the same, but the version of bcrypt
+		h, err := Parse(c.encoded)
+		if err != nil {
+			t.Fatalf("parsing of \"%s\" failed: %s", c.encoded, err)
+		}
+
+		if got := h.String(); got != strings.Replace(c.encoded, "$2a$", "$2b$", 1) {
+			t.Errorf("encoding of \"%s\" is wrong: %s", c.encoded, got)
+		}
+	}
+}
+
+func TestHash(t *testing.T) {
+	schemes := []struct {
+		scheme   string
+		settings string
+	}{
+		{"argon2id", "m=64,t=1,p=2"},
+		{"argon2i", "m=64,t=1,p=1"},
+		{"argon2d", "m=64,t=1,p=1"},
+		{"scrypt", "ln=4"},
+		{"bcrypt", "cost=4"},
+		{"pbkdf2", "i=10"},
+		{"pbkdf2-whirlpool", "i=10"},
+		{"md5-crypt", ""},
+		{"apr1", ""},
+		{"sha256-crypt", "rounds=1000"},
+		{"sha512-crypt", ""},
+		{"yescrypt", "ln=8,r=8,p=2,t=1"},
+	}
+
+	for _, s := range schemes {
+		params, err := New(s.scheme, s.settings)
+		if err != nil {
+			t.Fatalf("%s failed: %s", s.scheme, err)
+		}
+
+		encoded, err := Hash([]byte("secret"), params)
+		if err != nil {
+			t.Fatalf("%s hash failed: %s", s.scheme, err)
+		}
+
+		if err = Verify([]byte("secret"), encoded); err != nil {
+			t.Errorf("%s verification of \"%s\" failed: %s", s.scheme, encoded, err)
+		}
+
+		if rehash, err := NeedsRehash(encoded, params); rehash || err != nil {
+			t.Errorf("%s hash \"%s\" needs rehash with the same parameters: %v", s.scheme, encoded, err)
+		}
+	}
+}
+
+func TestNeedsRehash(t *testing.T) {
+	encoded := "$argon2id$v=19$m=256,t=2,p=1$c29tZXNhbHQ$nf65EOgLrQMR/uIPnA4rEsF5h7TKyQwu9U1bMCHGi/4"
+	same := Argon2{Mode: argon2.ID, Memory: 256, Time: 2, Threads: 1, SaltLength: 8, KeyLength: 32}
+
+	cases := []struct {
+		params   Params
+		expected bool
+	}{
+		{same, false},
+		{Argon2{Mode: argon2.ID, Memory: 256, Time: 3, Threads: 1, SaltLength: 8, KeyLength: 32}, true},
+		{Argon2{Mode: argon2.ID, Memory: 256, Time: 2, Threads: 1, SaltLength: 16, KeyLength: 32}, true},
+		{Argon2{Mode: argon2.I, Memory: 256, Time: 2, Threads: 1, SaltLength: 8, KeyLength: 32}, true},
+		{DefaultArgon2, true},
+		{DefaultBcrypt, true},
+	}
+
+	for _, c := range cases {
+		if got, err := NeedsRehash(encoded, c.params); got != c.expected || err != nil {
+			t.Errorf("rehash with %+v is %v, expected %v: %v", c.params, got, c.expected, err)
+		}
+	}
+}
+
+func TestErrors(t *testing.T) {
+	cases := []struct {
+		encoded  string
+		expected error
+	}{
+		{"", ErrFormat},
+		{"$argon2id$m=256,t=2,p=1$c29tZXNhbHQ$nf65EOgLrQMR", ErrFormat},
+		{"$argon2id$v=16$m=256,t=2,p=1$c29tZXNhbHQ$nf65EOgLrQMR", ErrFormat},
+		{"$argon2id$v=19$m=256,t=2,p=1,x=1$c29tZXNhbHQ$nf65EOgLrQMR", ErrParams},
+		{"$argon2id$v=19$m=256,t=0,p=1$c29tZXNhbHQ$nf65EOgLrQMR", ErrParams},
+		{"$argon2id$v=19$m=256,t=2,p=1$c29tZXNhbHQ$nf65EOgLrQMR!", ErrFormat},
+		{"$scrypt$v=19$ln=10,r=8,p=1$c29tZXNhbHQ$nf65EOgLrQMR", ErrFormat},
+		{"$md5$c29tZXNhbHQ$nf65EOgLrQMR", ErrScheme},
+		{"$pbkdf2-kmac-128$i=2$c2FsdA$6mwBTcctb4zNHtkqzh1B8NjeiVc", ErrScheme},
+		{"$pbkdf2-crc-32$i=2$c2FsdA$6mwBTcctb4zNHtkqzh1B8NjeiVc", ErrScheme},
+		{"$pbkdf2-xxh64$i=2$c2FsdA$6mwBTcctb4zNHtkqzh1B8NjeiVc", ErrScheme},
+		{"$pbkdf2-shake-128$i=2$c2FsdA$6mwBTcctb4zNHtkqzh1B8NjeiVc", ErrScheme},
+		{"$2x$05$CCCCCCCCCCCCCCCCCCCCC.E5YPO9kmyuRGyh0XouQYb4YMJKvyOeW", ErrFormat},
+		{"$2b$03$CCCCCCCCCCCCCCCCCCCCC.E5YPO9kmyuRGyh0XouQYb4YMJKvyOeW", ErrFormat},
+		{"$2b$05$CCCCCCCCCCCCCCCCCCCCC.E5YPO9kmyuRGyh0XouQYb4YMJKvyOe", ErrFormat},
+		{"$1$saltsaltx$qQY4WxjABChYG1ccLpfkzz", ErrFormat},
+		{"$1$saltstri$qQY4WxjABChYG1ccLpfkzz", ErrFormat},
+		{"$5$rounds=999$saltstring$5B8vYYiY.CVt1RlTTf8KbXBH3hsxY/GNooZaBBGWEc5", ErrFormat},
+		{"$5$rounds=01000$saltstring$5B8vYYiY.CVt1RlTTf8KbXBH3hsxY/GNooZaBBGWEc5", ErrFormat},
+		{"$6$saltstring$5B8vYYiY.CVt1RlTTf8KbXBH3hsxY/GNooZaBBGWEc5", ErrFormat},
+		{"$y$j75$abcdefghijklmno$MY7LY7iSiXDbIK//WLX8B9MRa5LUgGVUicMJCn3sKE1", ErrFormat},
+		{"$y$j752$abcdefghijklmnop$MY7LY7iSiXDbIK//WLX8B9MRa5LUgGVUicMJCn3sKE1", ErrFormat},
+		{"$y$i75$abcdefghijklmnop$MY7LY7iSiXDbIK//WLX8B9MRa5LUgGVUicMJCn3sKE1", ErrFormat},
+	}
+
+	for _, c := range cases {
+		if err := Verify([]byte("password"), c.encoded); !errors.Is(err, c.expected) {
+			t.Errorf("verification of \"%s\" returned %v, expected %v", c.encoded, err, c.expected)
+		}
+	}
+
+	if _, err := Hash([]byte(strings.Repeat("x", 73)), Bcrypt{Cost: MinBcryptCost}); !errors.Is(err, ErrPasswordTooLong) {
+		t.Errorf("bcrypt of 73 bytes returned %v, expected %v", err, ErrPasswordTooLong)
+	}
+
+	if _, err := New("scrypt", "ln=10,ln=11"); !errors.Is(err, ErrParams) {
+		t.Errorf("repeated setting returned %v, expected %v", err, ErrParams)
+	}
+}
@@ -0,0 +1,77 @@
+package password
+
+import (
+	"fmt"
+	"math"
+	"strings"
+
+	"golang.org/x/crypto/pbkdf2"
+
+	"hashed"
+)
+
+// Pbkdf2 represents the parameters of PBKDF2 password hashing with HMAC of a registered hash type.
+type Pbkdf2 struct {
+	HashType   string
+	Iterations int
+	SaltLength int
+	KeyLength  int
+}
+
+// DefaultPbkdf2 are the parameters of PBKDF2 with HMAC-SHA-256 and 600000 iterations.
+var DefaultPbkdf2 = Pbkdf2{HashType: "sha2-256", Iterations: 600000, SaltLength: 16, KeyLength: 32}
+
+// Scheme returns the identifier of the scheme, pbkdf2- followed by the hash type.
+func (r Pbkdf2) Scheme() string { return "pbkdf2-" + r.HashType }
+
+// private
+
+func (r Pbkdf2) key(password, salt []byte) ([]byte, error) {
+	newHash, err := hashed.HMacHashFunc(r.HashType)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrScheme, err)
+	}
+
+	if r.Iterations < 1 || r.KeyLength < 1 {
+		return nil, fmt.Errorf("%w: i=%d, key length %d", ErrParams, r.Iterations, r.KeyLength)
+	}
+
+	return pbkdf2.Key(password, salt, r.Iterations, r.KeyLength, newHash), nil
+}
+
+func (r Pbkdf2) encode(salt, key []byte) string {
+	return encodePHC(r.Scheme(), "", fmt.Sprintf("i=%d", r.Iterations), salt, key)
+}
+
+func (r Pbkdf2) newSalt() ([]byte, error) { return randomSalt(r.SaltLength) }
+
+// newPbkdf2 returns the parameters of the PBKDF2 scheme of the hash type with the setting i, the hash type is the
+// default one if it is empty.
+func newPbkdf2(hashType string, values settings, saltLength, keyLength int) (Params, error) {
+	p := DefaultPbkdf2
+
+	if hashType != "" {
+		p.HashType = strings.ToLower(hashType)
+	}
+
+	if _, err := hashed.HMacHashFunc(p.HashType); err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrScheme, err)
+	}
+
+	iterations, err := values.take("i", uint64(p.Iterations), 1, math.MaxInt32)
+	if err != nil {
+		return nil, err
+	}
+
+	p.Iterations = int(iterations)
+
+	if saltLength > 0 {
+		p.SaltLength = saltLength
+	}
+
+	if keyLength > 0 {
+		p.KeyLength = keyLength
+	}
+
+	return p, nil
+}
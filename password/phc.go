@@ -0,0 +1,146 @@
+package password
+
+import (
+	"encoding/base64"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"hashed/argon2"
+)
+
+// phcEncoding is the base64 encoding of the salt and the hash of the PHC string format, without padding.
+var phcEncoding = base64.RawStdEncoding
+
+// settings represents the parameters of an encoded hash by their names.
+type settings map[string]uint64
+
+// parseSettings decodes the comma separated name=value pairs of the settings.
+func parseSettings(s string) (settings, error) {
+	values := settings{}
+
+	if s == "" {
+		return values, nil
+	}
+
+	for _, field := range strings.Split(s, ",") {
+		name, value, found := strings.Cut(field, "=")
+		if !found || name == "" {
+			return nil, fmt.Errorf("%w: %s", ErrParams, field)
+		}
+
+		if _, found = values[name]; found {
+			return nil, fmt.Errorf("%w: %s is repeated", ErrParams, name)
+		}
+
+		n, err := strconv.ParseUint(value, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s", ErrParams, field)
+		}
+
+		values[name] = n
+	}
+
+	return values, nil
+}
+
+// take removes the setting of the name and returns its value, or the default value if it is not set.
+// It returns an error if the value is not in the range from low to high.
+func (r settings) take(name string, value, low, high uint64) (uint64, error) {
+	if v, found := r[name]; found {
+		delete(r, name)
+		value = v
+	}
+
+	if value < low || value > high {
+		return 0, fmt.Errorf("%w: %s=%d is out of range [%d, %d]", ErrParams, name, value, low, high)
+	}
+
+	return value, nil
+}
+
+// check returns an error if a setting is left, which is unknown to the scheme.
+func (r settings) check() error {
+	for name := range r {
+		return fmt.Errorf("%w: unknown parameter %s", ErrParams, name)
+	}
+
+	return nil
+}
+
+// parsePHC decodes a hash in the PHC string format, $id[$v=version][$settings]$salt$hash.
+func parsePHC(encoded string) (*Hashed, error) {
+	fields := strings.Split(encoded, "$")
+	if len(fields) < 4 || fields[0] != "" {
+		return nil, ErrFormat
+	}
+
+	id := fields[1]
+	fields = fields[2:]
+
+	version := ""
+	if strings.HasPrefix(fields[0], "v=") {
+		version = strings.TrimPrefix(fields[0], "v=")
+		fields = fields[1:]
+	}
+
+	values := settings{}
+	if len(fields) == 3 {
+		var err error
+		if values, err = parseSettings(fields[0]); err != nil {
+			return nil, err
+		}
+
+		fields = fields[1:]
+	}
+
+	if len(fields) != 2 {
+		return nil, ErrFormat
+	}
+
+	// the version is only defined for Argon2, and bcrypt has its own format
+	if strings.HasPrefix(id, "argon2") {
+		if version != strconv.Itoa(argon2.Version) {
+			return nil, fmt.Errorf("%w: unsupported version %s", ErrFormat, version)
+		}
+	} else if version != "" || id == "bcrypt" {
+		return nil, ErrFormat
+	}
+
+	salt, err := phcEncoding.DecodeString(fields[0])
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrFormat, err)
+	}
+
+	key, err := phcEncoding.DecodeString(fields[1])
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrFormat, err)
+	}
+
+	if len(salt) == 0 || len(key) == 0 {
+		return nil, ErrFormat
+	}
+
+	params, err := newParams(id, values, len(salt), len(key))
+	if err != nil {
+		return nil, err
+	}
+
+	return &Hashed{Params: params, Salt: salt, Key: key}, nil
+}
+
+// encodePHC returns the hash in the PHC string format, the version is omitted if it is empty.
+func encodePHC(id, version, settings string, salt, key []byte) string {
+	var b strings.Builder
+
+	b.WriteString("$" + id)
+	if version != "" {
+		b.WriteString("$v=" + version)
+	}
+
+	b.WriteString("$" + settings)
+	b.WriteString("$" + phcEncoding.EncodeToString(salt))
+	b.WriteString("$" + phcEncoding.EncodeToString(key))
+
+	return b.String()
+}
@@ -0,0 +1,79 @@
+package password
+
+import (
+	"fmt"
+
+	"golang.org/x/crypto/scrypt"
+)
+
+// Scrypt represents the parameters of scrypt password hashing.
+type Scrypt struct {
+	// LogN is the base 2 logarithm of the CPU and memory cost N.
+	LogN uint8
+	// R is the block size.
+	R int
+	// P is the degree of parallelism.
+	P          int
+	SaltLength int
+	KeyLength  int
+}
+
+// DefaultScrypt are the parameters of scrypt with N of 2^15, 32 MiB of memory.
+var DefaultScrypt = Scrypt{LogN: 15, R: 8, P: 1, SaltLength: 16, KeyLength: 32}
+
+// Scheme returns the identifier of the scheme, scrypt.
+func (r Scrypt) Scheme() string { return "scrypt" }
+
+// private
+
+func (r Scrypt) key(password, salt []byte) ([]byte, error) {
+	if r.LogN < 1 || r.LogN > 62 || r.KeyLength < 1 {
+		return nil, fmt.Errorf("%w: ln=%d, key length %d", ErrParams, r.LogN, r.KeyLength)
+	}
+
+	key, err := scrypt.Key(password, salt, 1<<r.LogN, r.R, r.P, r.KeyLength)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrParams, err)
+	}
+
+	return key, nil
+}
+
+func (r Scrypt) encode(salt, key []byte) string {
+	return encodePHC(r.Scheme(), "", fmt.Sprintf("ln=%d,r=%d,p=%d", r.LogN, r.R, r.P), salt, key)
+}
+
+func (r Scrypt) newSalt() ([]byte, error) { return randomSalt(r.SaltLength) }
+
+// newScrypt returns the parameters of the scrypt scheme with the settings ln, r and p.
+func newScrypt(values settings, saltLength, keyLength int) (Params, error) {
+	p := DefaultScrypt
+
+	logN, err := values.take("ln", uint64(p.LogN), 1, 62)
+	if err != nil {
+		return nil, err
+	}
+
+	// the product of r and p is less than 2^30
+	r, err := values.take("r", uint64(p.R), 1, 1<<30-1)
+	if err != nil {
+		return nil, err
+	}
+
+	parallelism, err := values.take("p", uint64(p.P), 1, 1<<30-1)
+	if err != nil {
+		return nil, err
+	}
+
+	p.LogN, p.R, p.P = uint8(logN), int(r), int(parallelism)
+
+	if saltLength > 0 {
+		p.SaltLength = saltLength
+	}
+
+	if keyLength > 0 {
+		p.KeyLength = keyLength
+	}
+
+	return p, nil
+}
@@ -0,0 +1,244 @@
+package password
+
+import (
+	"bytes"
+	"fmt"
+	"hash"
+	"strconv"
+	"strings"
+
+	"hashed"
+)
+
+const (
+	// MinShaCryptRounds is the minimum number of rounds of SHA crypt.
+	MinShaCryptRounds = 1000
+	// MaxShaCryptRounds is the maximum number of rounds of SHA crypt.
+	MaxShaCryptRounds = 999999999
+	// shaCryptRounds is the default number of rounds of SHA crypt, which is not encoded.
+	shaCryptRounds = 5000
+	// shaCryptSaltLength is the maximum length of the salt of SHA crypt in characters.
+	shaCryptSaltLength = 16
+)
+
+var (
+	// sha256CryptOrder is the order of the bytes of the hash of SHA-256 crypt in its encoding.
+	sha256CryptOrder = []int{
+		0, 10, 20, 21, 1, 11, 12, 22, 2, 3, 13, 23, 24, 4, 14, 15, 25, 5, 6, 16, 26, 27, 7, 17, 18, 28, 8, 9, 19, 29,
+		31, 30,
+	}
+	// sha512CryptOrder is the order of the bytes of the hash of SHA-512 crypt in its encoding.
+	sha512CryptOrder = []int{
+		0, 21, 42, 22, 43, 1, 44, 2, 23, 3, 24, 45, 25, 46, 4, 47, 5, 26, 6, 27, 48, 28, 49, 7, 50, 8, 29, 9, 30, 51,
+		31, 52, 10, 53, 11, 32, 12, 33, 54, 34, 55, 13, 56, 14, 35, 15, 36, 57, 37, 58, 16, 59, 17, 38, 18, 39, 60,
+		40, 61, 19, 62, 20, 41, 63,
+	}
+)
+
+// ShaCrypt represents the parameters of the SHA-256 and SHA-512 based crypt(3) of Ulrich Drepper, $5$ and $6$.
+type ShaCrypt struct {
+	// Size is the size of SHA-2 in bits, 256 or 512.
+	Size int
+	// Rounds is the number of rounds, zero for the default 5000 rounds which are not encoded.
+	Rounds int
+}
+
+// DefaultShaCrypt are the parameters of SHA-512 crypt with the default 5000 rounds.
+var DefaultShaCrypt = ShaCrypt{Size: 512}
+
+// Scheme returns the identifier of the scheme, sha256-crypt or sha512-crypt.
+func (r ShaCrypt) Scheme() string { return fmt.Sprintf("sha%d-crypt", r.Size) }
+
+// private
+
+// key returns the hash of SHA crypt, the initial hash of the password and the salt is mixed in the rounds with
+// the sequences of the same lengths as the password and the salt.
+func (r ShaCrypt) key(password, salt []byte) ([]byte, error) {
+	newHash, _, err := r.sha()
+	if err != nil {
+		return nil, err
+	}
+
+	rounds := r.rounds()
+	if rounds < MinShaCryptRounds || rounds > MaxShaCryptRounds {
+		return nil, fmt.Errorf("%w: rounds=%d", ErrParams, rounds)
+	}
+
+	if len(salt) > shaCryptSaltLength {
+		return nil, fmt.Errorf("%w: salt length %d", ErrParams, len(salt))
+	}
+
+	h := newHash()
+	write(h, password, salt, password)
+	alternate := h.Sum(nil)
+
+	h.Reset()
+	write(h, password, salt)
+
+	for n := len(password); n > 0; n -= len(alternate) {
+		write(h, alternate[:min(n, len(alternate))])
+	}
+
+	// the bits of the length of the password select the alternate hash or the password
+	for n := len(password); n > 0; n >>= 1 {
+		if n&1 != 0 {
+			write(h, alternate)
+		} else {
+			write(h, password)
+		}
+	}
+
+	sum := h.Sum(nil)
+
+	// the sequences are the hashes of the password repeated by its length and of the salt repeated by 16 plus
+	// the first byte of the sum, both cut to the lengths of the password and the salt
+	h.Reset()
+	for range password {
+		write(h, password)
+	}
+
+	p := repeat(h.Sum(nil), len(password))
+
+	h.Reset()
+	for i := 0; i < 16+int(sum[0]); i++ {
+		write(h, salt)
+	}
+
+	s := repeat(h.Sum(nil), len(salt))
+
+	for i := 0; i < rounds; i++ {
+		h.Reset()
+
+		if i&1 != 0 {
+			write(h, p)
+		} else {
+			write(h, sum)
+		}
+
+		if i%3 != 0 {
+			write(h, s)
+		}
+
+		if i%7 != 0 {
+			write(h, p)
+		}
+
+		if i&1 != 0 {
+			write(h, sum)
+		} else {
+			write(h, p)
+		}
+
+		sum = h.Sum(sum[:0])
+	}
+
+	return sum, nil
+}
+
+func (r ShaCrypt) encode(salt, key []byte) string {
+	var b strings.Builder
+
+	b.WriteString(r.prefix())
+	if r.Rounds != 0 {
+		b.WriteString(fmt.Sprintf("rounds=%d$", r.Rounds))
+	}
+
+	_, order, _ := r.sha()
+
+	b.Write(salt)
+	b.WriteString("$" + encodeCrypt(key, order))
+
+	return b.String()
+}
+
+func (r ShaCrypt) newSalt() ([]byte, error) { return cryptSalt(shaCryptSaltLength) }
+
+// sha returns the constructor of SHA-2 of the size and the order of the bytes of its hash in the encoding.
+func (r ShaCrypt) sha() (func() hash.Hash, []int, error) {
+	switch r.Size {
+	case 256:
+		return hashed.Sha2Type256, sha256CryptOrder, nil
+	case 512:
+		return hashed.Sha2Type256Length512, sha512CryptOrder, nil
+	}
+
+	return nil, nil, fmt.Errorf("%w: size %d", ErrParams, r.Size)
+}
+
+// rounds returns the number of rounds, the default one if it is zero.
+func (r ShaCrypt) rounds() int {
+	if r.Rounds == 0 {
+		return shaCryptRounds
+	}
+
+	return r.Rounds
+}
+
+// prefix returns the prefix of the encoded hash, $5$ or $6$.
+func (r ShaCrypt) prefix() string {
+	if r.Size == 256 {
+		return "$5$"
+	}
+
+	return "$6$"
+}
+
+// newShaCrypt returns the parameters of the SHA crypt scheme of the size with the setting rounds.
+func newShaCrypt(size int, values settings) (Params, error) {
+	p := ShaCrypt{Size: size}
+
+	if _, found := values["rounds"]; found {
+		rounds, err := values.take("rounds", 0, MinShaCryptRounds, MaxShaCryptRounds)
+		if err != nil {
+			return nil, err
+		}
+
+		p.Rounds = int(rounds)
+	}
+
+	return p, nil
+}
+
+// parseShaCrypt decodes a hash of SHA crypt, $5$ or $6$ followed by the optional rounds=number$, up to 16 characters
+// of the salt, $ and 43 or 86 characters of the hash.
+func parseShaCrypt(encoded string) (*Hashed, error) {
+	params := ShaCrypt{Size: 256}
+	if strings.HasPrefix(encoded, "$6$") {
+		params.Size = 512
+	}
+
+	rest := encoded[len(params.prefix()):]
+	if value, found := strings.CutPrefix(rest, "rounds="); found {
+		value, rest, found = strings.Cut(value, "$")
+
+		rounds, err := strconv.Atoi(value)
+		if !found || err != nil || strconv.Itoa(rounds) != value {
+			return nil, ErrFormat
+		}
+
+		if rounds < MinShaCryptRounds || rounds > MaxShaCryptRounds {
+			return nil, fmt.Errorf("%w: rounds=%d is out of range", ErrFormat, rounds)
+		}
+
+		params.Rounds = rounds
+	}
+
+	salt, hash, found := strings.Cut(rest, "$")
+	if !found || len(salt) > shaCryptSaltLength {
+		return nil, ErrFormat
+	}
+
+	_, order, _ := params.sha()
+
+	key, err := decodeCrypt(hash, order)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Hashed{Params: params, Salt: []byte(salt), Key: key}, nil
+}
+
+// repeat returns b repeated to the length.
+func repeat(b []byte, length int) []byte {
+	return bytes.Repeat(b, length/len(b)+1)[:length]
+}
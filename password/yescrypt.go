@@ -0,0 +1,273 @@
+package password
+
+import (
+	"fmt"
+	"math"
+	"strings"
+
+	"hashed/yescrypt"
+)
+
+// yescryptKeyLength is the length of the hash of yescrypt in bytes.
+const yescryptKeyLength = 32
+
+// Yescrypt represents the parameters of yescrypt password hashing in the $y$ format of libxcrypt.
+type Yescrypt struct {
+	Flags yescrypt.Flags
+	// LogN is the base 2 logarithm of the number of blocks N.
+	LogN uint8
+	// R is the block size.
+	R uint32
+	// P is the degree of parallelism.
+	P uint32
+	// T is the time parameter.
+	T          uint32
+	SaltLength int
+}
+
+// DefaultYescrypt are the parameters of yescrypt of the default cost of libxcrypt, N of 2^12 and r of 32,
+// 16 MiB of memory.
+var DefaultYescrypt = Yescrypt{Flags: yescrypt.RW, LogN: 12, R: 32, P: 1, SaltLength: 16}
+
+// Scheme returns the identifier of the scheme, yescrypt.
+func (r Yescrypt) Scheme() string { return "yescrypt" }
+
+// private
+
+func (r Yescrypt) key(password, salt []byte) ([]byte, error) {
+	if r.LogN < 1 || r.LogN > 63 || r.T >= 1<<30 {
+		return nil, fmt.Errorf("%w: ln=%d, t=%d", ErrParams, r.LogN, r.T)
+	}
+
+	return yescrypt.Key(password, salt, &yescrypt.Params{
+		Flags:     r.Flags,
+		N:         1 << r.LogN,
+		R:         r.R,
+		P:         r.P,
+		T:         r.T,
+		KeyLength: yescryptKeyLength,
+	})
+}
+
+// encode returns the hash in the $y$ format, the flavor, N and r are followed by the flags of the optional p and t
+// and their values, and the salt and the hash are encoded as little-endian numbers of three bytes.
+func (r Yescrypt) encode(salt, key []byte) string {
+	var b strings.Builder
+
+	// the flavors of the read-write mode are 2 plus its flags without the mode
+	flavor := uint32(r.Flags)
+	if r.Flags > yescrypt.WORM {
+		flavor = 2 + uint32(r.Flags)>>2
+	}
+
+	b.WriteString("$y$")
+	b.WriteString(encodeYescryptValue(flavor, 0))
+	b.WriteString(encodeYescryptValue(uint32(r.LogN), 1))
+	b.WriteString(encodeYescryptValue(r.R, 1))
+
+	var have uint32
+	if r.P != 1 {
+		have |= 1
+	}
+
+	if r.T != 0 {
+		have |= 2
+	}
+
+	if have != 0 {
+		b.WriteString(encodeYescryptValue(have, 1))
+	}
+
+	if r.P != 1 {
+		b.WriteString(encodeYescryptValue(r.P, 2))
+	}
+
+	if r.T != 0 {
+		b.WriteString(encodeYescryptValue(r.T, 1))
+	}
+
+	b.WriteString("$" + encodeCrypt(salt, littleEndianOrder(len(salt))))
+	b.WriteString("$" + encodeCrypt(key, littleEndianOrder(len(key))))
+
+	return b.String()
+}
+
+func (r Yescrypt) newSalt() ([]byte, error) { return randomSalt(r.SaltLength) }
+
+// newYescrypt returns the parameters of the yescrypt scheme with the settings ln, r, p and t.
+func newYescrypt(values settings) (Params, error) {
+	p := DefaultYescrypt
+
+	logN, err := values.take("ln", uint64(p.LogN), 1, 63)
+	if err != nil {
+		return nil, err
+	}
+
+	// the product of r and p is less than 2^30
+	r, err := values.take("r", uint64(p.R), 1, 1<<30-1)
+	if err != nil {
+		return nil, err
+	}
+
+	parallelism, err := values.take("p", uint64(p.P), 1, 1<<30-1)
+	if err != nil {
+		return nil, err
+	}
+
+	time, err := values.take("t", uint64(p.T), 0, 1<<30-1)
+	if err != nil {
+		return nil, err
+	}
+
+	p.LogN, p.R, p.P, p.T = uint8(logN), uint32(r), uint32(parallelism), uint32(time)
+
+	return p, nil
+}
+
+// parseYescrypt decodes a hash in the $y$ format, the ROM and the hash upgrades are not supported.
+func parseYescrypt(encoded string) (*Hashed, error) {
+	fields := strings.Split(encoded, "$")
+	if len(fields) != 5 {
+		return nil, ErrFormat
+	}
+
+	s := fields[2]
+	params := Yescrypt{P: 1}
+
+	flavor, s, err := decodeYescryptValue(s, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	params.Flags = yescrypt.Flags(flavor)
+	if params.Flags > yescrypt.WORM {
+		params.Flags = 2 + yescrypt.Flags(flavor-2)<<2
+	}
+
+	if params.Flags != yescrypt.Scrypt && params.Flags != yescrypt.WORM && params.Flags != yescrypt.RW {
+		return nil, fmt.Errorf("%w: unsupported yescrypt flavor %d", ErrFormat, flavor)
+	}
+
+	logN, s, err := decodeYescryptValue(s, 1)
+	if err != nil || logN > 63 {
+		return nil, ErrFormat
+	}
+
+	if params.R, s, err = decodeYescryptValue(s, 1); err != nil {
+		return nil, err
+	}
+
+	if s != "" {
+		var have uint32
+		if have, s, err = decodeYescryptValue(s, 1); err != nil {
+			return nil, err
+		}
+
+		if have&^3 != 0 {
+			return nil, fmt.Errorf("%w: unsupported yescrypt parameters %d", ErrFormat, have)
+		}
+
+		if have&1 != 0 {
+			if params.P, s, err = decodeYescryptValue(s, 2); err != nil {
+				return nil, err
+			}
+		}
+
+		if have&2 != 0 {
+			if params.T, s, err = decodeYescryptValue(s, 1); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if s != "" {
+		return nil, ErrFormat
+	}
+
+	params.LogN = uint8(logN)
+
+	saltLength := cryptLength(len(fields[3]))
+	if saltLength < 0 {
+		return nil, ErrFormat
+	}
+
+	salt, err := decodeCrypt(fields[3], littleEndianOrder(saltLength))
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := decodeCrypt(fields[4], littleEndianOrder(yescryptKeyLength))
+	if err != nil {
+		return nil, err
+	}
+
+	params.SaltLength = len(salt)
+
+	return &Hashed{Params: params, Salt: salt, Key: key}, nil
+}
+
+// encodeYescryptValue returns the variable-length encoding of the value, which is not less than low, of the
+// parameters of the $y$ format. The first character selects a range of the values by its position in the alphabet,
+// the ranges are longer for the later positions, and the next characters are the offset in the range.
+func encodeYescryptValue(value, low uint32) string {
+	v := uint64(value - low)
+	start, end, bits := 0, 47, 0
+
+	for start < 63 && v >= uint64(end+1-start)<<bits {
+		v -= uint64(end+1-start) << bits
+		start, end = end+1, end+1+(62-end)/2
+		bits += 6
+	}
+
+	s := []byte{cryptAlphabet[start+int(v>>bits)]}
+	for bits > 0 {
+		bits -= 6
+		s = append(s, cryptAlphabet[v>>bits&0x3f])
+	}
+
+	return string(s)
+}
+
+// decodeYescryptValue decodes a value encoded by encodeYescryptValue from the start of s, and returns the rest of s.
+func decodeYescryptValue(s string, low uint32) (uint32, string, error) {
+	if s == "" {
+		return 0, "", ErrFormat
+	}
+
+	c := strings.IndexByte(cryptAlphabet, s[0])
+	if c < 0 {
+		return 0, "", ErrFormat
+	}
+
+	v := uint64(low)
+	start, end, chars, bits := 0, 47, 1, 0
+
+	for c > end {
+		v += uint64(end+1-start) << bits
+		start, end = end+1, end+1+(62-end)/2
+		chars++
+		bits += 6
+	}
+
+	v += uint64(c-start) << bits
+
+	if len(s) < chars {
+		return 0, "", ErrFormat
+	}
+
+	for i := 1; i < chars; i++ {
+		c = strings.IndexByte(cryptAlphabet, s[i])
+		if c < 0 {
+			return 0, "", ErrFormat
+		}
+
+		bits -= 6
+		v += uint64(c) << bits
+	}
+
+	if v > math.MaxUint32 {
+		return 0, "", ErrFormat
+	}
+
+	return uint32(v), s[chars:], nil
+}
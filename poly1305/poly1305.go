@@ -0,0 +1,168 @@
+// Package poly1305 implements the Poly1305 one-time authenticator of RFC 8439.
+//
+// The key must be used for one message only, like the keys derived by ChaCha20 or AES for every nonce, otherwise
+// the forgery of the tags of other messages is trivial.
+package poly1305
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash"
+	"math/bits"
+)
+
+const (
+	// KeySize is the size of a Poly1305 key in bytes, the 16 bytes of r and the 16 bytes of s.
+	KeySize = 32
+	// Size is the size of a Poly1305 tag in bytes.
+	Size = 16
+	// BlockSize is the block size of Poly1305 in bytes.
+	BlockSize = 16
+
+	// the clamping masks of the two halves of r
+	rMask0 = 0x0ffffffc0fffffff
+	rMask1 = 0x0ffffffc0ffffffc
+)
+
+// ErrKeySize is returned when the key is not 32 bytes.
+var ErrKeySize = errors.New("poly1305: invalid key size")
+
+// model represents a structure for the Poly1305 hash.Hash.
+type model struct {
+	r      [2]uint64
+	s      [2]uint64
+	h      [3]uint64
+	buf    [BlockSize]byte
+	bufLen int
+}
+
+// New creates a new Poly1305 hash.Hash with the one-time key of 32 bytes.
+func New(key []byte) (hash.Hash, error) {
+	if len(key) != KeySize {
+		return nil, fmt.Errorf("%w: %d bytes instead of %d", ErrKeySize, len(key), KeySize)
+	}
+
+	r := &model{}
+	r.r[0] = binary.LittleEndian.Uint64(key[0:]) & rMask0
+	r.r[1] = binary.LittleEndian.Uint64(key[8:]) & rMask1
+	r.s[0] = binary.LittleEndian.Uint64(key[16:])
+	r.s[1] = binary.LittleEndian.Uint64(key[24:])
+
+	return r, nil
+}
+
+// implementation of the hash.Hash
+
+// Reset resets the hash.Hash to its initial state.
+func (r *model) Reset() {
+	r.h = [3]uint64{}
+	r.bufLen = 0
+}
+
+// Size returns the number of bytes Sum will return.
+func (r *model) Size() int { return Size }
+
+// BlockSize returns the hash's underlying block size.
+func (r *model) BlockSize() int { return BlockSize }
+
+// Write appends the data to the digest.
+func (r *model) Write(p []byte) (int, error) {
+	n := len(p)
+
+	if r.bufLen > 0 {
+		x := copy(r.buf[r.bufLen:], p)
+		r.bufLen += x
+		p = p[x:]
+
+		if r.bufLen < BlockSize {
+			return n, nil
+		}
+
+		r.h = r.block(r.h, r.buf[:], 1)
+		r.bufLen = 0
+	}
+
+	for ; len(p) >= BlockSize; p = p[BlockSize:] {
+		r.h = r.block(r.h, p, 1)
+	}
+
+	r.bufLen = copy(r.buf[:], p)
+
+	return n, nil
+}
+
+// Sum appends the current checksum to b and returns the resulting slice, it does not change the underlying state.
+func (r *model) Sum(b []byte) []byte {
+	h := r.h
+
+	// the last partial block is padded by a byte 1 instead of the bit 2^128 of the full blocks
+	if r.bufLen > 0 {
+		var last [BlockSize]byte
+		copy(last[:], r.buf[:r.bufLen])
+		last[r.bufLen] = 1
+		h = r.block(h, last[:], 0)
+	}
+
+	// h is reduced modulo 2^130 - 5 by subtracting it if h + 5 carries into 2^130, selected by the mask of the borrow
+	t0, borrow := bits.Sub64(h[0], 0xfffffffffffffffb, 0)
+	t1, borrow := bits.Sub64(h[1], 0xffffffffffffffff, borrow)
+	_, borrow = bits.Sub64(h[2], 3, borrow)
+
+	mask := borrow - 1
+	h0 := h[0]&^mask | t0&mask
+	h1 := h[1]&^mask | t1&mask
+
+	h0, carry := bits.Add64(h0, r.s[0], 0)
+	h1, _ = bits.Add64(h1, r.s[1], carry)
+
+	var tag [Size]byte
+	binary.LittleEndian.PutUint64(tag[0:], h0)
+	binary.LittleEndian.PutUint64(tag[8:], h1)
+
+	return append(b, tag[:]...)
+}
+
+// private
+
+// block returns the accumulator h after adding the block with the high bit and multiplying by r, partially reduced
+// modulo 2^130 - 5 so that h[2] is a few bits only.
+func (r *model) block(h [3]uint64, block []byte, high uint64) [3]uint64 {
+	var carry uint64
+	h[0], carry = bits.Add64(h[0], binary.LittleEndian.Uint64(block[0:]), 0)
+	h[1], carry = bits.Add64(h[1], binary.LittleEndian.Uint64(block[8:]), carry)
+	h[2] += carry + high
+
+	// the product of h and r is of 4 words m0 to m3, r is small enough for the sums of the products not to overflow
+	m0hi, m0lo := bits.Mul64(h[0], r.r[0])
+	h1r0hi, h1r0lo := bits.Mul64(h[1], r.r[0])
+	h0r1hi, h0r1lo := bits.Mul64(h[0], r.r[1])
+	h1r1hi, h1r1lo := bits.Mul64(h[1], r.r[1])
+
+	m1lo, c := bits.Add64(h1r0lo, h0r1lo, 0)
+	m1hi := h1r0hi + h0r1hi + c
+	m2lo, c := bits.Add64(h1r1lo, h[2]*r.r[0], 0)
+	m2hi := h1r1hi + c
+	m3 := h[2] * r.r[1]
+
+	t0 := m0lo
+	t1, c := bits.Add64(m1lo, m0hi, 0)
+	t2, c := bits.Add64(m2lo, m1hi, c)
+	t3 := m3 + m2hi + c
+
+	// the bits from 2^130 are multiplied by 5 as 4 and 1 times, since 2^130 is 5 modulo 2^130 - 5
+	h = [3]uint64{t0, t1, t2 & 3}
+	cc0, cc1 := t2&^3, t3
+
+	h[0], c = bits.Add64(h[0], cc0, 0)
+	h[1], c = bits.Add64(h[1], cc1, c)
+	h[2] += c
+
+	cc0, cc1 = cc0>>2|cc1<<62, cc1>>2
+
+	h[0], c = bits.Add64(h[0], cc0, 0)
+	h[1], c = bits.Add64(h[1], cc1, c)
+	h[2] += c
+
+	return h
+}
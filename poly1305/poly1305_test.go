@@ -0,0 +1,84 @@
+package poly1305
+
+import (
+	"encoding/hex"
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestVectors(t *testing.T) {
+	// the example of the section 2.5.2 and the test vectors of the appendix A.3 of RFC 8439
+	vectors := []struct {
+		key      string
+		msg      string
+		expected string
+	}{
+		{
+			"85d6be7857556d337f4452fe42d506a80103808afb0db2fd4abff6af4149f51b",
+			hex.EncodeToString([]byte("Cryptographic Forum Research Group")),
+			"a8061dc1305136c6c22b8baf0c0127a9",
+		},
+		{strings.Repeat("00", 32), strings.Repeat("00", 64), "00000000000000000000000000000000"},
+		{
+			"02" + strings.Repeat("00", 31),
+			"ffffffffffffffffffffffffffffffff",
+			"03000000000000000000000000000000",
+		},
+		{
+			"02" + strings.Repeat("00", 15) + strings.Repeat("ff", 16),
+			"02000000000000000000000000000000",
+			"03000000000000000000000000000000",
+		},
+		{
+			"01" + strings.Repeat("00", 31),
+			"ffffffffffffffffffffffffffffffff" + "f0ffffffffffffffffffffffffffffff" + "11000000000000000000000000000000",
+			"05000000000000000000000000000000",
+		},
+		{
+			"01" + strings.Repeat("00", 31),
+			"ffffffffffffffffffffffffffffffff" + "fbfefefefefefefefefefefefefefefe" + "01010101010101010101010101010101",
+			"00000000000000000000000000000000",
+		},
+		{
+			"02" + strings.Repeat("00", 31),
+			"fdffffffffffffffffffffffffffffff",
+			"faffffffffffffffffffffffffffffff",
+		},
+	}
+
+	for _, v := range vectors {
+		key, _ := hex.DecodeString(v.key)
+		msg, _ := hex.DecodeString(v.msg)
+
+		h, err := New(key)
+		if err != nil {
+			t.Fatalf("key %s failed: %s", v.key, err)
+		}
+
+		h.Write(msg[:len(msg)/3])
+		h.Write(msg[len(msg)/3:])
+
+		if got := hex.EncodeToString(h.Sum(nil)); got != v.expected {
+			t.Errorf("Poly1305 of %s is wrong:\n\texpected \"%s\"\n\tgot \"%s\"", v.msg, v.expected, got)
+		}
+	}
+}
+
+func TestErrors(t *testing.T) {
+	if _, err := New(make([]byte, 16)); !errors.Is(err, ErrKeySize) {
+		t.Errorf("short key is accepted: %v", err)
+	}
+}
+
+func BenchmarkPoly1305(b *testing.B) {
+	h, _ := New(make([]byte, KeySize))
+	data := make([]byte, 8192)
+
+	b.SetBytes(int64(len(data)))
+	for i := 0; i < b.N; i++ {
+		h.Reset()
+		h.Write(data)
+		h.Sum(nil)
+	}
+}
@@ -0,0 +1,1082 @@
+// Code generated by gen.go. DO NOT EDIT.
+
+package ripemd
+
+import (
+	"encoding/binary"
+	"math/bits"
+)
+
+// compress128 runs the RIPEMD-128 compression function on every full block of p.
+func compress128(s *[10]uint32, p []byte) {
+	for len(p) >= blockSize {
+		x0 := binary.LittleEndian.Uint32(p[0:])
+		x1 := binary.LittleEndian.Uint32(p[4:])
+		x2 := binary.LittleEndian.Uint32(p[8:])
+		x3 := binary.LittleEndian.Uint32(p[12:])
+		x4 := binary.LittleEndian.Uint32(p[16:])
+		x5 := binary.LittleEndian.Uint32(p[20:])
+		x6 := binary.LittleEndian.Uint32(p[24:])
+		x7 := binary.LittleEndian.Uint32(p[28:])
+		x8 := binary.LittleEndian.Uint32(p[32:])
+		x9 := binary.LittleEndian.Uint32(p[36:])
+		x10 := binary.LittleEndian.Uint32(p[40:])
+		x11 := binary.LittleEndian.Uint32(p[44:])
+		x12 := binary.LittleEndian.Uint32(p[48:])
+		x13 := binary.LittleEndian.Uint32(p[52:])
+		x14 := binary.LittleEndian.Uint32(p[56:])
+		x15 := binary.LittleEndian.Uint32(p[60:])
+
+		a1, a2 := s[0], s[0]
+		b1, b2 := s[1], s[1]
+		c1, c2 := s[2], s[2]
+		d1, d2 := s[3], s[3]
+
+		// round 1
+		a1 = bits.RotateLeft32(a1+(b1^c1^d1)+x0, 11)
+		a2 = bits.RotateLeft32(a2+(c2^(d2&(b2^c2)))+x5+0x50a28be6, 8)
+		d1 = bits.RotateLeft32(d1+(a1^b1^c1)+x1, 14)
+		d2 = bits.RotateLeft32(d2+(b2^(c2&(a2^b2)))+x14+0x50a28be6, 9)
+		c1 = bits.RotateLeft32(c1+(d1^a1^b1)+x2, 15)
+		c2 = bits.RotateLeft32(c2+(a2^(b2&(d2^a2)))+x7+0x50a28be6, 9)
+		b1 = bits.RotateLeft32(b1+(c1^d1^a1)+x3, 12)
+		b2 = bits.RotateLeft32(b2+(d2^(a2&(c2^d2)))+x0+0x50a28be6, 11)
+		a1 = bits.RotateLeft32(a1+(b1^c1^d1)+x4, 5)
+		a2 = bits.RotateLeft32(a2+(c2^(d2&(b2^c2)))+x9+0x50a28be6, 13)
+		d1 = bits.RotateLeft32(d1+(a1^b1^c1)+x5, 8)
+		d2 = bits.RotateLeft32(d2+(b2^(c2&(a2^b2)))+x2+0x50a28be6, 15)
+		c1 = bits.RotateLeft32(c1+(d1^a1^b1)+x6, 7)
+		c2 = bits.RotateLeft32(c2+(a2^(b2&(d2^a2)))+x11+0x50a28be6, 15)
+		b1 = bits.RotateLeft32(b1+(c1^d1^a1)+x7, 9)
+		b2 = bits.RotateLeft32(b2+(d2^(a2&(c2^d2)))+x4+0x50a28be6, 5)
+		a1 = bits.RotateLeft32(a1+(b1^c1^d1)+x8, 11)
+		a2 = bits.RotateLeft32(a2+(c2^(d2&(b2^c2)))+x13+0x50a28be6, 7)
+		d1 = bits.RotateLeft32(d1+(a1^b1^c1)+x9, 13)
+		d2 = bits.RotateLeft32(d2+(b2^(c2&(a2^b2)))+x6+0x50a28be6, 7)
+		c1 = bits.RotateLeft32(c1+(d1^a1^b1)+x10, 14)
+		c2 = bits.RotateLeft32(c2+(a2^(b2&(d2^a2)))+x15+0x50a28be6, 8)
+		b1 = bits.RotateLeft32(b1+(c1^d1^a1)+x11, 15)
+		b2 = bits.RotateLeft32(b2+(d2^(a2&(c2^d2)))+x8+0x50a28be6, 11)
+		a1 = bits.RotateLeft32(a1+(b1^c1^d1)+x12, 6)
+		a2 = bits.RotateLeft32(a2+(c2^(d2&(b2^c2)))+x1+0x50a28be6, 14)
+		d1 = bits.RotateLeft32(d1+(a1^b1^c1)+x13, 7)
+		d2 = bits.RotateLeft32(d2+(b2^(c2&(a2^b2)))+x10+0x50a28be6, 14)
+		c1 = bits.RotateLeft32(c1+(d1^a1^b1)+x14, 9)
+		c2 = bits.RotateLeft32(c2+(a2^(b2&(d2^a2)))+x3+0x50a28be6, 12)
+		b1 = bits.RotateLeft32(b1+(c1^d1^a1)+x15, 8)
+		b2 = bits.RotateLeft32(b2+(d2^(a2&(c2^d2)))+x12+0x50a28be6, 6)
+
+		// round 2
+		a1 = bits.RotateLeft32(a1+(d1^(b1&(c1^d1)))+x7+0x5a827999, 7)
+		a2 = bits.RotateLeft32(a2+((b2|^c2)^d2)+x6+0x5c4dd124, 9)
+		d1 = bits.RotateLeft32(d1+(c1^(a1&(b1^c1)))+x4+0x5a827999, 6)
+		d2 = bits.RotateLeft32(d2+((a2|^b2)^c2)+x11+0x5c4dd124, 13)
+		c1 = bits.RotateLeft32(c1+(b1^(d1&(a1^b1)))+x13+0x5a827999, 8)
+		c2 = bits.RotateLeft32(c2+((d2|^a2)^b2)+x3+0x5c4dd124, 15)
+		b1 = bits.RotateLeft32(b1+(a1^(c1&(d1^a1)))+x1+0x5a827999, 13)
+		b2 = bits.RotateLeft32(b2+((c2|^d2)^a2)+x7+0x5c4dd124, 7)
+		a1 = bits.RotateLeft32(a1+(d1^(b1&(c1^d1)))+x10+0x5a827999, 11)
+		a2 = bits.RotateLeft32(a2+((b2|^c2)^d2)+x0+0x5c4dd124, 12)
+		d1 = bits.RotateLeft32(d1+(c1^(a1&(b1^c1)))+x6+0x5a827999, 9)
+		d2 = bits.RotateLeft32(d2+((a2|^b2)^c2)+x13+0x5c4dd124, 8)
+		c1 = bits.RotateLeft32(c1+(b1^(d1&(a1^b1)))+x15+0x5a827999, 7)
+		c2 = bits.RotateLeft32(c2+((d2|^a2)^b2)+x5+0x5c4dd124, 9)
+		b1 = bits.RotateLeft32(b1+(a1^(c1&(d1^a1)))+x3+0x5a827999, 15)
+		b2 = bits.RotateLeft32(b2+((c2|^d2)^a2)+x10+0x5c4dd124, 11)
+		a1 = bits.RotateLeft32(a1+(d1^(b1&(c1^d1)))+x12+0x5a827999, 7)
+		a2 = bits.RotateLeft32(a2+((b2|^c2)^d2)+x14+0x5c4dd124, 7)
+		d1 = bits.RotateLeft32(d1+(c1^(a1&(b1^c1)))+x0+0x5a827999, 12)
+		d2 = bits.RotateLeft32(d2+((a2|^b2)^c2)+x15+0x5c4dd124, 7)
+		c1 = bits.RotateLeft32(c1+(b1^(d1&(a1^b1)))+x9+0x5a827999, 15)
+		c2 = bits.RotateLeft32(c2+((d2|^a2)^b2)+x8+0x5c4dd124, 12)
+		b1 = bits.RotateLeft32(b1+(a1^(c1&(d1^a1)))+x5+0x5a827999, 9)
+		b2 = bits.RotateLeft32(b2+((c2|^d2)^a2)+x12+0x5c4dd124, 7)
+		a1 = bits.RotateLeft32(a1+(d1^(b1&(c1^d1)))+x2+0x5a827999, 11)
+		a2 = bits.RotateLeft32(a2+((b2|^c2)^d2)+x4+0x5c4dd124, 6)
+		d1 = bits.RotateLeft32(d1+(c1^(a1&(b1^c1)))+x14+0x5a827999, 7)
+		d2 = bits.RotateLeft32(d2+((a2|^b2)^c2)+x9+0x5c4dd124, 15)
+		c1 = bits.RotateLeft32(c1+(b1^(d1&(a1^b1)))+x11+0x5a827999, 13)
+		c2 = bits.RotateLeft32(c2+((d2|^a2)^b2)+x1+0x5c4dd124, 13)
+		b1 = bits.RotateLeft32(b1+(a1^(c1&(d1^a1)))+x8+0x5a827999, 12)
+		b2 = bits.RotateLeft32(b2+((c2|^d2)^a2)+x2+0x5c4dd124, 11)
+
+		// round 3
+		a1 = bits.RotateLeft32(a1+((b1|^c1)^d1)+x3+0x6ed9eba1, 11)
+		a2 = bits.RotateLeft32(a2+(d2^(b2&(c2^d2)))+x15+0x6d703ef3, 9)
+		d1 = bits.RotateLeft32(d1+((a1|^b1)^c1)+x10+0x6ed9eba1, 13)
+		d2 = bits.RotateLeft32(d2+(c2^(a2&(b2^c2)))+x5+0x6d703ef3, 7)
+		c1 = bits.RotateLeft32(c1+((d1|^a1)^b1)+x14+0x6ed9eba1, 6)
+		c2 = bits.RotateLeft32(c2+(b2^(d2&(a2^b2)))+x1+0x6d703ef3, 15)
+		b1 = bits.RotateLeft32(b1+((c1|^d1)^a1)+x4+0x6ed9eba1, 7)
+		b2 = bits.RotateLeft32(b2+(a2^(c2&(d2^a2)))+x3+0x6d703ef3, 11)
+		a1 = bits.RotateLeft32(a1+((b1|^c1)^d1)+x9+0x6ed9eba1, 14)
+		a2 = bits.RotateLeft32(a2+(d2^(b2&(c2^d2)))+x7+0x6d703ef3, 8)
+		d1 = bits.RotateLeft32(d1+((a1|^b1)^c1)+x15+0x6ed9eba1, 9)
+		d2 = bits.RotateLeft32(d2+(c2^(a2&(b2^c2)))+x14+0x6d703ef3, 6)
+		c1 = bits.RotateLeft32(c1+((d1|^a1)^b1)+x8+0x6ed9eba1, 13)
+		c2 = bits.RotateLeft32(c2+(b2^(d2&(a2^b2)))+x6+0x6d703ef3, 6)
+		b1 = bits.RotateLeft32(b1+((c1|^d1)^a1)+x1+0x6ed9eba1, 15)
+		b2 = bits.RotateLeft32(b2+(a2^(c2&(d2^a2)))+x9+0x6d703ef3, 14)
+		a1 = bits.RotateLeft32(a1+((b1|^c1)^d1)+x2+0x6ed9eba1, 14)
+		a2 = bits.RotateLeft32(a2+(d2^(b2&(c2^d2)))+x11+0x6d703ef3, 12)
+		d1 = bits.RotateLeft32(d1+((a1|^b1)^c1)+x7+0x6ed9eba1, 8)
+		d2 = bits.RotateLeft32(d2+(c2^(a2&(b2^c2)))+x8+0x6d703ef3, 13)
+		c1 = bits.RotateLeft32(c1+((d1|^a1)^b1)+x0+0x6ed9eba1, 13)
+		c2 = bits.RotateLeft32(c2+(b2^(d2&(a2^b2)))+x12+0x6d703ef3, 5)
+		b1 = bits.RotateLeft32(b1+((c1|^d1)^a1)+x6+0x6ed9eba1, 6)
+		b2 = bits.RotateLeft32(b2+(a2^(c2&(d2^a2)))+x2+0x6d703ef3, 14)
+		a1 = bits.RotateLeft32(a1+((b1|^c1)^d1)+x13+0x6ed9eba1, 5)
+		a2 = bits.RotateLeft32(a2+(d2^(b2&(c2^d2)))+x10+0x6d703ef3, 13)
+		d1 = bits.RotateLeft32(d1+((a1|^b1)^c1)+x11+0x6ed9eba1, 12)
+		d2 = bits.RotateLeft32(d2+(c2^(a2&(b2^c2)))+x0+0x6d703ef3, 13)
+		c1 = bits.RotateLeft32(c1+((d1|^a1)^b1)+x5+0x6ed9eba1, 7)
+		c2 = bits.RotateLeft32(c2+(b2^(d2&(a2^b2)))+x4+0x6d703ef3, 7)
+		b1 = bits.RotateLeft32(b1+((c1|^d1)^a1)+x12+0x6ed9eba1, 5)
+		b2 = bits.RotateLeft32(b2+(a2^(c2&(d2^a2)))+x13+0x6d703ef3, 5)
+
+		// round 4
+		a1 = bits.RotateLeft32(a1+(c1^(d1&(b1^c1)))+x1+0x8f1bbcdc, 11)
+		a2 = bits.RotateLeft32(a2+(b2^c2^d2)+x8, 15)
+		d1 = bits.RotateLeft32(d1+(b1^(c1&(a1^b1)))+x9+0x8f1bbcdc, 12)
+		d2 = bits.RotateLeft32(d2+(a2^b2^c2)+x6, 5)
+		c1 = bits.RotateLeft32(c1+(a1^(b1&(d1^a1)))+x11+0x8f1bbcdc, 14)
+		c2 = bits.RotateLeft32(c2+(d2^a2^b2)+x4, 8)
+		b1 = bits.RotateLeft32(b1+(d1^(a1&(c1^d1)))+x10+0x8f1bbcdc, 15)
+		b2 = bits.RotateLeft32(b2+(c2^d2^a2)+x1, 11)
+		a1 = bits.RotateLeft32(a1+(c1^(d1&(b1^c1)))+x0+0x8f1bbcdc, 14)
+		a2 = bits.RotateLeft32(a2+(b2^c2^d2)+x3, 14)
+		d1 = bits.RotateLeft32(d1+(b1^(c1&(a1^b1)))+x8+0x8f1bbcdc, 15)
+		d2 = bits.RotateLeft32(d2+(a2^b2^c2)+x11, 14)
+		c1 = bits.RotateLeft32(c1+(a1^(b1&(d1^a1)))+x12+0x8f1bbcdc, 9)
+		c2 = bits.RotateLeft32(c2+(d2^a2^b2)+x15, 6)
+		b1 = bits.RotateLeft32(b1+(d1^(a1&(c1^d1)))+x4+0x8f1bbcdc, 8)
+		b2 = bits.RotateLeft32(b2+(c2^d2^a2)+x0, 14)
+		a1 = bits.RotateLeft32(a1+(c1^(d1&(b1^c1)))+x13+0x8f1bbcdc, 9)
+		a2 = bits.RotateLeft32(a2+(b2^c2^d2)+x5, 6)
+		d1 = bits.RotateLeft32(d1+(b1^(c1&(a1^b1)))+x3+0x8f1bbcdc, 14)
+		d2 = bits.RotateLeft32(d2+(a2^b2^c2)+x12, 9)
+		c1 = bits.RotateLeft32(c1+(a1^(b1&(d1^a1)))+x7+0x8f1bbcdc, 5)
+		c2 = bits.RotateLeft32(c2+(d2^a2^b2)+x2, 12)
+		b1 = bits.RotateLeft32(b1+(d1^(a1&(c1^d1)))+x15+0x8f1bbcdc, 6)
+		b2 = bits.RotateLeft32(b2+(c2^d2^a2)+x13, 9)
+		a1 = bits.RotateLeft32(a1+(c1^(d1&(b1^c1)))+x14+0x8f1bbcdc, 8)
+		a2 = bits.RotateLeft32(a2+(b2^c2^d2)+x9, 12)
+		d1 = bits.RotateLeft32(d1+(b1^(c1&(a1^b1)))+x5+0x8f1bbcdc, 6)
+		d2 = bits.RotateLeft32(d2+(a2^b2^c2)+x7, 5)
+		c1 = bits.RotateLeft32(c1+(a1^(b1&(d1^a1)))+x6+0x8f1bbcdc, 5)
+		c2 = bits.RotateLeft32(c2+(d2^a2^b2)+x10, 15)
+		b1 = bits.RotateLeft32(b1+(d1^(a1&(c1^d1)))+x2+0x8f1bbcdc, 12)
+		b2 = bits.RotateLeft32(b2+(c2^d2^a2)+x14, 8)
+
+		s[0], s[1], s[2], s[3] = s[1]+c1+d2, s[2]+d1+a2, s[3]+a1+b2, s[0]+b1+c2
+
+		p = p[blockSize:]
+	}
+}
+
+// compress160 runs the RIPEMD-160 compression function on every full block of p.
+func compress160(s *[10]uint32, p []byte) {
+	for len(p) >= blockSize {
+		x0 := binary.LittleEndian.Uint32(p[0:])
+		x1 := binary.LittleEndian.Uint32(p[4:])
+		x2 := binary.LittleEndian.Uint32(p[8:])
+		x3 := binary.LittleEndian.Uint32(p[12:])
+		x4 := binary.LittleEndian.Uint32(p[16:])
+		x5 := binary.LittleEndian.Uint32(p[20:])
+		x6 := binary.LittleEndian.Uint32(p[24:])
+		x7 := binary.LittleEndian.Uint32(p[28:])
+		x8 := binary.LittleEndian.Uint32(p[32:])
+		x9 := binary.LittleEndian.Uint32(p[36:])
+		x10 := binary.LittleEndian.Uint32(p[40:])
+		x11 := binary.LittleEndian.Uint32(p[44:])
+		x12 := binary.LittleEndian.Uint32(p[48:])
+		x13 := binary.LittleEndian.Uint32(p[52:])
+		x14 := binary.LittleEndian.Uint32(p[56:])
+		x15 := binary.LittleEndian.Uint32(p[60:])
+
+		a1, a2 := s[0], s[0]
+		b1, b2 := s[1], s[1]
+		c1, c2 := s[2], s[2]
+		d1, d2 := s[3], s[3]
+		e1, e2 := s[4], s[4]
+
+		// round 1
+		a1 = bits.RotateLeft32(a1+(b1^c1^d1)+x0, 11) + e1
+		c1 = bits.RotateLeft32(c1, 10)
+		a2 = bits.RotateLeft32(a2+(b2^(c2|^d2))+x5+0x50a28be6, 8) + e2
+		c2 = bits.RotateLeft32(c2, 10)
+		e1 = bits.RotateLeft32(e1+(a1^b1^c1)+x1, 14) + d1
+		b1 = bits.RotateLeft32(b1, 10)
+		e2 = bits.RotateLeft32(e2+(a2^(b2|^c2))+x14+0x50a28be6, 9) + d2
+		b2 = bits.RotateLeft32(b2, 10)
+		d1 = bits.RotateLeft32(d1+(e1^a1^b1)+x2, 15) + c1
+		a1 = bits.RotateLeft32(a1, 10)
+		d2 = bits.RotateLeft32(d2+(e2^(a2|^b2))+x7+0x50a28be6, 9) + c2
+		a2 = bits.RotateLeft32(a2, 10)
+		c1 = bits.RotateLeft32(c1+(d1^e1^a1)+x3, 12) + b1
+		e1 = bits.RotateLeft32(e1, 10)
+		c2 = bits.RotateLeft32(c2+(d2^(e2|^a2))+x0+0x50a28be6, 11) + b2
+		e2 = bits.RotateLeft32(e2, 10)
+		b1 = bits.RotateLeft32(b1+(c1^d1^e1)+x4, 5) + a1
+		d1 = bits.RotateLeft32(d1, 10)
+		b2 = bits.RotateLeft32(b2+(c2^(d2|^e2))+x9+0x50a28be6, 13) + a2
+		d2 = bits.RotateLeft32(d2, 10)
+		a1 = bits.RotateLeft32(a1+(b1^c1^d1)+x5, 8) + e1
+		c1 = bits.RotateLeft32(c1, 10)
+		a2 = bits.RotateLeft32(a2+(b2^(c2|^d2))+x2+0x50a28be6, 15) + e2
+		c2 = bits.RotateLeft32(c2, 10)
+		e1 = bits.RotateLeft32(e1+(a1^b1^c1)+x6, 7) + d1
+		b1 = bits.RotateLeft32(b1, 10)
+		e2 = bits.RotateLeft32(e2+(a2^(b2|^c2))+x11+0x50a28be6, 15) + d2
+		b2 = bits.RotateLeft32(b2, 10)
+		d1 = bits.RotateLeft32(d1+(e1^a1^b1)+x7, 9) + c1
+		a1 = bits.RotateLeft32(a1, 10)
+		d2 = bits.RotateLeft32(d2+(e2^(a2|^b2))+x4+0x50a28be6, 5) + c2
+		a2 = bits.RotateLeft32(a2, 10)
+		c1 = bits.RotateLeft32(c1+(d1^e1^a1)+x8, 11) + b1
+		e1 = bits.RotateLeft32(e1, 10)
+		c2 = bits.RotateLeft32(c2+(d2^(e2|^a2))+x13+0x50a28be6, 7) + b2
+		e2 = bits.RotateLeft32(e2, 10)
+		b1 = bits.RotateLeft32(b1+(c1^d1^e1)+x9, 13) + a1
+		d1 = bits.RotateLeft32(d1, 10)
+		b2 = bits.RotateLeft32(b2+(c2^(d2|^e2))+x6+0x50a28be6, 7) + a2
+		d2 = bits.RotateLeft32(d2, 10)
+		a1 = bits.RotateLeft32(a1+(b1^c1^d1)+x10, 14) + e1
+		c1 = bits.RotateLeft32(c1, 10)
+		a2 = bits.RotateLeft32(a2+(b2^(c2|^d2))+x15+0x50a28be6, 8) + e2
+		c2 = bits.RotateLeft32(c2, 10)
+		e1 = bits.RotateLeft32(e1+(a1^b1^c1)+x11, 15) + d1
+		b1 = bits.RotateLeft32(b1, 10)
+		e2 = bits.RotateLeft32(e2+(a2^(b2|^c2))+x8+0x50a28be6, 11) + d2
+		b2 = bits.RotateLeft32(b2, 10)
+		d1 = bits.RotateLeft32(d1+(e1^a1^b1)+x12, 6) + c1
+		a1 = bits.RotateLeft32(a1, 10)
+		d2 = bits.RotateLeft32(d2+(e2^(a2|^b2))+x1+0x50a28be6, 14) + c2
+		a2 = bits.RotateLeft32(a2, 10)
+		c1 = bits.RotateLeft32(c1+(d1^e1^a1)+x13, 7) + b1
+		e1 = bits.RotateLeft32(e1, 10)
+		c2 = bits.RotateLeft32(c2+(d2^(e2|^a2))+x10+0x50a28be6, 14) + b2
+		e2 = bits.RotateLeft32(e2, 10)
+		b1 = bits.RotateLeft32(b1+(c1^d1^e1)+x14, 9) + a1
+		d1 = bits.RotateLeft32(d1, 10)
+		b2 = bits.RotateLeft32(b2+(c2^(d2|^e2))+x3+0x50a28be6, 12) + a2
+		d2 = bits.RotateLeft32(d2, 10)
+		a1 = bits.RotateLeft32(a1+(b1^c1^d1)+x15, 8) + e1
+		c1 = bits.RotateLeft32(c1, 10)
+		a2 = bits.RotateLeft32(a2+(b2^(c2|^d2))+x12+0x50a28be6, 6) + e2
+		c2 = bits.RotateLeft32(c2, 10)
+
+		// round 2
+		e1 = bits.RotateLeft32(e1+(c1^(a1&(b1^c1)))+x7+0x5a827999, 7) + d1
+		b1 = bits.RotateLeft32(b1, 10)
+		e2 = bits.RotateLeft32(e2+(b2^(c2&(a2^b2)))+x6+0x5c4dd124, 9) + d2
+		b2 = bits.RotateLeft32(b2, 10)
+		d1 = bits.RotateLeft32(d1+(b1^(e1&(a1^b1)))+x4+0x5a827999, 6) + c1
+		a1 = bits.RotateLeft32(a1, 10)
+		d2 = bits.RotateLeft32(d2+(a2^(b2&(e2^a2)))+x11+0x5c4dd124, 13) + c2
+		a2 = bits.RotateLeft32(a2, 10)
+		c1 = bits.RotateLeft32(c1+(a1^(d1&(e1^a1)))+x13+0x5a827999, 8) + b1
+		e1 = bits.RotateLeft32(e1, 10)
+		c2 = bits.RotateLeft32(c2+(e2^(a2&(d2^e2)))+x3+0x5c4dd124, 15) + b2
+		e2 = bits.RotateLeft32(e2, 10)
+		b1 = bits.RotateLeft32(b1+(e1^(c1&(d1^e1)))+x1+0x5a827999, 13) + a1
+		d1 = bits.RotateLeft32(d1, 10)
+		b2 = bits.RotateLeft32(b2+(d2^(e2&(c2^d2)))+x7+0x5c4dd124, 7) + a2
+		d2 = bits.RotateLeft32(d2, 10)
+		a1 = bits.RotateLeft32(a1+(d1^(b1&(c1^d1)))+x10+0x5a827999, 11) + e1
+		c1 = bits.RotateLeft32(c1, 10)
+		a2 = bits.RotateLeft32(a2+(c2^(d2&(b2^c2)))+x0+0x5c4dd124, 12) + e2
+		c2 = bits.RotateLeft32(c2, 10)
+		e1 = bits.RotateLeft32(e1+(c1^(a1&(b1^c1)))+x6+0x5a827999, 9) + d1
+		b1 = bits.RotateLeft32(b1, 10)
+		e2 = bits.RotateLeft32(e2+(b2^(c2&(a2^b2)))+x13+0x5c4dd124, 8) + d2
+		b2 = bits.RotateLeft32(b2, 10)
+		d1 = bits.RotateLeft32(d1+(b1^(e1&(a1^b1)))+x15+0x5a827999, 7) + c1
+		a1 = bits.RotateLeft32(a1, 10)
+		d2 = bits.RotateLeft32(d2+(a2^(b2&(e2^a2)))+x5+0x5c4dd124, 9) + c2
+		a2 = bits.RotateLeft32(a2, 10)
+		c1 = bits.RotateLeft32(c1+(a1^(d1&(e1^a1)))+x3+0x5a827999, 15) + b1
+		e1 = bits.RotateLeft32(e1, 10)
+		c2 = bits.RotateLeft32(c2+(e2^(a2&(d2^e2)))+x10+0x5c4dd124, 11) + b2
+		e2 = bits.RotateLeft32(e2, 10)
+		b1 = bits.RotateLeft32(b1+(e1^(c1&(d1^e1)))+x12+0x5a827999, 7) + a1
+		d1 = bits.RotateLeft32(d1, 10)
+		b2 = bits.RotateLeft32(b2+(d2^(e2&(c2^d2)))+x14+0x5c4dd124, 7) + a2
+		d2 = bits.RotateLeft32(d2, 10)
+		a1 = bits.RotateLeft32(a1+(d1^(b1&(c1^d1)))+x0+0x5a827999, 12) + e1
+		c1 = bits.RotateLeft32(c1, 10)
+		a2 = bits.RotateLeft32(a2+(c2^(d2&(b2^c2)))+x15+0x5c4dd124, 7) + e2
+		c2 = bits.RotateLeft32(c2, 10)
+		e1 = bits.RotateLeft32(e1+(c1^(a1&(b1^c1)))+x9+0x5a827999, 15) + d1
+		b1 = bits.RotateLeft32(b1, 10)
+		e2 = bits.RotateLeft32(e2+(b2^(c2&(a2^b2)))+x8+0x5c4dd124, 12) + d2
+		b2 = bits.RotateLeft32(b2, 10)
+		d1 = bits.RotateLeft32(d1+(b1^(e1&(a1^b1)))+x5+0x5a827999, 9) + c1
+		a1 = bits.RotateLeft32(a1, 10)
+		d2 = bits.RotateLeft32(d2+(a2^(b2&(e2^a2)))+x12+0x5c4dd124, 7) + c2
+		a2 = bits.RotateLeft32(a2, 10)
+		c1 = bits.RotateLeft32(c1+(a1^(d1&(e1^a1)))+x2+0x5a827999, 11) + b1
+		e1 = bits.RotateLeft32(e1, 10)
+		c2 = bits.RotateLeft32(c2+(e2^(a2&(d2^e2)))+x4+0x5c4dd124, 6) + b2
+		e2 = bits.RotateLeft32(e2, 10)
+		b1 = bits.RotateLeft32(b1+(e1^(c1&(d1^e1)))+x14+0x5a827999, 7) + a1
+		d1 = bits.RotateLeft32(d1, 10)
+		b2 = bits.RotateLeft32(b2+(d2^(e2&(c2^d2)))+x9+0x5c4dd124, 15) + a2
+		d2 = bits.RotateLeft32(d2, 10)
+		a1 = bits.RotateLeft32(a1+(d1^(b1&(c1^d1)))+x11+0x5a827999, 13) + e1
+		c1 = bits.RotateLeft32(c1, 10)
+		a2 = bits.RotateLeft32(a2+(c2^(d2&(b2^c2)))+x1+0x5c4dd124, 13) + e2
+		c2 = bits.RotateLeft32(c2, 10)
+		e1 = bits.RotateLeft32(e1+(c1^(a1&(b1^c1)))+x8+0x5a827999, 12) + d1
+		b1 = bits.RotateLeft32(b1, 10)
+		e2 = bits.RotateLeft32(e2+(b2^(c2&(a2^b2)))+x2+0x5c4dd124, 11) + d2
+		b2 = bits.RotateLeft32(b2, 10)
+
+		// round 3
+		d1 = bits.RotateLeft32(d1+((e1|^a1)^b1)+x3+0x6ed9eba1, 11) + c1
+		a1 = bits.RotateLeft32(a1, 10)
+		d2 = bits.RotateLeft32(d2+((e2|^a2)^b2)+x15+0x6d703ef3, 9) + c2
+		a2 = bits.RotateLeft32(a2, 10)
+		c1 = bits.RotateLeft32(c1+((d1|^e1)^a1)+x10+0x6ed9eba1, 13) + b1
+		e1 = bits.RotateLeft32(e1, 10)
+		c2 = bits.RotateLeft32(c2+((d2|^e2)^a2)+x5+0x6d703ef3, 7) + b2
+		e2 = bits.RotateLeft32(e2, 10)
+		b1 = bits.RotateLeft32(b1+((c1|^d1)^e1)+x14+0x6ed9eba1, 6) + a1
+		d1 = bits.RotateLeft32(d1, 10)
+		b2 = bits.RotateLeft32(b2+((c2|^d2)^e2)+x1+0x6d703ef3, 15) + a2
+		d2 = bits.RotateLeft32(d2, 10)
+		a1 = bits.RotateLeft32(a1+((b1|^c1)^d1)+x4+0x6ed9eba1, 7) + e1
+		c1 = bits.RotateLeft32(c1, 10)
+		a2 = bits.RotateLeft32(a2+((b2|^c2)^d2)+x3+0x6d703ef3, 11) + e2
+		c2 = bits.RotateLeft32(c2, 10)
+		e1 = bits.RotateLeft32(e1+((a1|^b1)^c1)+x9+0x6ed9eba1, 14) + d1
+		b1 = bits.RotateLeft32(b1, 10)
+		e2 = bits.RotateLeft32(e2+((a2|^b2)^c2)+x7+0x6d703ef3, 8) + d2
+		b2 = bits.RotateLeft32(b2, 10)
+		d1 = bits.RotateLeft32(d1+((e1|^a1)^b1)+x15+0x6ed9eba1, 9) + c1
+		a1 = bits.RotateLeft32(a1, 10)
+		d2 = bits.RotateLeft32(d2+((e2|^a2)^b2)+x14+0x6d703ef3, 6) + c2
+		a2 = bits.RotateLeft32(a2, 10)
+		c1 = bits.RotateLeft32(c1+((d1|^e1)^a1)+x8+0x6ed9eba1, 13) + b1
+		e1 = bits.RotateLeft32(e1, 10)
+		c2 = bits.RotateLeft32(c2+((d2|^e2)^a2)+x6+0x6d703ef3, 6) + b2
+		e2 = bits.RotateLeft32(e2, 10)
+		b1 = bits.RotateLeft32(b1+((c1|^d1)^e1)+x1+0x6ed9eba1, 15) + a1
+		d1 = bits.RotateLeft32(d1, 10)
+		b2 = bits.RotateLeft32(b2+((c2|^d2)^e2)+x9+0x6d703ef3, 14) + a2
+		d2 = bits.RotateLeft32(d2, 10)
+		a1 = bits.RotateLeft32(a1+((b1|^c1)^d1)+x2+0x6ed9eba1, 14) + e1
+		c1 = bits.RotateLeft32(c1, 10)
+		a2 = bits.RotateLeft32(a2+((b2|^c2)^d2)+x11+0x6d703ef3, 12) + e2
+		c2 = bits.RotateLeft32(c2, 10)
+		e1 = bits.RotateLeft32(e1+((a1|^b1)^c1)+x7+0x6ed9eba1, 8) + d1
+		b1 = bits.RotateLeft32(b1, 10)
+		e2 = bits.RotateLeft32(e2+((a2|^b2)^c2)+x8+0x6d703ef3, 13) + d2
+		b2 = bits.RotateLeft32(b2, 10)
+		d1 = bits.RotateLeft32(d1+((e1|^a1)^b1)+x0+0x6ed9eba1, 13) + c1
+		a1 = bits.RotateLeft32(a1, 10)
+		d2 = bits.RotateLeft32(d2+((e2|^a2)^b2)+x12+0x6d703ef3, 5) + c2
+		a2 = bits.RotateLeft32(a2, 10)
+		c1 = bits.RotateLeft32(c1+((d1|^e1)^a1)+x6+0x6ed9eba1, 6) + b1
+		e1 = bits.RotateLeft32(e1, 10)
+		c2 = bits.RotateLeft32(c2+((d2|^e2)^a2)+x2+0x6d703ef3, 14) + b2
+		e2 = bits.RotateLeft32(e2, 10)
+		b1 = bits.RotateLeft32(b1+((c1|^d1)^e1)+x13+0x6ed9eba1, 5) + a1
+		d1 = bits.RotateLeft32(d1, 10)
+		b2 = bits.RotateLeft32(b2+((c2|^d2)^e2)+x10+0x6d703ef3, 13) + a2
+		d2 = bits.RotateLeft32(d2, 10)
+		a1 = bits.RotateLeft32(a1+((b1|^c1)^d1)+x11+0x6ed9eba1, 12) + e1
+		c1 = bits.RotateLeft32(c1, 10)
+		a2 = bits.RotateLeft32(a2+((b2|^c2)^d2)+x0+0x6d703ef3, 13) + e2
+		c2 = bits.RotateLeft32(c2, 10)
+		e1 = bits.RotateLeft32(e1+((a1|^b1)^c1)+x5+0x6ed9eba1, 7) + d1
+		b1 = bits.RotateLeft32(b1, 10)
+		e2 = bits.RotateLeft32(e2+((a2|^b2)^c2)+x4+0x6d703ef3, 7) + d2
+		b2 = bits.RotateLeft32(b2, 10)
+		d1 = bits.RotateLeft32(d1+((e1|^a1)^b1)+x12+0x6ed9eba1, 5) + c1
+		a1 = bits.RotateLeft32(a1, 10)
+		d2 = bits.RotateLeft32(d2+((e2|^a2)^b2)+x13+0x6d703ef3, 5) + c2
+		a2 = bits.RotateLeft32(a2, 10)
+
+		// round 4
+		c1 = bits.RotateLeft32(c1+(e1^(a1&(d1^e1)))+x1+0x8f1bbcdc, 11) + b1
+		e1 = bits.RotateLeft32(e1, 10)
+		c2 = bits.RotateLeft32(c2+(a2^(d2&(e2^a2)))+x8+0x7a6d76e9, 15) + b2
+		e2 = bits.RotateLeft32(e2, 10)
+		b1 = bits.RotateLeft32(b1+(d1^(e1&(c1^d1)))+x9+0x8f1bbcdc, 12) + a1
+		d1 = bits.RotateLeft32(d1, 10)
+		b2 = bits.RotateLeft32(b2+(e2^(c2&(d2^e2)))+x6+0x7a6d76e9, 5) + a2
+		d2 = bits.RotateLeft32(d2, 10)
+		a1 = bits.RotateLeft32(a1+(c1^(d1&(b1^c1)))+x11+0x8f1bbcdc, 14) + e1
+		c1 = bits.RotateLeft32(c1, 10)
+		a2 = bits.RotateLeft32(a2+(d2^(b2&(c2^d2)))+x4+0x7a6d76e9, 8) + e2
+		c2 = bits.RotateLeft32(c2, 10)
+		e1 = bits.RotateLeft32(e1+(b1^(c1&(a1^b1)))+x10+0x8f1bbcdc, 15) + d1
+		b1 = bits.RotateLeft32(b1, 10)
+		e2 = bits.RotateLeft32(e2+(c2^(a2&(b2^c2)))+x1+0x7a6d76e9, 11) + d2
+		b2 = bits.RotateLeft32(b2, 10)
+		d1 = bits.RotateLeft32(d1+(a1^(b1&(e1^a1)))+x0+0x8f1bbcdc, 14) + c1
+		a1 = bits.RotateLeft32(a1, 10)
+		d2 = bits.RotateLeft32(d2+(b2^(e2&(a2^b2)))+x3+0x7a6d76e9, 14) + c2
+		a2 = bits.RotateLeft32(a2, 10)
+		c1 = bits.RotateLeft32(c1+(e1^(a1&(d1^e1)))+x8+0x8f1bbcdc, 15) + b1
+		e1 = bits.RotateLeft32(e1, 10)
+		c2 = bits.RotateLeft32(c2+(a2^(d2&(e2^a2)))+x11+0x7a6d76e9, 14) + b2
+		e2 = bits.RotateLeft32(e2, 10)
+		b1 = bits.RotateLeft32(b1+(d1^(e1&(c1^d1)))+x12+0x8f1bbcdc, 9) + a1
+		d1 = bits.RotateLeft32(d1, 10)
+		b2 = bits.RotateLeft32(b2+(e2^(c2&(d2^e2)))+x15+0x7a6d76e9, 6) + a2
+		d2 = bits.RotateLeft32(d2, 10)
+		a1 = bits.RotateLeft32(a1+(c1^(d1&(b1^c1)))+x4+0x8f1bbcdc, 8) + e1
+		c1 = bits.RotateLeft32(c1, 10)
+		a2 = bits.RotateLeft32(a2+(d2^(b2&(c2^d2)))+x0+0x7a6d76e9, 14) + e2
+		c2 = bits.RotateLeft32(c2, 10)
+		e1 = bits.RotateLeft32(e1+(b1^(c1&(a1^b1)))+x13+0x8f1bbcdc, 9) + d1
+		b1 = bits.RotateLeft32(b1, 10)
+		e2 = bits.RotateLeft32(e2+(c2^(a2&(b2^c2)))+x5+0x7a6d76e9, 6) + d2
+		b2 = bits.RotateLeft32(b2, 10)
+		d1 = bits.RotateLeft32(d1+(a1^(b1&(e1^a1)))+x3+0x8f1bbcdc, 14) + c1
+		a1 = bits.RotateLeft32(a1, 10)
+		d2 = bits.RotateLeft32(d2+(b2^(e2&(a2^b2)))+x12+0x7a6d76e9, 9) + c2
+		a2 = bits.RotateLeft32(a2, 10)
+		c1 = bits.RotateLeft32(c1+(e1^(a1&(d1^e1)))+x7+0x8f1bbcdc, 5) + b1
+		e1 = bits.RotateLeft32(e1, 10)
+		c2 = bits.RotateLeft32(c2+(a2^(d2&(e2^a2)))+x2+0x7a6d76e9, 12) + b2
+		e2 = bits.RotateLeft32(e2, 10)
+		b1 = bits.RotateLeft32(b1+(d1^(e1&(c1^d1)))+x15+0x8f1bbcdc, 6) + a1
+		d1 = bits.RotateLeft32(d1, 10)
+		b2 = bits.RotateLeft32(b2+(e2^(c2&(d2^e2)))+x13+0x7a6d76e9, 9) + a2
+		d2 = bits.RotateLeft32(d2, 10)
+		a1 = bits.RotateLeft32(a1+(c1^(d1&(b1^c1)))+x14+0x8f1bbcdc, 8) + e1
+		c1 = bits.RotateLeft32(c1, 10)
+		a2 = bits.RotateLeft32(a2+(d2^(b2&(c2^d2)))+x9+0x7a6d76e9, 12) + e2
+		c2 = bits.RotateLeft32(c2, 10)
+		e1 = bits.RotateLeft32(e1+(b1^(c1&(a1^b1)))+x5+0x8f1bbcdc, 6) + d1
+		b1 = bits.RotateLeft32(b1, 10)
+		e2 = bits.RotateLeft32(e2+(c2^(a2&(b2^c2)))+x7+0x7a6d76e9, 5) + d2
+		b2 = bits.RotateLeft32(b2, 10)
+		d1 = bits.RotateLeft32(d1+(a1^(b1&(e1^a1)))+x6+0x8f1bbcdc, 5) + c1
+		a1 = bits.RotateLeft32(a1, 10)
+		d2 = bits.RotateLeft32(d2+(b2^(e2&(a2^b2)))+x10+0x7a6d76e9, 15) + c2
+		a2 = bits.RotateLeft32(a2, 10)
+		c1 = bits.RotateLeft32(c1+(e1^(a1&(d1^e1)))+x2+0x8f1bbcdc, 12) + b1
+		e1 = bits.RotateLeft32(e1, 10)
+		c2 = bits.RotateLeft32(c2+(a2^(d2&(e2^a2)))+x14+0x7a6d76e9, 8) + b2
+		e2 = bits.RotateLeft32(e2, 10)
+
+		// round 5
+		b1 = bits.RotateLeft32(b1+(c1^(d1|^e1))+x4+0xa953fd4e, 9) + a1
+		d1 = bits.RotateLeft32(d1, 10)
+		b2 = bits.RotateLeft32(b2+(c2^d2^e2)+x12, 8) + a2
+		d2 = bits.RotateLeft32(d2, 10)
+		a1 = bits.RotateLeft32(a1+(b1^(c1|^d1))+x0+0xa953fd4e, 15) + e1
+		c1 = bits.RotateLeft32(c1, 10)
+		a2 = bits.RotateLeft32(a2+(b2^c2^d2)+x15, 5) + e2
+		c2 = bits.RotateLeft32(c2, 10)
+		e1 = bits.RotateLeft32(e1+(a1^(b1|^c1))+x5+0xa953fd4e, 5) + d1
+		b1 = bits.RotateLeft32(b1, 10)
+		e2 = bits.RotateLeft32(e2+(a2^b2^c2)+x10, 12) + d2
+		b2 = bits.RotateLeft32(b2, 10)
+		d1 = bits.RotateLeft32(d1+(e1^(a1|^b1))+x9+0xa953fd4e, 11) + c1
+		a1 = bits.RotateLeft32(a1, 10)
+		d2 = bits.RotateLeft32(d2+(e2^a2^b2)+x4, 9) + c2
+		a2 = bits.RotateLeft32(a2, 10)
+		c1 = bits.RotateLeft32(c1+(d1^(e1|^a1))+x7+0xa953fd4e, 6) + b1
+		e1 = bits.RotateLeft32(e1, 10)
+		c2 = bits.RotateLeft32(c2+(d2^e2^a2)+x1, 12) + b2
+		e2 = bits.RotateLeft32(e2, 10)
+		b1 = bits.RotateLeft32(b1+(c1^(d1|^e1))+x12+0xa953fd4e, 8) + a1
+		d1 = bits.RotateLeft32(d1, 10)
+		b2 = bits.RotateLeft32(b2+(c2^d2^e2)+x5, 5) + a2
+		d2 = bits.RotateLeft32(d2, 10)
+		a1 = bits.RotateLeft32(a1+(b1^(c1|^d1))+x2+0xa953fd4e, 13) + e1
+		c1 = bits.RotateLeft32(c1, 10)
+		a2 = bits.RotateLeft32(a2+(b2^c2^d2)+x8, 14) + e2
+		c2 = bits.RotateLeft32(c2, 10)
+		e1 = bits.RotateLeft32(e1+(a1^(b1|^c1))+x10+0xa953fd4e, 12) + d1
+		b1 = bits.RotateLeft32(b1, 10)
+		e2 = bits.RotateLeft32(e2+(a2^b2^c2)+x7, 6) + d2
+		b2 = bits.RotateLeft32(b2, 10)
+		d1 = bits.RotateLeft32(d1+(e1^(a1|^b1))+x14+0xa953fd4e, 5) + c1
+		a1 = bits.RotateLeft32(a1, 10)
+		d2 = bits.RotateLeft32(d2+(e2^a2^b2)+x6, 8) + c2
+		a2 = bits.RotateLeft32(a2, 10)
+		c1 = bits.RotateLeft32(c1+(d1^(e1|^a1))+x1+0xa953fd4e, 12) + b1
+		e1 = bits.RotateLeft32(e1, 10)
+		c2 = bits.RotateLeft32(c2+(d2^e2^a2)+x2, 13) + b2
+		e2 = bits.RotateLeft32(e2, 10)
+		b1 = bits.RotateLeft32(b1+(c1^(d1|^e1))+x3+0xa953fd4e, 13) + a1
+		d1 = bits.RotateLeft32(d1, 10)
+		b2 = bits.RotateLeft32(b2+(c2^d2^e2)+x13, 6) + a2
+		d2 = bits.RotateLeft32(d2, 10)
+		a1 = bits.RotateLeft32(a1+(b1^(c1|^d1))+x8+0xa953fd4e, 14) + e1
+		c1 = bits.RotateLeft32(c1, 10)
+		a2 = bits.RotateLeft32(a2+(b2^c2^d2)+x14, 5) + e2
+		c2 = bits.RotateLeft32(c2, 10)
+		e1 = bits.RotateLeft32(e1+(a1^(b1|^c1))+x11+0xa953fd4e, 11) + d1
+		b1 = bits.RotateLeft32(b1, 10)
+		e2 = bits.RotateLeft32(e2+(a2^b2^c2)+x0, 15) + d2
+		b2 = bits.RotateLeft32(b2, 10)
+		d1 = bits.RotateLeft32(d1+(e1^(a1|^b1))+x6+0xa953fd4e, 8) + c1
+		a1 = bits.RotateLeft32(a1, 10)
+		d2 = bits.RotateLeft32(d2+(e2^a2^b2)+x3, 13) + c2
+		a2 = bits.RotateLeft32(a2, 10)
+		c1 = bits.RotateLeft32(c1+(d1^(e1|^a1))+x15+0xa953fd4e, 5) + b1
+		e1 = bits.RotateLeft32(e1, 10)
+		c2 = bits.RotateLeft32(c2+(d2^e2^a2)+x9, 11) + b2
+		e2 = bits.RotateLeft32(e2, 10)
+		b1 = bits.RotateLeft32(b1+(c1^(d1|^e1))+x13+0xa953fd4e, 6) + a1
+		d1 = bits.RotateLeft32(d1, 10)
+		b2 = bits.RotateLeft32(b2+(c2^d2^e2)+x11, 11) + a2
+		d2 = bits.RotateLeft32(d2, 10)
+
+		s[0], s[1], s[2], s[3], s[4] = s[1]+c1+d2, s[2]+d1+e2, s[3]+e1+a2, s[4]+a1+b2, s[0]+b1+c2
+
+		p = p[blockSize:]
+	}
+}
+
+// compress256 runs the RIPEMD-256 compression function on every full block of p.
+func compress256(s *[10]uint32, p []byte) {
+	for len(p) >= blockSize {
+		x0 := binary.LittleEndian.Uint32(p[0:])
+		x1 := binary.LittleEndian.Uint32(p[4:])
+		x2 := binary.LittleEndian.Uint32(p[8:])
+		x3 := binary.LittleEndian.Uint32(p[12:])
+		x4 := binary.LittleEndian.Uint32(p[16:])
+		x5 := binary.LittleEndian.Uint32(p[20:])
+		x6 := binary.LittleEndian.Uint32(p[24:])
+		x7 := binary.LittleEndian.Uint32(p[28:])
+		x8 := binary.LittleEndian.Uint32(p[32:])
+		x9 := binary.LittleEndian.Uint32(p[36:])
+		x10 := binary.LittleEndian.Uint32(p[40:])
+		x11 := binary.LittleEndian.Uint32(p[44:])
+		x12 := binary.LittleEndian.Uint32(p[48:])
+		x13 := binary.LittleEndian.Uint32(p[52:])
+		x14 := binary.LittleEndian.Uint32(p[56:])
+		x15 := binary.LittleEndian.Uint32(p[60:])
+
+		a1, a2 := s[0], s[4]
+		b1, b2 := s[1], s[5]
+		c1, c2 := s[2], s[6]
+		d1, d2 := s[3], s[7]
+
+		// round 1
+		a1 = bits.RotateLeft32(a1+(b1^c1^d1)+x0, 11)
+		a2 = bits.RotateLeft32(a2+(c2^(d2&(b2^c2)))+x5+0x50a28be6, 8)
+		d1 = bits.RotateLeft32(d1+(a1^b1^c1)+x1, 14)
+		d2 = bits.RotateLeft32(d2+(b2^(c2&(a2^b2)))+x14+0x50a28be6, 9)
+		c1 = bits.RotateLeft32(c1+(d1^a1^b1)+x2, 15)
+		c2 = bits.RotateLeft32(c2+(a2^(b2&(d2^a2)))+x7+0x50a28be6, 9)
+		b1 = bits.RotateLeft32(b1+(c1^d1^a1)+x3, 12)
+		b2 = bits.RotateLeft32(b2+(d2^(a2&(c2^d2)))+x0+0x50a28be6, 11)
+		a1 = bits.RotateLeft32(a1+(b1^c1^d1)+x4, 5)
+		a2 = bits.RotateLeft32(a2+(c2^(d2&(b2^c2)))+x9+0x50a28be6, 13)
+		d1 = bits.RotateLeft32(d1+(a1^b1^c1)+x5, 8)
+		d2 = bits.RotateLeft32(d2+(b2^(c2&(a2^b2)))+x2+0x50a28be6, 15)
+		c1 = bits.RotateLeft32(c1+(d1^a1^b1)+x6, 7)
+		c2 = bits.RotateLeft32(c2+(a2^(b2&(d2^a2)))+x11+0x50a28be6, 15)
+		b1 = bits.RotateLeft32(b1+(c1^d1^a1)+x7, 9)
+		b2 = bits.RotateLeft32(b2+(d2^(a2&(c2^d2)))+x4+0x50a28be6, 5)
+		a1 = bits.RotateLeft32(a1+(b1^c1^d1)+x8, 11)
+		a2 = bits.RotateLeft32(a2+(c2^(d2&(b2^c2)))+x13+0x50a28be6, 7)
+		d1 = bits.RotateLeft32(d1+(a1^b1^c1)+x9, 13)
+		d2 = bits.RotateLeft32(d2+(b2^(c2&(a2^b2)))+x6+0x50a28be6, 7)
+		c1 = bits.RotateLeft32(c1+(d1^a1^b1)+x10, 14)
+		c2 = bits.RotateLeft32(c2+(a2^(b2&(d2^a2)))+x15+0x50a28be6, 8)
+		b1 = bits.RotateLeft32(b1+(c1^d1^a1)+x11, 15)
+		b2 = bits.RotateLeft32(b2+(d2^(a2&(c2^d2)))+x8+0x50a28be6, 11)
+		a1 = bits.RotateLeft32(a1+(b1^c1^d1)+x12, 6)
+		a2 = bits.RotateLeft32(a2+(c2^(d2&(b2^c2)))+x1+0x50a28be6, 14)
+		d1 = bits.RotateLeft32(d1+(a1^b1^c1)+x13, 7)
+		d2 = bits.RotateLeft32(d2+(b2^(c2&(a2^b2)))+x10+0x50a28be6, 14)
+		c1 = bits.RotateLeft32(c1+(d1^a1^b1)+x14, 9)
+		c2 = bits.RotateLeft32(c2+(a2^(b2&(d2^a2)))+x3+0x50a28be6, 12)
+		b1 = bits.RotateLeft32(b1+(c1^d1^a1)+x15, 8)
+		b2 = bits.RotateLeft32(b2+(d2^(a2&(c2^d2)))+x12+0x50a28be6, 6)
+
+		// round 2
+		a2 = bits.RotateLeft32(a2+(d1^(b1&(c1^d1)))+x7+0x5a827999, 7)
+		a1 = bits.RotateLeft32(a1+((b2|^c2)^d2)+x6+0x5c4dd124, 9)
+		d1 = bits.RotateLeft32(d1+(c1^(a2&(b1^c1)))+x4+0x5a827999, 6)
+		d2 = bits.RotateLeft32(d2+((a1|^b2)^c2)+x11+0x5c4dd124, 13)
+		c1 = bits.RotateLeft32(c1+(b1^(d1&(a2^b1)))+x13+0x5a827999, 8)
+		c2 = bits.RotateLeft32(c2+((d2|^a1)^b2)+x3+0x5c4dd124, 15)
+		b1 = bits.RotateLeft32(b1+(a2^(c1&(d1^a2)))+x1+0x5a827999, 13)
+		b2 = bits.RotateLeft32(b2+((c2|^d2)^a1)+x7+0x5c4dd124, 7)
+		a2 = bits.RotateLeft32(a2+(d1^(b1&(c1^d1)))+x10+0x5a827999, 11)
+		a1 = bits.RotateLeft32(a1+((b2|^c2)^d2)+x0+0x5c4dd124, 12)
+		d1 = bits.RotateLeft32(d1+(c1^(a2&(b1^c1)))+x6+0x5a827999, 9)
+		d2 = bits.RotateLeft32(d2+((a1|^b2)^c2)+x13+0x5c4dd124, 8)
+		c1 = bits.RotateLeft32(c1+(b1^(d1&(a2^b1)))+x15+0x5a827999, 7)
+		c2 = bits.RotateLeft32(c2+((d2|^a1)^b2)+x5+0x5c4dd124, 9)
+		b1 = bits.RotateLeft32(b1+(a2^(c1&(d1^a2)))+x3+0x5a827999, 15)
+		b2 = bits.RotateLeft32(b2+((c2|^d2)^a1)+x10+0x5c4dd124, 11)
+		a2 = bits.RotateLeft32(a2+(d1^(b1&(c1^d1)))+x12+0x5a827999, 7)
+		a1 = bits.RotateLeft32(a1+((b2|^c2)^d2)+x14+0x5c4dd124, 7)
+		d1 = bits.RotateLeft32(d1+(c1^(a2&(b1^c1)))+x0+0x5a827999, 12)
+		d2 = bits.RotateLeft32(d2+((a1|^b2)^c2)+x15+0x5c4dd124, 7)
+		c1 = bits.RotateLeft32(c1+(b1^(d1&(a2^b1)))+x9+0x5a827999, 15)
+		c2 = bits.RotateLeft32(c2+((d2|^a1)^b2)+x8+0x5c4dd124, 12)
+		b1 = bits.RotateLeft32(b1+(a2^(c1&(d1^a2)))+x5+0x5a827999, 9)
+		b2 = bits.RotateLeft32(b2+((c2|^d2)^a1)+x12+0x5c4dd124, 7)
+		a2 = bits.RotateLeft32(a2+(d1^(b1&(c1^d1)))+x2+0x5a827999, 11)
+		a1 = bits.RotateLeft32(a1+((b2|^c2)^d2)+x4+0x5c4dd124, 6)
+		d1 = bits.RotateLeft32(d1+(c1^(a2&(b1^c1)))+x14+0x5a827999, 7)
+		d2 = bits.RotateLeft32(d2+((a1|^b2)^c2)+x9+0x5c4dd124, 15)
+		c1 = bits.RotateLeft32(c1+(b1^(d1&(a2^b1)))+x11+0x5a827999, 13)
+		c2 = bits.RotateLeft32(c2+((d2|^a1)^b2)+x1+0x5c4dd124, 13)
+		b1 = bits.RotateLeft32(b1+(a2^(c1&(d1^a2)))+x8+0x5a827999, 12)
+		b2 = bits.RotateLeft32(b2+((c2|^d2)^a1)+x2+0x5c4dd124, 11)
+
+		// round 3
+		a2 = bits.RotateLeft32(a2+((b2|^c1)^d1)+x3+0x6ed9eba1, 11)
+		a1 = bits.RotateLeft32(a1+(d2^(b1&(c2^d2)))+x15+0x6d703ef3, 9)
+		d1 = bits.RotateLeft32(d1+((a2|^b2)^c1)+x10+0x6ed9eba1, 13)
+		d2 = bits.RotateLeft32(d2+(c2^(a1&(b1^c2)))+x5+0x6d703ef3, 7)
+		c1 = bits.RotateLeft32(c1+((d1|^a2)^b2)+x14+0x6ed9eba1, 6)
+		c2 = bits.RotateLeft32(c2+(b1^(d2&(a1^b1)))+x1+0x6d703ef3, 15)
+		b2 = bits.RotateLeft32(b2+((c1|^d1)^a2)+x4+0x6ed9eba1, 7)
+		b1 = bits.RotateLeft32(b1+(a1^(c2&(d2^a1)))+x3+0x6d703ef3, 11)
+		a2 = bits.RotateLeft32(a2+((b2|^c1)^d1)+x9+0x6ed9eba1, 14)
+		a1 = bits.RotateLeft32(a1+(d2^(b1&(c2^d2)))+x7+0x6d703ef3, 8)
+		d1 = bits.RotateLeft32(d1+((a2|^b2)^c1)+x15+0x6ed9eba1, 9)
+		d2 = bits.RotateLeft32(d2+(c2^(a1&(b1^c2)))+x14+0x6d703ef3, 6)
+		c1 = bits.RotateLeft32(c1+((d1|^a2)^b2)+x8+0x6ed9eba1, 13)
+		c2 = bits.RotateLeft32(c2+(b1^(d2&(a1^b1)))+x6+0x6d703ef3, 6)
+		b2 = bits.RotateLeft32(b2+((c1|^d1)^a2)+x1+0x6ed9eba1, 15)
+		b1 = bits.RotateLeft32(b1+(a1^(c2&(d2^a1)))+x9+0x6d703ef3, 14)
+		a2 = bits.RotateLeft32(a2+((b2|^c1)^d1)+x2+0x6ed9eba1, 14)
+		a1 = bits.RotateLeft32(a1+(d2^(b1&(c2^d2)))+x11+0x6d703ef3, 12)
+		d1 = bits.RotateLeft32(d1+((a2|^b2)^c1)+x7+0x6ed9eba1, 8)
+		d2 = bits.RotateLeft32(d2+(c2^(a1&(b1^c2)))+x8+0x6d703ef3, 13)
+		c1 = bits.RotateLeft32(c1+((d1|^a2)^b2)+x0+0x6ed9eba1, 13)
+		c2 = bits.RotateLeft32(c2+(b1^(d2&(a1^b1)))+x12+0x6d703ef3, 5)
+		b2 = bits.RotateLeft32(b2+((c1|^d1)^a2)+x6+0x6ed9eba1, 6)
+		b1 = bits.RotateLeft32(b1+(a1^(c2&(d2^a1)))+x2+0x6d703ef3, 14)
+		a2 = bits.RotateLeft32(a2+((b2|^c1)^d1)+x13+0x6ed9eba1, 5)
+		a1 = bits.RotateLeft32(a1+(d2^(b1&(c2^d2)))+x10+0x6d703ef3, 13)
+		d1 = bits.RotateLeft32(d1+((a2|^b2)^c1)+x11+0x6ed9eba1, 12)
+		d2 = bits.RotateLeft32(d2+(c2^(a1&(b1^c2)))+x0+0x6d703ef3, 13)
+		c1 = bits.RotateLeft32(c1+((d1|^a2)^b2)+x5+0x6ed9eba1, 7)
+		c2 = bits.RotateLeft32(c2+(b1^(d2&(a1^b1)))+x4+0x6d703ef3, 7)
+		b2 = bits.RotateLeft32(b2+((c1|^d1)^a2)+x12+0x6ed9eba1, 5)
+		b1 = bits.RotateLeft32(b1+(a1^(c2&(d2^a1)))+x13+0x6d703ef3, 5)
+
+		// round 4
+		a2 = bits.RotateLeft32(a2+(c2^(d1&(b2^c2)))+x1+0x8f1bbcdc, 11)
+		a1 = bits.RotateLeft32(a1+(b1^c1^d2)+x8, 15)
+		d1 = bits.RotateLeft32(d1+(b2^(c2&(a2^b2)))+x9+0x8f1bbcdc, 12)
+		d2 = bits.RotateLeft32(d2+(a1^b1^c1)+x6, 5)
+		c2 = bits.RotateLeft32(c2+(a2^(b2&(d1^a2)))+x11+0x8f1bbcdc, 14)
+		c1 = bits.RotateLeft32(c1+(d2^a1^b1)+x4, 8)
+		b2 = bits.RotateLeft32(b2+(d1^(a2&(c2^d1)))+x10+0x8f1bbcdc, 15)
+		b1 = bits.RotateLeft32(b1+(c1^d2^a1)+x1, 11)
+		a2 = bits.RotateLeft32(a2+(c2^(d1&(b2^c2)))+x0+0x8f1bbcdc, 14)
+		a1 = bits.RotateLeft32(a1+(b1^c1^d2)+x3, 14)
+		d1 = bits.RotateLeft32(d1+(b2^(c2&(a2^b2)))+x8+0x8f1bbcdc, 15)
+		d2 = bits.RotateLeft32(d2+(a1^b1^c1)+x11, 14)
+		c2 = bits.RotateLeft32(c2+(a2^(b2&(d1^a2)))+x12+0x8f1bbcdc, 9)
+		c1 = bits.RotateLeft32(c1+(d2^a1^b1)+x15, 6)
+		b2 = bits.RotateLeft32(b2+(d1^(a2&(c2^d1)))+x4+0x8f1bbcdc, 8)
+		b1 = bits.RotateLeft32(b1+(c1^d2^a1)+x0, 14)
+		a2 = bits.RotateLeft32(a2+(c2^(d1&(b2^c2)))+x13+0x8f1bbcdc, 9)
+		a1 = bits.RotateLeft32(a1+(b1^c1^d2)+x5, 6)
+		d1 = bits.RotateLeft32(d1+(b2^(c2&(a2^b2)))+x3+0x8f1bbcdc, 14)
+		d2 = bits.RotateLeft32(d2+(a1^b1^c1)+x12, 9)
+		c2 = bits.RotateLeft32(c2+(a2^(b2&(d1^a2)))+x7+0x8f1bbcdc, 5)
+		c1 = bits.RotateLeft32(c1+(d2^a1^b1)+x2, 12)
+		b2 = bits.RotateLeft32(b2+(d1^(a2&(c2^d1)))+x15+0x8f1bbcdc, 6)
+		b1 = bits.RotateLeft32(b1+(c1^d2^a1)+x13, 9)
+		a2 = bits.RotateLeft32(a2+(c2^(d1&(b2^c2)))+x14+0x8f1bbcdc, 8)
+		a1 = bits.RotateLeft32(a1+(b1^c1^d2)+x9, 12)
+		d1 = bits.RotateLeft32(d1+(b2^(c2&(a2^b2)))+x5+0x8f1bbcdc, 6)
+		d2 = bits.RotateLeft32(d2+(a1^b1^c1)+x7, 5)
+		c2 = bits.RotateLeft32(c2+(a2^(b2&(d1^a2)))+x6+0x8f1bbcdc, 5)
+		c1 = bits.RotateLeft32(c1+(d2^a1^b1)+x10, 15)
+		b2 = bits.RotateLeft32(b2+(d1^(a2&(c2^d1)))+x2+0x8f1bbcdc, 12)
+		b1 = bits.RotateLeft32(b1+(c1^d2^a1)+x14, 8)
+
+		s[0] += a2
+		s[1] += b2
+		s[2] += c2
+		s[3] += d2
+		s[4] += a1
+		s[5] += b1
+		s[6] += c1
+		s[7] += d1
+
+		p = p[blockSize:]
+	}
+}
+
+// compress320 runs the RIPEMD-320 compression function on every full block of p.
+func compress320(s *[10]uint32, p []byte) {
+	for len(p) >= blockSize {
+		x0 := binary.LittleEndian.Uint32(p[0:])
+		x1 := binary.LittleEndian.Uint32(p[4:])
+		x2 := binary.LittleEndian.Uint32(p[8:])
+		x3 := binary.LittleEndian.Uint32(p[12:])
+		x4 := binary.LittleEndian.Uint32(p[16:])
+		x5 := binary.LittleEndian.Uint32(p[20:])
+		x6 := binary.LittleEndian.Uint32(p[24:])
+		x7 := binary.LittleEndian.Uint32(p[28:])
+		x8 := binary.LittleEndian.Uint32(p[32:])
+		x9 := binary.LittleEndian.Uint32(p[36:])
+		x10 := binary.LittleEndian.Uint32(p[40:])
+		x11 := binary.LittleEndian.Uint32(p[44:])
+		x12 := binary.LittleEndian.Uint32(p[48:])
+		x13 := binary.LittleEndian.Uint32(p[52:])
+		x14 := binary.LittleEndian.Uint32(p[56:])
+		x15 := binary.LittleEndian.Uint32(p[60:])
+
+		a1, a2 := s[0], s[5]
+		b1, b2 := s[1], s[6]
+		c1, c2 := s[2], s[7]
+		d1, d2 := s[3], s[8]
+		e1, e2 := s[4], s[9]
+
+		// round 1
+		a1 = bits.RotateLeft32(a1+(b1^c1^d1)+x0, 11) + e1
+		c1 = bits.RotateLeft32(c1, 10)
+		a2 = bits.RotateLeft32(a2+(b2^(c2|^d2))+x5+0x50a28be6, 8) + e2
+		c2 = bits.RotateLeft32(c2, 10)
+		e1 = bits.RotateLeft32(e1+(a1^b1^c1)+x1, 14) + d1
+		b1 = bits.RotateLeft32(b1, 10)
+		e2 = bits.RotateLeft32(e2+(a2^(b2|^c2))+x14+0x50a28be6, 9) + d2
+		b2 = bits.RotateLeft32(b2, 10)
+		d1 = bits.RotateLeft32(d1+(e1^a1^b1)+x2, 15) + c1
+		a1 = bits.RotateLeft32(a1, 10)
+		d2 = bits.RotateLeft32(d2+(e2^(a2|^b2))+x7+0x50a28be6, 9) + c2
+		a2 = bits.RotateLeft32(a2, 10)
+		c1 = bits.RotateLeft32(c1+(d1^e1^a1)+x3, 12) + b1
+		e1 = bits.RotateLeft32(e1, 10)
+		c2 = bits.RotateLeft32(c2+(d2^(e2|^a2))+x0+0x50a28be6, 11) + b2
+		e2 = bits.RotateLeft32(e2, 10)
+		b1 = bits.RotateLeft32(b1+(c1^d1^e1)+x4, 5) + a1
+		d1 = bits.RotateLeft32(d1, 10)
+		b2 = bits.RotateLeft32(b2+(c2^(d2|^e2))+x9+0x50a28be6, 13) + a2
+		d2 = bits.RotateLeft32(d2, 10)
+		a1 = bits.RotateLeft32(a1+(b1^c1^d1)+x5, 8) + e1
+		c1 = bits.RotateLeft32(c1, 10)
+		a2 = bits.RotateLeft32(a2+(b2^(c2|^d2))+x2+0x50a28be6, 15) + e2
+		c2 = bits.RotateLeft32(c2, 10)
+		e1 = bits.RotateLeft32(e1+(a1^b1^c1)+x6, 7) + d1
+		b1 = bits.RotateLeft32(b1, 10)
+		e2 = bits.RotateLeft32(e2+(a2^(b2|^c2))+x11+0x50a28be6, 15) + d2
+		b2 = bits.RotateLeft32(b2, 10)
+		d1 = bits.RotateLeft32(d1+(e1^a1^b1)+x7, 9) + c1
+		a1 = bits.RotateLeft32(a1, 10)
+		d2 = bits.RotateLeft32(d2+(e2^(a2|^b2))+x4+0x50a28be6, 5) + c2
+		a2 = bits.RotateLeft32(a2, 10)
+		c1 = bits.RotateLeft32(c1+(d1^e1^a1)+x8, 11) + b1
+		e1 = bits.RotateLeft32(e1, 10)
+		c2 = bits.RotateLeft32(c2+(d2^(e2|^a2))+x13+0x50a28be6, 7) + b2
+		e2 = bits.RotateLeft32(e2, 10)
+		b1 = bits.RotateLeft32(b1+(c1^d1^e1)+x9, 13) + a1
+		d1 = bits.RotateLeft32(d1, 10)
+		b2 = bits.RotateLeft32(b2+(c2^(d2|^e2))+x6+0x50a28be6, 7) + a2
+		d2 = bits.RotateLeft32(d2, 10)
+		a1 = bits.RotateLeft32(a1+(b1^c1^d1)+x10, 14) + e1
+		c1 = bits.RotateLeft32(c1, 10)
+		a2 = bits.RotateLeft32(a2+(b2^(c2|^d2))+x15+0x50a28be6, 8) + e2
+		c2 = bits.RotateLeft32(c2, 10)
+		e1 = bits.RotateLeft32(e1+(a1^b1^c1)+x11, 15) + d1
+		b1 = bits.RotateLeft32(b1, 10)
+		e2 = bits.RotateLeft32(e2+(a2^(b2|^c2))+x8+0x50a28be6, 11) + d2
+		b2 = bits.RotateLeft32(b2, 10)
+		d1 = bits.RotateLeft32(d1+(e1^a1^b1)+x12, 6) + c1
+		a1 = bits.RotateLeft32(a1, 10)
+		d2 = bits.RotateLeft32(d2+(e2^(a2|^b2))+x1+0x50a28be6, 14) + c2
+		a2 = bits.RotateLeft32(a2, 10)
+		c1 = bits.RotateLeft32(c1+(d1^e1^a1)+x13, 7) + b1
+		e1 = bits.RotateLeft32(e1, 10)
+		c2 = bits.RotateLeft32(c2+(d2^(e2|^a2))+x10+0x50a28be6, 14) + b2
+		e2 = bits.RotateLeft32(e2, 10)
+		b1 = bits.RotateLeft32(b1+(c1^d1^e1)+x14, 9) + a1
+		d1 = bits.RotateLeft32(d1, 10)
+		b2 = bits.RotateLeft32(b2+(c2^(d2|^e2))+x3+0x50a28be6, 12) + a2
+		d2 = bits.RotateLeft32(d2, 10)
+		a1 = bits.RotateLeft32(a1+(b1^c1^d1)+x15, 8) + e1
+		c1 = bits.RotateLeft32(c1, 10)
+		a2 = bits.RotateLeft32(a2+(b2^(c2|^d2))+x12+0x50a28be6, 6) + e2
+		c2 = bits.RotateLeft32(c2, 10)
+
+		// round 2
+		e1 = bits.RotateLeft32(e1+(c1^(a2&(b1^c1)))+x7+0x5a827999, 7) + d1
+		b1 = bits.RotateLeft32(b1, 10)
+		e2 = bits.RotateLeft32(e2+(b2^(c2&(a1^b2)))+x6+0x5c4dd124, 9) + d2
+		b2 = bits.RotateLeft32(b2, 10)
+		d1 = bits.RotateLeft32(d1+(b1^(e1&(a2^b1)))+x4+0x5a827999, 6) + c1
+		a2 = bits.RotateLeft32(a2, 10)
+		d2 = bits.RotateLeft32(d2+(a1^(b2&(e2^a1)))+x11+0x5c4dd124, 13) + c2
+		a1 = bits.RotateLeft32(a1, 10)
+		c1 = bits.RotateLeft32(c1+(a2^(d1&(e1^a2)))+x13+0x5a827999, 8) + b1
+		e1 = bits.RotateLeft32(e1, 10)
+		c2 = bits.RotateLeft32(c2+(e2^(a1&(d2^e2)))+x3+0x5c4dd124, 15) + b2
+		e2 = bits.RotateLeft32(e2, 10)
+		b1 = bits.RotateLeft32(b1+(e1^(c1&(d1^e1)))+x1+0x5a827999, 13) + a2
+		d1 = bits.RotateLeft32(d1, 10)
+		b2 = bits.RotateLeft32(b2+(d2^(e2&(c2^d2)))+x7+0x5c4dd124, 7) + a1
+		d2 = bits.RotateLeft32(d2, 10)
+		a2 = bits.RotateLeft32(a2+(d1^(b1&(c1^d1)))+x10+0x5a827999, 11) + e1
+		c1 = bits.RotateLeft32(c1, 10)
+		a1 = bits.RotateLeft32(a1+(c2^(d2&(b2^c2)))+x0+0x5c4dd124, 12) + e2
+		c2 = bits.RotateLeft32(c2, 10)
+		e1 = bits.RotateLeft32(e1+(c1^(a2&(b1^c1)))+x6+0x5a827999, 9) + d1
+		b1 = bits.RotateLeft32(b1, 10)
+		e2 = bits.RotateLeft32(e2+(b2^(c2&(a1^b2)))+x13+0x5c4dd124, 8) + d2
+		b2 = bits.RotateLeft32(b2, 10)
+		d1 = bits.RotateLeft32(d1+(b1^(e1&(a2^b1)))+x15+0x5a827999, 7) + c1
+		a2 = bits.RotateLeft32(a2, 10)
+		d2 = bits.RotateLeft32(d2+(a1^(b2&(e2^a1)))+x5+0x5c4dd124, 9) + c2
+		a1 = bits.RotateLeft32(a1, 10)
+		c1 = bits.RotateLeft32(c1+(a2^(d1&(e1^a2)))+x3+0x5a827999, 15) + b1
+		e1 = bits.RotateLeft32(e1, 10)
+		c2 = bits.RotateLeft32(c2+(e2^(a1&(d2^e2)))+x10+0x5c4dd124, 11) + b2
+		e2 = bits.RotateLeft32(e2, 10)
+		b1 = bits.RotateLeft32(b1+(e1^(c1&(d1^e1)))+x12+0x5a827999, 7) + a2
+		d1 = bits.RotateLeft32(d1, 10)
+		b2 = bits.RotateLeft32(b2+(d2^(e2&(c2^d2)))+x14+0x5c4dd124, 7) + a1
+		d2 = bits.RotateLeft32(d2, 10)
+		a2 = bits.RotateLeft32(a2+(d1^(b1&(c1^d1)))+x0+0x5a827999, 12) + e1
+		c1 = bits.RotateLeft32(c1, 10)
+		a1 = bits.RotateLeft32(a1+(c2^(d2&(b2^c2)))+x15+0x5c4dd124, 7) + e2
+		c2 = bits.RotateLeft32(c2, 10)
+		e1 = bits.RotateLeft32(e1+(c1^(a2&(b1^c1)))+x9+0x5a827999, 15) + d1
+		b1 = bits.RotateLeft32(b1, 10)
+		e2 = bits.RotateLeft32(e2+(b2^(c2&(a1^b2)))+x8+0x5c4dd124, 12) + d2
+		b2 = bits.RotateLeft32(b2, 10)
+		d1 = bits.RotateLeft32(d1+(b1^(e1&(a2^b1)))+x5+0x5a827999, 9) + c1
+		a2 = bits.RotateLeft32(a2, 10)
+		d2 = bits.RotateLeft32(d2+(a1^(b2&(e2^a1)))+x12+0x5c4dd124, 7) + c2
+		a1 = bits.RotateLeft32(a1, 10)
+		c1 = bits.RotateLeft32(c1+(a2^(d1&(e1^a2)))+x2+0x5a827999, 11) + b1
+		e1 = bits.RotateLeft32(e1, 10)
+		c2 = bits.RotateLeft32(c2+(e2^(a1&(d2^e2)))+x4+0x5c4dd124, 6) + b2
+		e2 = bits.RotateLeft32(e2, 10)
+		b1 = bits.RotateLeft32(b1+(e1^(c1&(d1^e1)))+x14+0x5a827999, 7) + a2
+		d1 = bits.RotateLeft32(d1, 10)
+		b2 = bits.RotateLeft32(b2+(d2^(e2&(c2^d2)))+x9+0x5c4dd124, 15) + a1
+		d2 = bits.RotateLeft32(d2, 10)
+		a2 = bits.RotateLeft32(a2+(d1^(b1&(c1^d1)))+x11+0x5a827999, 13) + e1
+		c1 = bits.RotateLeft32(c1, 10)
+		a1 = bits.RotateLeft32(a1+(c2^(d2&(b2^c2)))+x1+0x5c4dd124, 13) + e2
+		c2 = bits.RotateLeft32(c2, 10)
+		e1 = bits.RotateLeft32(e1+(c1^(a2&(b1^c1)))+x8+0x5a827999, 12) + d1
+		b1 = bits.RotateLeft32(b1, 10)
+		e2 = bits.RotateLeft32(e2+(b2^(c2&(a1^b2)))+x2+0x5c4dd124, 11) + d2
+		b2 = bits.RotateLeft32(b2, 10)
+
+		// round 3
+		d1 = bits.RotateLeft32(d1+((e1|^a2)^b2)+x3+0x6ed9eba1, 11) + c1
+		a2 = bits.RotateLeft32(a2, 10)
+		d2 = bits.RotateLeft32(d2+((e2|^a1)^b1)+x15+0x6d703ef3, 9) + c2
+		a1 = bits.RotateLeft32(a1, 10)
+		c1 = bits.RotateLeft32(c1+((d1|^e1)^a2)+x10+0x6ed9eba1, 13) + b2
+		e1 = bits.RotateLeft32(e1, 10)
+		c2 = bits.RotateLeft32(c2+((d2|^e2)^a1)+x5+0x6d703ef3, 7) + b1
+		e2 = bits.RotateLeft32(e2, 10)
+		b2 = bits.RotateLeft32(b2+((c1|^d1)^e1)+x14+0x6ed9eba1, 6) + a2
+		d1 = bits.RotateLeft32(d1, 10)
+		b1 = bits.RotateLeft32(b1+((c2|^d2)^e2)+x1+0x6d703ef3, 15) + a1
+		d2 = bits.RotateLeft32(d2, 10)
+		a2 = bits.RotateLeft32(a2+((b2|^c1)^d1)+x4+0x6ed9eba1, 7) + e1
+		c1 = bits.RotateLeft32(c1, 10)
+		a1 = bits.RotateLeft32(a1+((b1|^c2)^d2)+x3+0x6d703ef3, 11) + e2
+		c2 = bits.RotateLeft32(c2, 10)
+		e1 = bits.RotateLeft32(e1+((a2|^b2)^c1)+x9+0x6ed9eba1, 14) + d1
+		b2 = bits.RotateLeft32(b2, 10)
+		e2 = bits.RotateLeft32(e2+((a1|^b1)^c2)+x7+0x6d703ef3, 8) + d2
+		b1 = bits.RotateLeft32(b1, 10)
+		d1 = bits.RotateLeft32(d1+((e1|^a2)^b2)+x15+0x6ed9eba1, 9) + c1
+		a2 = bits.RotateLeft32(a2, 10)
+		d2 = bits.RotateLeft32(d2+((e2|^a1)^b1)+x14+0x6d703ef3, 6) + c2
+		a1 = bits.RotateLeft32(a1, 10)
+		c1 = bits.RotateLeft32(c1+((d1|^e1)^a2)+x8+0x6ed9eba1, 13) + b2
+		e1 = bits.RotateLeft32(e1, 10)
+		c2 = bits.RotateLeft32(c2+((d2|^e2)^a1)+x6+0x6d703ef3, 6) + b1
+		e2 = bits.RotateLeft32(e2, 10)
+		b2 = bits.RotateLeft32(b2+((c1|^d1)^e1)+x1+0x6ed9eba1, 15) + a2
+		d1 = bits.RotateLeft32(d1, 10)
+		b1 = bits.RotateLeft32(b1+((c2|^d2)^e2)+x9+0x6d703ef3, 14) + a1
+		d2 = bits.RotateLeft32(d2, 10)
+		a2 = bits.RotateLeft32(a2+((b2|^c1)^d1)+x2+0x6ed9eba1, 14) + e1
+		c1 = bits.RotateLeft32(c1, 10)
+		a1 = bits.RotateLeft32(a1+((b1|^c2)^d2)+x11+0x6d703ef3, 12) + e2
+		c2 = bits.RotateLeft32(c2, 10)
+		e1 = bits.RotateLeft32(e1+((a2|^b2)^c1)+x7+0x6ed9eba1, 8) + d1
+		b2 = bits.RotateLeft32(b2, 10)
+		e2 = bits.RotateLeft32(e2+((a1|^b1)^c2)+x8+0x6d703ef3, 13) + d2
+		b1 = bits.RotateLeft32(b1, 10)
+		d1 = bits.RotateLeft32(d1+((e1|^a2)^b2)+x0+0x6ed9eba1, 13) + c1
+		a2 = bits.RotateLeft32(a2, 10)
+		d2 = bits.RotateLeft32(d2+((e2|^a1)^b1)+x12+0x6d703ef3, 5) + c2
+		a1 = bits.RotateLeft32(a1, 10)
+		c1 = bits.RotateLeft32(c1+((d1|^e1)^a2)+x6+0x6ed9eba1, 6) + b2
+		e1 = bits.RotateLeft32(e1, 10)
+		c2 = bits.RotateLeft32(c2+((d2|^e2)^a1)+x2+0x6d703ef3, 14) + b1
+		e2 = bits.RotateLeft32(e2, 10)
+		b2 = bits.RotateLeft32(b2+((c1|^d1)^e1)+x13+0x6ed9eba1, 5) + a2
+		d1 = bits.RotateLeft32(d1, 10)
+		b1 = bits.RotateLeft32(b1+((c2|^d2)^e2)+x10+0x6d703ef3, 13) + a1
+		d2 = bits.RotateLeft32(d2, 10)
+		a2 = bits.RotateLeft32(a2+((b2|^c1)^d1)+x11+0x6ed9eba1, 12) + e1
+		c1 = bits.RotateLeft32(c1, 10)
+		a1 = bits.RotateLeft32(a1+((b1|^c2)^d2)+x0+0x6d703ef3, 13) + e2
+		c2 = bits.RotateLeft32(c2, 10)
+		e1 = bits.RotateLeft32(e1+((a2|^b2)^c1)+x5+0x6ed9eba1, 7) + d1
+		b2 = bits.RotateLeft32(b2, 10)
+		e2 = bits.RotateLeft32(e2+((a1|^b1)^c2)+x4+0x6d703ef3, 7) + d2
+		b1 = bits.RotateLeft32(b1, 10)
+		d1 = bits.RotateLeft32(d1+((e1|^a2)^b2)+x12+0x6ed9eba1, 5) + c1
+		a2 = bits.RotateLeft32(a2, 10)
+		d2 = bits.RotateLeft32(d2+((e2|^a1)^b1)+x13+0x6d703ef3, 5) + c2
+		a1 = bits.RotateLeft32(a1, 10)
+
+		// round 4
+		c2 = bits.RotateLeft32(c2+(e1^(a2&(d1^e1)))+x1+0x8f1bbcdc, 11) + b2
+		e1 = bits.RotateLeft32(e1, 10)
+		c1 = bits.RotateLeft32(c1+(a1^(d2&(e2^a1)))+x8+0x7a6d76e9, 15) + b1
+		e2 = bits.RotateLeft32(e2, 10)
+		b2 = bits.RotateLeft32(b2+(d1^(e1&(c2^d1)))+x9+0x8f1bbcdc, 12) + a2
+		d1 = bits.RotateLeft32(d1, 10)
+		b1 = bits.RotateLeft32(b1+(e2^(c1&(d2^e2)))+x6+0x7a6d76e9, 5) + a1
+		d2 = bits.RotateLeft32(d2, 10)
+		a2 = bits.RotateLeft32(a2+(c2^(d1&(b2^c2)))+x11+0x8f1bbcdc, 14) + e1
+		c2 = bits.RotateLeft32(c2, 10)
+		a1 = bits.RotateLeft32(a1+(d2^(b1&(c1^d2)))+x4+0x7a6d76e9, 8) + e2
+		c1 = bits.RotateLeft32(c1, 10)
+		e1 = bits.RotateLeft32(e1+(b2^(c2&(a2^b2)))+x10+0x8f1bbcdc, 15) + d1
+		b2 = bits.RotateLeft32(b2, 10)
+		e2 = bits.RotateLeft32(e2+(c1^(a1&(b1^c1)))+x1+0x7a6d76e9, 11) + d2
+		b1 = bits.RotateLeft32(b1, 10)
+		d1 = bits.RotateLeft32(d1+(a2^(b2&(e1^a2)))+x0+0x8f1bbcdc, 14) + c2
+		a2 = bits.RotateLeft32(a2, 10)
+		d2 = bits.RotateLeft32(d2+(b1^(e2&(a1^b1)))+x3+0x7a6d76e9, 14) + c1
+		a1 = bits.RotateLeft32(a1, 10)
+		c2 = bits.RotateLeft32(c2+(e1^(a2&(d1^e1)))+x8+0x8f1bbcdc, 15) + b2
+		e1 = bits.RotateLeft32(e1, 10)
+		c1 = bits.RotateLeft32(c1+(a1^(d2&(e2^a1)))+x11+0x7a6d76e9, 14) + b1
+		e2 = bits.RotateLeft32(e2, 10)
+		b2 = bits.RotateLeft32(b2+(d1^(e1&(c2^d1)))+x12+0x8f1bbcdc, 9) + a2
+		d1 = bits.RotateLeft32(d1, 10)
+		b1 = bits.RotateLeft32(b1+(e2^(c1&(d2^e2)))+x15+0x7a6d76e9, 6) + a1
+		d2 = bits.RotateLeft32(d2, 10)
+		a2 = bits.RotateLeft32(a2+(c2^(d1&(b2^c2)))+x4+0x8f1bbcdc, 8) + e1
+		c2 = bits.RotateLeft32(c2, 10)
+		a1 = bits.RotateLeft32(a1+(d2^(b1&(c1^d2)))+x0+0x7a6d76e9, 14) + e2
+		c1 = bits.RotateLeft32(c1, 10)
+		e1 = bits.RotateLeft32(e1+(b2^(c2&(a2^b2)))+x13+0x8f1bbcdc, 9) + d1
+		b2 = bits.RotateLeft32(b2, 10)
+		e2 = bits.RotateLeft32(e2+(c1^(a1&(b1^c1)))+x5+0x7a6d76e9, 6) + d2
+		b1 = bits.RotateLeft32(b1, 10)
+		d1 = bits.RotateLeft32(d1+(a2^(b2&(e1^a2)))+x3+0x8f1bbcdc, 14) + c2
+		a2 = bits.RotateLeft32(a2, 10)
+		d2 = bits.RotateLeft32(d2+(b1^(e2&(a1^b1)))+x12+0x7a6d76e9, 9) + c1
+		a1 = bits.RotateLeft32(a1, 10)
+		c2 = bits.RotateLeft32(c2+(e1^(a2&(d1^e1)))+x7+0x8f1bbcdc, 5) + b2
+		e1 = bits.RotateLeft32(e1, 10)
+		c1 = bits.RotateLeft32(c1+(a1^(d2&(e2^a1)))+x2+0x7a6d76e9, 12) + b1
+		e2 = bits.RotateLeft32(e2, 10)
+		b2 = bits.RotateLeft32(b2+(d1^(e1&(c2^d1)))+x15+0x8f1bbcdc, 6) + a2
+		d1 = bits.RotateLeft32(d1, 10)
+		b1 = bits.RotateLeft32(b1+(e2^(c1&(d2^e2)))+x13+0x7a6d76e9, 9) + a1
+		d2 = bits.RotateLeft32(d2, 10)
+		a2 = bits.RotateLeft32(a2+(c2^(d1&(b2^c2)))+x14+0x8f1bbcdc, 8) + e1
+		c2 = bits.RotateLeft32(c2, 10)
+		a1 = bits.RotateLeft32(a1+(d2^(b1&(c1^d2)))+x9+0x7a6d76e9, 12) + e2
+		c1 = bits.RotateLeft32(c1, 10)
+		e1 = bits.RotateLeft32(e1+(b2^(c2&(a2^b2)))+x5+0x8f1bbcdc, 6) + d1
+		b2 = bits.RotateLeft32(b2, 10)
+		e2 = bits.RotateLeft32(e2+(c1^(a1&(b1^c1)))+x7+0x7a6d76e9, 5) + d2
+		b1 = bits.RotateLeft32(b1, 10)
+		d1 = bits.RotateLeft32(d1+(a2^(b2&(e1^a2)))+x6+0x8f1bbcdc, 5) + c2
+		a2 = bits.RotateLeft32(a2, 10)
+		d2 = bits.RotateLeft32(d2+(b1^(e2&(a1^b1)))+x10+0x7a6d76e9, 15) + c1
+		a1 = bits.RotateLeft32(a1, 10)
+		c2 = bits.RotateLeft32(c2+(e1^(a2&(d1^e1)))+x2+0x8f1bbcdc, 12) + b2
+		e1 = bits.RotateLeft32(e1, 10)
+		c1 = bits.RotateLeft32(c1+(a1^(d2&(e2^a1)))+x14+0x7a6d76e9, 8) + b1
+		e2 = bits.RotateLeft32(e2, 10)
+
+		// round 5
+		b2 = bits.RotateLeft32(b2+(c2^(d2|^e1))+x4+0xa953fd4e, 9) + a2
+		d2 = bits.RotateLeft32(d2, 10)
+		b1 = bits.RotateLeft32(b1+(c1^d1^e2)+x12, 8) + a1
+		d1 = bits.RotateLeft32(d1, 10)
+		a2 = bits.RotateLeft32(a2+(b2^(c2|^d2))+x0+0xa953fd4e, 15) + e1
+		c2 = bits.RotateLeft32(c2, 10)
+		a1 = bits.RotateLeft32(a1+(b1^c1^d1)+x15, 5) + e2
+		c1 = bits.RotateLeft32(c1, 10)
+		e1 = bits.RotateLeft32(e1+(a2^(b2|^c2))+x5+0xa953fd4e, 5) + d2
+		b2 = bits.RotateLeft32(b2, 10)
+		e2 = bits.RotateLeft32(e2+(a1^b1^c1)+x10, 12) + d1
+		b1 = bits.RotateLeft32(b1, 10)
+		d2 = bits.RotateLeft32(d2+(e1^(a2|^b2))+x9+0xa953fd4e, 11) + c2
+		a2 = bits.RotateLeft32(a2, 10)
+		d1 = bits.RotateLeft32(d1+(e2^a1^b1)+x4, 9) + c1
+		a1 = bits.RotateLeft32(a1, 10)
+		c2 = bits.RotateLeft32(c2+(d2^(e1|^a2))+x7+0xa953fd4e, 6) + b2
+		e1 = bits.RotateLeft32(e1, 10)
+		c1 = bits.RotateLeft32(c1+(d1^e2^a1)+x1, 12) + b1
+		e2 = bits.RotateLeft32(e2, 10)
+		b2 = bits.RotateLeft32(b2+(c2^(d2|^e1))+x12+0xa953fd4e, 8) + a2
+		d2 = bits.RotateLeft32(d2, 10)
+		b1 = bits.RotateLeft32(b1+(c1^d1^e2)+x5, 5) + a1
+		d1 = bits.RotateLeft32(d1, 10)
+		a2 = bits.RotateLeft32(a2+(b2^(c2|^d2))+x2+0xa953fd4e, 13) + e1
+		c2 = bits.RotateLeft32(c2, 10)
+		a1 = bits.RotateLeft32(a1+(b1^c1^d1)+x8, 14) + e2
+		c1 = bits.RotateLeft32(c1, 10)
+		e1 = bits.RotateLeft32(e1+(a2^(b2|^c2))+x10+0xa953fd4e, 12) + d2
+		b2 = bits.RotateLeft32(b2, 10)
+		e2 = bits.RotateLeft32(e2+(a1^b1^c1)+x7, 6) + d1
+		b1 = bits.RotateLeft32(b1, 10)
+		d2 = bits.RotateLeft32(d2+(e1^(a2|^b2))+x14+0xa953fd4e, 5) + c2
+		a2 = bits.RotateLeft32(a2, 10)
+		d1 = bits.RotateLeft32(d1+(e2^a1^b1)+x6, 8) + c1
+		a1 = bits.RotateLeft32(a1, 10)
+		c2 = bits.RotateLeft32(c2+(d2^(e1|^a2))+x1+0xa953fd4e, 12) + b2
+		e1 = bits.RotateLeft32(e1, 10)
+		c1 = bits.RotateLeft32(c1+(d1^e2^a1)+x2, 13) + b1
+		e2 = bits.RotateLeft32(e2, 10)
+		b2 = bits.RotateLeft32(b2+(c2^(d2|^e1))+x3+0xa953fd4e, 13) + a2
+		d2 = bits.RotateLeft32(d2, 10)
+		b1 = bits.RotateLeft32(b1+(c1^d1^e2)+x13, 6) + a1
+		d1 = bits.RotateLeft32(d1, 10)
+		a2 = bits.RotateLeft32(a2+(b2^(c2|^d2))+x8+0xa953fd4e, 14) + e1
+		c2 = bits.RotateLeft32(c2, 10)
+		a1 = bits.RotateLeft32(a1+(b1^c1^d1)+x14, 5) + e2
+		c1 = bits.RotateLeft32(c1, 10)
+		e1 = bits.RotateLeft32(e1+(a2^(b2|^c2))+x11+0xa953fd4e, 11) + d2
+		b2 = bits.RotateLeft32(b2, 10)
+		e2 = bits.RotateLeft32(e2+(a1^b1^c1)+x0, 15) + d1
+		b1 = bits.RotateLeft32(b1, 10)
+		d2 = bits.RotateLeft32(d2+(e1^(a2|^b2))+x6+0xa953fd4e, 8) + c2
+		a2 = bits.RotateLeft32(a2, 10)
+		d1 = bits.RotateLeft32(d1+(e2^a1^b1)+x3, 13) + c1
+		a1 = bits.RotateLeft32(a1, 10)
+		c2 = bits.RotateLeft32(c2+(d2^(e1|^a2))+x15+0xa953fd4e, 5) + b2
+		e1 = bits.RotateLeft32(e1, 10)
+		c1 = bits.RotateLeft32(c1+(d1^e2^a1)+x9, 11) + b1
+		e2 = bits.RotateLeft32(e2, 10)
+		b2 = bits.RotateLeft32(b2+(c2^(d2|^e1))+x13+0xa953fd4e, 6) + a2
+		d2 = bits.RotateLeft32(d2, 10)
+		b1 = bits.RotateLeft32(b1+(c1^d1^e2)+x11, 11) + a1
+		d1 = bits.RotateLeft32(d1, 10)
+
+		s[0] += a2
+		s[1] += b2
+		s[2] += c2
+		s[3] += d2
+		s[4] += e2
+		s[5] += a1
+		s[6] += b1
+		s[7] += c1
+		s[8] += d1
+		s[9] += e1
+
+		p = p[blockSize:]
+	}
+}
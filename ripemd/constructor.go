@@ -1,35 +1,28 @@
 // Package ripemd implements the RIPEMD hash algorithm.
+//
+// The variants share the buffering and the padding, and the compression functions
+// are fully unrolled by gen.go.
 package ripemd
 
 import "hash"
 
-// New128 creates a new RIPMD-128 hash.Hash.
-func New128() hash.Hash {
-	r := new(modelRipeMd128)
-	r.Reset()
+//go:generate go run gen.go
 
-	return r
-}
+// New128 creates a new RIPMD-128 hash.Hash.
+func New128() hash.Hash { return newModel(&variant128) }
 
 // New160 creates a new RIPMD-160 hash.Hash.
-func New160() hash.Hash {
-	result := new(modelRipeMd160)
-	result.Reset()
-
-	return result
-}
+func New160() hash.Hash { return newModel(&variant160) }
 
 // New256 creates a new RIPMD-256 hash.Hash.
-func New256() hash.Hash {
-	r := new(modelRipeMd256)
-	r.Reset()
-
-	return r
-}
+func New256() hash.Hash { return newModel(&variant256) }
 
 // New320 creates a new RIPMD-320 hash.Hash.
-func New320() hash.Hash {
-	r := new(modelRipeMd320)
+func New320() hash.Hash { return newModel(&variant320) }
+
+// newModel creates a new hash.Hash of the variant.
+func newModel(v *variant) hash.Hash {
+	r := &model{variant: v}
 	r.Reset()
 
 	return r
@@ -0,0 +1,223 @@
+//go:build ignore
+
+// This program generates compress.go, run it with "go generate".
+//
+// The generated compression functions are fully unrolled. Instead of moving the words of the state
+// after every step, the generator renames them, so the message words and the rotations are constants.
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"os"
+)
+
+// message word indices of the left and the right lines.
+var (
+	indicesLeft = [80]int{
+		0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15,
+		7, 4, 13, 1, 10, 6, 15, 3, 12, 0, 9, 5, 2, 14, 11, 8,
+		3, 10, 14, 4, 9, 15, 8, 1, 2, 7, 0, 6, 13, 11, 5, 12,
+		1, 9, 11, 10, 0, 8, 12, 4, 13, 3, 7, 15, 14, 5, 6, 2,
+		4, 0, 5, 9, 7, 12, 2, 10, 14, 1, 3, 8, 11, 6, 15, 13,
+	}
+	indicesRight = [80]int{
+		5, 14, 7, 0, 9, 2, 11, 4, 13, 6, 15, 8, 1, 10, 3, 12,
+		6, 11, 3, 7, 0, 13, 5, 10, 14, 15, 8, 12, 4, 9, 1, 2,
+		15, 5, 1, 3, 7, 14, 6, 9, 11, 8, 12, 2, 10, 0, 4, 13,
+		8, 6, 4, 1, 3, 11, 15, 0, 5, 12, 2, 13, 9, 7, 10, 14,
+		12, 15, 10, 4, 1, 5, 8, 7, 6, 2, 13, 14, 0, 3, 9, 11,
+	}
+)
+
+// rotations of the left and the right lines.
+var (
+	rotationsLeft = [80]int{
+		11, 14, 15, 12, 5, 8, 7, 9, 11, 13, 14, 15, 6, 7, 9, 8,
+		7, 6, 8, 13, 11, 9, 7, 15, 7, 12, 15, 9, 11, 7, 13, 12,
+		11, 13, 6, 7, 14, 9, 13, 15, 14, 8, 13, 6, 5, 12, 7, 5,
+		11, 12, 14, 15, 14, 15, 9, 8, 9, 14, 5, 6, 8, 6, 5, 12,
+		9, 15, 5, 11, 6, 8, 13, 12, 5, 12, 13, 14, 11, 8, 5, 6,
+	}
+	rotationsRight = [80]int{
+		8, 9, 9, 11, 13, 15, 15, 5, 7, 7, 8, 11, 14, 14, 12, 6,
+		9, 13, 15, 7, 12, 8, 9, 11, 7, 7, 12, 7, 6, 15, 13, 11,
+		9, 7, 15, 11, 8, 6, 6, 14, 12, 13, 5, 14, 13, 13, 7, 5,
+		15, 5, 8, 11, 14, 14, 6, 14, 6, 9, 12, 9, 12, 5, 15, 8,
+		8, 5, 12, 9, 12, 5, 14, 6, 8, 13, 6, 5, 15, 13, 11, 11,
+	}
+)
+
+// functions are the boolean functions of the rounds, f1 to f5.
+// The selections f2 = x&y | ^x&z and f4 = x&z | y&^z are written with one operation less.
+var functions = [5]string{
+	"%[1]s ^ %[2]s ^ %[3]s",
+	"%[3]s ^ (%[1]s & (%[2]s ^ %[3]s))",
+	"(%[1]s | ^%[2]s) ^ %[3]s",
+	"%[2]s ^ (%[3]s & (%[1]s ^ %[2]s))",
+	"%[1]s ^ (%[2]s | ^%[3]s)",
+}
+
+// constants of the rounds of the left line, and of the right lines with four and five rounds.
+var (
+	constantsLeft   = [5]uint32{0, 0x5a827999, 0x6ed9eba1, 0x8f1bbcdc, 0xa953fd4e}
+	constantsRight4 = [4]uint32{0x50a28be6, 0x5c4dd124, 0x6d703ef3, 0}
+	constantsRight5 = [5]uint32{0x50a28be6, 0x5c4dd124, 0x6d703ef3, 0x7a6d76e9, 0}
+)
+
+// variant represents the shape of a RIPEMD compression function.
+type variant struct {
+	bits int
+	// words is the number of words of each line, 4 or 5.
+	words int
+	// swaps are the roles of the words swapped between the lines after every round, nil for no swaps.
+	swaps []int
+}
+
+var variants = []variant{
+	{bits: 128, words: 4},
+	{bits: 160, words: 5},
+	{bits: 256, words: 4, swaps: []int{0, 1, 2, 3}},
+	{bits: 320, words: 5, swaps: []int{1, 3, 0, 2, 4}},
+}
+
+func main() {
+	var buf bytes.Buffer
+
+	fmt.Fprintln(&buf, "// Code generated by gen.go. DO NOT EDIT.")
+	fmt.Fprintln(&buf)
+	fmt.Fprintln(&buf, "package ripemd")
+	fmt.Fprintln(&buf)
+	fmt.Fprintln(&buf, `import (`)
+	fmt.Fprintln(&buf, `	"encoding/binary"`)
+	fmt.Fprintln(&buf, `	"math/bits"`)
+	fmt.Fprintln(&buf, `)`)
+
+	for _, v := range variants {
+		compress(&buf, v)
+	}
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		panic(err)
+	}
+
+	if err = os.WriteFile("compress.go", src, 0o644); err != nil {
+		panic(err)
+	}
+}
+
+// compress writes the compression function of the variant, which processes every full block of p.
+func compress(buf *bytes.Buffer, v variant) {
+	rounds := v.words
+	names := []string{"a", "b", "c", "d", "e"}[:v.words]
+
+	left := make([]string, v.words)
+	right := make([]string, v.words)
+	for i, name := range names {
+		left[i] = name + "1"
+		right[i] = name + "2"
+	}
+
+	fmt.Fprintln(buf)
+	fmt.Fprintf(buf, "// compress%d runs the RIPEMD-%d compression function on every full block of p.\n", v.bits, v.bits)
+	fmt.Fprintf(buf, "func compress%d(s *[10]uint32, p []byte) {\n", v.bits)
+	fmt.Fprintln(buf, "for len(p) >= blockSize {")
+
+	for i := 0; i < 16; i++ {
+		fmt.Fprintf(buf, "x%d := binary.LittleEndian.Uint32(p[%d:])\n", i, 4*i)
+	}
+
+	fmt.Fprintln(buf)
+
+	offset := 0
+	if v.swaps != nil {
+		offset = v.words
+	}
+
+	for i := range left {
+		fmt.Fprintf(buf, "%s, %s := s[%d], s[%d]\n", left[i], right[i], i, offset+i)
+	}
+
+	for round := 0; round < rounds; round++ {
+		fmt.Fprintln(buf)
+		fmt.Fprintf(buf, "// round %d\n", round+1)
+
+		constantRight := constantsRight5[round]
+		if rounds == 4 {
+			constantRight = constantsRight4[round]
+		}
+
+		for i := 16 * round; i < 16*(round+1); i++ {
+			left = step(buf, left, functions[round], indicesLeft[i], rotationsLeft[i], constantsLeft[round])
+			right = step(buf, right, functions[rounds-1-round], indicesRight[i], rotationsRight[i], constantRight)
+		}
+
+		if v.swaps != nil {
+			k := v.swaps[round]
+			left[k], right[k] = right[k], left[k]
+		}
+	}
+
+	fmt.Fprintln(buf)
+
+	switch {
+	case v.swaps != nil:
+		for i := range left {
+			fmt.Fprintf(buf, "s[%d] += %s\n", i, left[i])
+		}
+
+		for i := range right {
+			fmt.Fprintf(buf, "s[%d] += %s\n", offset+i, right[i])
+		}
+	default:
+		// the words are combined crosswise
+		results := make([]string, v.words)
+		for i := range results {
+			results[i] = fmt.Sprintf("s[%d] + %s + %s", (i+1)%v.words, left[(i+2)%v.words], right[(i+3)%v.words])
+		}
+
+		for i := range results {
+			fmt.Fprintf(buf, "s[%d]", i)
+			if i < v.words-1 {
+				fmt.Fprint(buf, ", ")
+			}
+		}
+
+		fmt.Fprint(buf, " = ")
+
+		for i, result := range results {
+			fmt.Fprint(buf, result)
+			if i < v.words-1 {
+				fmt.Fprint(buf, ", ")
+			}
+		}
+
+		fmt.Fprintln(buf)
+	}
+
+	fmt.Fprintln(buf)
+	fmt.Fprintln(buf, "p = p[blockSize:]")
+	fmt.Fprintln(buf, "}")
+	fmt.Fprintln(buf, "}")
+}
+
+// step writes one step of a line and returns the new roles of the words.
+func step(buf *bytes.Buffer, w []string, function string, index, rotation int, constant uint32) []string {
+	sum := fmt.Sprintf("%s + (%s) + x%d", w[0], fmt.Sprintf(function, w[1], w[2], w[3]), index)
+	if constant != 0 {
+		sum += fmt.Sprintf(" + %#08x", constant)
+	}
+
+	if len(w) == 4 {
+		fmt.Fprintf(buf, "%s = bits.RotateLeft32(%s, %d)\n", w[0], sum, rotation)
+
+		return []string{w[3], w[0], w[1], w[2]}
+	}
+
+	fmt.Fprintf(buf, "%s = bits.RotateLeft32(%s, %d) + %s\n", w[0], sum, rotation, w[4])
+	fmt.Fprintf(buf, "%s = bits.RotateLeft32(%s, 10)\n", w[2], w[2])
+
+	return []string{w[4], w[0], w[1], w[2], w[3]}
+}
@@ -0,0 +1,114 @@
+package ripemd
+
+import "encoding/binary"
+
+// variant represents the differences between the RIPEMD hash functions.
+type variant struct {
+	size    int
+	initial [10]uint32
+}
+
+var (
+	variant128 = variant{size: Size128, initial: [10]uint32{_s0, _s1, _s2, _s3}}
+	variant160 = variant{size: Size160, initial: [10]uint32{_s0, _s1, _s2, _s3, _s4}}
+	variant256 = variant{size: Size256, initial: [10]uint32{_s0, _s1, _s2, _s3, _s5, _s6, _s7, _s8}}
+	variant320 = variant{size: Size320, initial: [10]uint32{_s0, _s1, _s2, _s3, _s4, _s5, _s6, _s7, _s8, _s9}}
+)
+
+// compress runs the compression function of the variant on every full block of p.
+// The functions are called directly, so the state and the blocks of Sum stay on the stack.
+func (r *variant) compress(s *[10]uint32, p []byte) {
+	switch r.size {
+	case Size128:
+		compress128(s, p)
+	case Size160:
+		compress160(s, p)
+	case Size256:
+		compress256(s, p)
+	default:
+		compress320(s, p)
+	}
+}
+
+// model represents a structure for the RIPEMD hash.Hash.
+// It buffers and pads the data for the compression function of its variant.
+type model struct {
+	sum            [10]uint32
+	buffer         [blockSize]byte
+	bufferIndex    int
+	processedBytes uint64
+	variant        *variant
+}
+
+// implementation of the hash.Hash
+
+// Reset resets the hash.Hash to its initial state.
+func (r *model) Reset() {
+	r.sum = r.variant.initial
+	r.bufferIndex = 0
+	r.processedBytes = 0
+}
+
+// Size returns the number of bytes Sum will return.
+func (r *model) Size() int { return r.variant.size }
+
+// BlockSize returns the hash's underlying block size.
+// The Write method must be able to accept any amount of data,
+// but it may operate more efficiently if all writes are a multiple of the update size.
+func (r *model) BlockSize() int { return blockSize }
+
+// Write appends the data to the digest.
+func (r *model) Write(p []byte) (int, error) {
+	n := len(p)
+	r.processedBytes += uint64(n)
+
+	if r.bufferIndex > 0 {
+		x := copy(r.buffer[r.bufferIndex:], p)
+		r.bufferIndex += x
+		p = p[x:]
+
+		if r.bufferIndex < blockSize {
+			return n, nil
+		}
+
+		r.variant.compress(&r.sum, r.buffer[:])
+		r.bufferIndex = 0
+	}
+
+	if len(p) >= blockSize {
+		blocks := len(p) - len(p)%blockSize
+		r.variant.compress(&r.sum, p[:blocks])
+		p = p[blocks:]
+	}
+
+	r.bufferIndex = copy(r.buffer[:], p)
+
+	return n, nil
+}
+
+// Sum appends the current hash to b and returns the resulting slice.
+// It does not change the underlying hash state.
+func (r *model) Sum(b []byte) []byte {
+	// copy the state and the buffer to allow other writes to continue and to prevent change of the state
+	sum := r.sum
+
+	// pad with 0x80 and zeros to 56 bytes modulo 64, and append the length in bits
+	var tmp [2 * blockSize]byte
+	copy(tmp[:], r.buffer[:r.bufferIndex])
+	tmp[r.bufferIndex] = 0x80
+
+	n := blockSize
+	if r.bufferIndex >= blockSize-8 {
+		n += blockSize
+	}
+
+	binary.LittleEndian.PutUint64(tmp[n-8:], r.processedBytes<<3)
+	r.variant.compress(&sum, tmp[:n])
+
+	var digest [Size320]byte
+	for i, s := range sum {
+		binary.LittleEndian.PutUint32(digest[4*i:], s)
+	}
+
+	return append(b, digest[:r.variant.size]...)
+}
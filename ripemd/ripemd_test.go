@@ -0,0 +1,122 @@
+package ripemd
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"hash"
+	"math/rand"
+	"strings"
+	"testing"
+
+	"golang.org/x/crypto/ripemd160"
+)
+
+func TestBitcoinHash160(t *testing.T) {
+	// the public key and its HASH160 of the version 1 address example of the Bitcoin wiki
+	publicKey, _ := hex.DecodeString("0250863ad64a87ae8a2fe83c1af1a8403cb53f53e486d8511dad8a04887e5b2352")
+	expected := "f54a5851e9372b87810a8e60cdd2e7cfd80b6e31"
+
+	digest := sha256.Sum256(publicKey)
+	h := New160()
+	h.Write(digest[:])
+
+	if got := hex.EncodeToString(h.Sum(nil)); got != expected {
+		t.Errorf("hash160 is wrong:\n\texpected \"%s\"\n\tgot \"%s\"", expected, got)
+	}
+}
+
+func TestVectors(t *testing.T) {
+	// the test vectors of the RIPEMD family homepage of Antoon Bosselaers
+	cases := []struct {
+		newHash  func() hash.Hash
+		input    string
+		expected string
+	}{
+		{New128, "", "cdf26213a150dc3ecb610f18f6b38b46"},
+		{New128, "a", "86be7afa339d0fc7cfc785e72f578d33"},
+		{New128, "abc", "c14a12199c66e4ba84636b0f69144c77"},
+		{New128, "message digest", "9e327b3d6e523062afc1132d7df9d1b8"},
+		{New128, "abcdefghijklmnopqrstuvwxyz", "fd2aa607f71dc8f510714922b371834e"},
+		{New128, "abcdbcdecdefdefgefghfghighijhijkijkljklmklmnlmnomnopnopq", "a1aa0689d0fafa2ddc22e88b49133a06"},
+		{New128, "ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789", "d1e959eb179c911faea4624c60c5c702"},
+		{New128, strings.Repeat("1234567890", 8), "3f45ef194732c2dbb2c4a2c769795fa3"},
+		{New128, strings.Repeat("a", 1000000), "4a7f5723f954eba1216c9d8f6320431f"},
+		{New160, "", "9c1185a5c5e9fc54612808977ee8f548b2258d31"},
+		{New160, "a", "0bdc9d2d256b3ee9daae347be6f4dc835a467ffe"},
+		{New160, "abc", "8eb208f7e05d987a9b044a8e98c6b087f15a0bfc"},
+		{New160, "message digest", "5d0689ef49d2fae572b881b123a85ffa21595f36"},
+		{New160, "abcdefghijklmnopqrstuvwxyz", "f71c27109c692c1b56bbdceb5b9d2865b3708dbc"},
+		{New160, "abcdbcdecdefdefgefghfghighijhijkijkljklmklmnlmnomnopnopq", "12a053384a9c0c88e405a06c27dcf49ada62eb2b"},
+		{New160, "ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789", "b0e20b6e3116640286ed3a87a5713079b21f5189"},
+		{New160, strings.Repeat("1234567890", 8), "9b752e45573d4b39f4dbd3323cab82bf63326bfb"},
+		{New160, strings.Repeat("a", 1000000), "52783243c1697bdbe16d37f97f68f08325dc1528"},
+		{New256, "", "02ba4c4e5f8ecd1877fc52d64d30e37a2d9774fb1e5d026380ae0168e3c5522d"},
+		{New256, "a", "f9333e45d857f5d90a91bab70a1eba0cfb1be4b0783c9acfcd883a9134692925"},
+		{New256, "abc", "afbd6e228b9d8cbbcef5ca2d03e6dba10ac0bc7dcbe4680e1e42d2e975459b65"},
+		{New256, "message digest", "87e971759a1ce47a514d5c914c392c9018c7c46bc14465554afcdf54a5070c0e"},
+		{New256, "abcdefghijklmnopqrstuvwxyz", "649d3034751ea216776bf9a18acc81bc7896118a5197968782dd1fd97d8d5133"},
+		{New256, "abcdbcdecdefdefgefghfghighijhijkijkljklmklmnlmnomnopnopq", "3843045583aac6c8c8d9128573e7a9809afb2a0f34ccc36ea9e72f16f6368e3f"},
+		{New256, "ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789", "5740a408ac16b720b84424ae931cbb1fe363d1d0bf4017f1a89f7ea6de77a0b8"},
+		{New256, strings.Repeat("1234567890", 8), "06fdcc7a409548aaf91368c06a6275b553e3f099bf0ea4edfd6778df89a890dd"},
+		{New256, strings.Repeat("a", 1000000), "ac953744e10e31514c150d4d8d7b677342e33399788296e43ae4850ce4f97978"},
+		{New320, "", "22d65d5661536cdc75c1fdf5c6de7b41b9f27325ebc61e8557177d705a0ec880151c3a32a00899b8"},
+		{New320, "a", "ce78850638f92658a5a585097579926dda667a5716562cfcf6fbe77f63542f99b04705d6970dff5d"},
+		{New320, "abc", "de4c01b3054f8930a79d09ae738e92301e5a17085beffdc1b8d116713e74f82fa942d64cdbc4682d"},
+		{New320, "message digest", "3a8e28502ed45d422f68844f9dd316e7b98533fa3f2a91d29f84d425c88d6b4eff727df66a7c0197"},
+		{New320, "abcdefghijklmnopqrstuvwxyz", "cabdb1810b92470a2093aa6bce05952c28348cf43ff60841975166bb40ed234004b8824463e6b009"},
+		{New320, "abcdbcdecdefdefgefghfghighijhijkijkljklmklmnlmnomnopnopq", "d034a7950cf722021ba4b84df769a5de2060e259df4c9bb4a4268c0e935bbc7470a969c9d072a1ac"},
+		{New320, "ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789", "ed544940c86d67f250d232c30b7b3e5770e0c60c8cb9a4cafe3b11388af9920e1b99230b843c86a4"},
+		{New320, strings.Repeat("1234567890", 8), "557888af5f6d8ed62ab66945c6d2a0a47ecd5341e915eb8fea1d0524955f825dc717e4a008ab2d42"},
+		{New320, strings.Repeat("a", 1000000), "bdee37f4371e20646b8b0d862dda16292ae36f40965e8c8509e63d1dbddecc503e2b63eb9245bb66"},
+	}
+
+	for _, c := range cases {
+		h := c.newHash()
+		h.Write([]byte(c.input))
+
+		if got := hex.EncodeToString(h.Sum(nil)); got != c.expected {
+			t.Errorf("sum of %d bytes of size %d is wrong:\n\texpected \"%s\"\n\tgot \"%s\"", len(c.input), h.Size(), c.expected, got)
+		}
+	}
+}
+
+func TestRipeMd160(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+
+	for n := 0; n < 1000; n++ {
+		data := make([]byte, n)
+		rnd.Read(data)
+
+		expected := ripemd160.New()
+		expected.Write(data)
+
+		h := New160()
+		h.Write(data)
+
+		if !bytes.Equal(expected.Sum(nil), h.Sum(nil)) {
+			t.Fatalf("sum of %d bytes is wrong:\n\texpected \"%x\"\n\tgot \"%x\"", n, expected.Sum(nil), h.Sum(nil))
+		}
+	}
+}
+
+func benchmarkRipeMd(b *testing.B, h hash.Hash) {
+	data := make([]byte, 8192)
+
+	b.SetBytes(int64(len(data)))
+	for i := 0; i < b.N; i++ {
+		h.Reset()
+		h.Write(data)
+		h.Sum(nil)
+	}
+}
+
+func BenchmarkRipeMd128(b *testing.B) { benchmarkRipeMd(b, New128()) }
+
+func BenchmarkRipeMd160(b *testing.B) { benchmarkRipeMd(b, New160()) }
+
+func BenchmarkRipeMd256(b *testing.B) { benchmarkRipeMd(b, New256()) }
+
+func BenchmarkRipeMd320(b *testing.B) { benchmarkRipeMd(b, New320()) }
+
+func BenchmarkRipeMd160Reference(b *testing.B) { benchmarkRipeMd(b, ripemd160.New()) }
@@ -0,0 +1,24 @@
+package siphash
+
+const (
+	// KeySize is the size of a SipHash key in bytes.
+	KeySize = 16
+	// Size64 is the size of a 64-bit SipHash checksum in bytes.
+	Size64 = 8
+	// Size128 is the size of a 128-bit SipHash checksum in bytes.
+	Size128 = 16
+	// BlockSize is the block size of SipHash in bytes.
+	BlockSize = 8
+
+	// CompressionRounds24 and FinalizationRounds24 are the rounds of SipHash-2-4.
+	CompressionRounds24  = 2
+	FinalizationRounds24 = 4
+	// CompressionRounds13 and FinalizationRounds13 are the rounds of SipHash-1-3.
+	CompressionRounds13  = 1
+	FinalizationRounds13 = 3
+
+	initial0 = 0x736f6d6570736575
+	initial1 = 0x646f72616e646f6d
+	initial2 = 0x6c7967656e657261
+	initial3 = 0x7465646279746573
+)
@@ -0,0 +1,53 @@
+// Package siphash implements the SipHash keyed hash algorithms with any number of rounds and 64 or 128-bit output,
+// like SipHash-2-4 and SipHash-1-3.
+//
+// The checksums are the little-endian bytes written by the reference implementation.
+package siphash
+
+import (
+	"errors"
+	"fmt"
+	"hash"
+)
+
+var (
+	ErrKeySize = errors.New("siphash: invalid key size")
+	ErrRounds  = errors.New("siphash: invalid number of rounds")
+)
+
+// New64 creates a new SipHash-c-d hash.Hash64 of 64 bits with the 16-byte key.
+func New64(key []byte, c, d int) (hash.Hash64, error) {
+	r, err := newModel(key, c, d, Size64)
+	if err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
+// New128 creates a new SipHash-c-d hash.Hash of 128 bits with the 16-byte key.
+func New128(key []byte, c, d int) (hash.Hash, error) {
+	r, err := newModel(key, c, d, Size128)
+	if err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
+// newModel creates a new SipHash model after validating its parameters.
+func newModel(key []byte, c, d, size int) (*model, error) {
+	if len(key) != KeySize {
+		return nil, fmt.Errorf("%w: %d bytes instead of %d", ErrKeySize, len(key), KeySize)
+	}
+
+	if c < 1 || d < 1 {
+		return nil, fmt.Errorf("%w: SipHash-%d-%d", ErrRounds, c, d)
+	}
+
+	r := &model{c: c, d: d, size: size}
+	copy(r.key[:], key)
+	r.Reset()
+
+	return r, nil
+}
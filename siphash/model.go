@@ -0,0 +1,139 @@
+package siphash
+
+import (
+	"encoding/binary"
+	"math/bits"
+)
+
+// model represents a structure for the SipHash hash.Hash.
+type model struct {
+	key    [KeySize]byte
+	c, d   int
+	size   int
+	v      [4]uint64
+	buf    [BlockSize]byte
+	bufLen int
+	total  uint64
+}
+
+// implementation of the hash.Hash
+
+// Reset resets the hash.Hash to its initial state.
+func (r *model) Reset() {
+	k0 := binary.LittleEndian.Uint64(r.key[0:])
+	k1 := binary.LittleEndian.Uint64(r.key[8:])
+	r.v = [4]uint64{k0 ^ initial0, k1 ^ initial1, k0 ^ initial2, k1 ^ initial3}
+
+	if r.size == Size128 {
+		r.v[1] ^= 0xee
+	}
+
+	r.bufLen = 0
+	r.total = 0
+}
+
+// Size returns the number of bytes Sum will return.
+func (r *model) Size() int { return r.size }
+
+// BlockSize returns the hash's underlying block size.
+func (r *model) BlockSize() int { return BlockSize }
+
+// Write appends the data to the digest.
+func (r *model) Write(p []byte) (int, error) {
+	n := len(p)
+	r.total += uint64(n)
+
+	if r.bufLen > 0 {
+		x := copy(r.buf[r.bufLen:], p)
+		r.bufLen += x
+		p = p[x:]
+
+		if r.bufLen < BlockSize {
+			return n, nil
+		}
+
+		r.compress(binary.LittleEndian.Uint64(r.buf[:]))
+		r.bufLen = 0
+	}
+
+	for ; len(p) >= BlockSize; p = p[BlockSize:] {
+		r.compress(binary.LittleEndian.Uint64(p))
+	}
+
+	r.bufLen = copy(r.buf[:], p)
+
+	return n, nil
+}
+
+// Sum appends the current hash to b and returns the resulting slice.
+// It does not change the underlying hash state.
+func (r *model) Sum(b []byte) []byte {
+	s := *r
+
+	last := s.total << 56
+	for i := s.bufLen - 1; i >= 0; i-- {
+		last |= uint64(s.buf[i]) << (8 * i)
+	}
+
+	s.compress(last)
+
+	if s.size == Size128 {
+		s.v[2] ^= 0xee
+	} else {
+		s.v[2] ^= 0xff
+	}
+
+	b = binary.LittleEndian.AppendUint64(b, s.finalize())
+	if s.size == Size128 {
+		s.v[1] ^= 0xdd
+		b = binary.LittleEndian.AppendUint64(b, s.finalize())
+	}
+
+	return b
+}
+
+// implementation of the hash.Hash64
+
+// Sum64 returns the first 64 bits of the current hash.
+func (r *model) Sum64() uint64 {
+	return binary.LittleEndian.Uint64(r.Sum(nil))
+}
+
+// private
+
+// compress mixes a message word into the state with the compression rounds.
+func (r *model) compress(m uint64) {
+	r.v[3] ^= m
+	r.rounds(r.c)
+	r.v[0] ^= m
+}
+
+// finalize runs the finalization rounds and returns the output word.
+func (r *model) finalize() uint64 {
+	r.rounds(r.d)
+	return r.v[0] ^ r.v[1] ^ r.v[2] ^ r.v[3]
+}
+
+// rounds runs n rounds of SipRound on the state.
+func (r *model) rounds(n int) {
+	v0, v1, v2, v3 := r.v[0], r.v[1], r.v[2], r.v[3]
+
+	for i := 0; i < n; i++ {
+		v0 += v1
+		v1 = bits.RotateLeft64(v1, 13)
+		v1 ^= v0
+		v0 = bits.RotateLeft64(v0, 32)
+		v2 += v3
+		v3 = bits.RotateLeft64(v3, 16)
+		v3 ^= v2
+		v0 += v3
+		v3 = bits.RotateLeft64(v3, 21)
+		v3 ^= v0
+		v2 += v1
+		v1 = bits.RotateLeft64(v1, 17)
+		v1 ^= v2
+		v2 = bits.RotateLeft64(v2, 32)
+	}
+
+	r.v = [4]uint64{v0, v1, v2, v3}
+}
@@ -0,0 +1,78 @@
+package siphash
+
+import (
+	"encoding/hex"
+	"errors"
+	"hash"
+	"testing"
+)
+
+func TestVectors(t *testing.T) {
+	key := make([]byte, KeySize)
+	msg := make([]byte, 64)
+
+	for i := range msg {
+		msg[i] = byte(i)
+	}
+
+	for i := range key {
+		key[i] = byte(i)
+	}
+
+	// the vectors of the reference implementation for SipHash-2-4 and of Rust's SipHasher13 for SipHash-1-3,
+	// with the key 00..0f and the message 00..(length-1)
+	vectors := []struct {
+		c, d     int
+		size     int
+		length   int
+		expected string
+	}{
+		{2, 4, Size64, 0, "310e0edd47db6f72"},
+		{2, 4, Size64, 1, "fd67dc93c539f874"},
+		{2, 4, Size64, 7, "37d1018bf50002ab"},
+		{2, 4, Size64, 8, "6224939a79f5f593"},
+		{2, 4, Size64, 15, "e545be4961ca29a1"},
+		{2, 4, Size64, 63, "724506eb4c328a95"},
+		{2, 4, Size128, 0, "a3817f04ba25a8e66df67214c7550293"},
+		{2, 4, Size128, 1, "da87c1d86b99af44347659119b22fc45"},
+		{1, 3, Size64, 0, "dcc40f055801acab"},
+		{1, 3, Size64, 1, "93ca577df39bf4c9"},
+	}
+
+	for _, v := range vectors {
+		var h hash.Hash
+		if v.size == Size64 {
+			h, _ = New64(key, v.c, v.d)
+		} else {
+			h, _ = New128(key, v.c, v.d)
+		}
+
+		h.Write(msg[:v.length])
+
+		if got := hex.EncodeToString(h.Sum(nil)); got != v.expected {
+			t.Errorf("SipHash-%d-%d-%d of %d bytes is wrong:\n\texpected \"%s\"\n\tgot \"%s\"", v.c, v.d, v.size*8, v.length, v.expected, got)
+		}
+	}
+}
+
+func TestErrors(t *testing.T) {
+	if _, err := New64(make([]byte, 15), 2, 4); !errors.Is(err, ErrKeySize) {
+		t.Errorf("short key is accepted: %v", err)
+	}
+
+	if _, err := New128(make([]byte, KeySize), 0, 4); !errors.Is(err, ErrRounds) {
+		t.Errorf("zero compression rounds are accepted: %v", err)
+	}
+}
+
+func BenchmarkSipHash24(b *testing.B) {
+	h, _ := New64(make([]byte, KeySize), CompressionRounds24, FinalizationRounds24)
+	data := make([]byte, 8192)
+
+	b.SetBytes(int64(len(data)))
+	for i := 0; i < b.N; i++ {
+		h.Reset()
+		h.Write(data)
+		h.Sum64()
+	}
+}
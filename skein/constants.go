@@ -0,0 +1,48 @@
+package skein
+
+const (
+	// StateSize256 is the state size of Skein-256 in bytes, the default size of its checksum.
+	StateSize256 = 32
+	// StateSize512 is the state size of Skein-512 in bytes, the default size of its checksum.
+	StateSize512 = 64
+	// StateSize1024 is the state size of Skein-1024 in bytes, the default size of its checksum.
+	StateSize1024 = 128
+
+	// maxWords is the number of words of the largest state.
+	maxWords = StateSize1024 / 8
+
+	// keyParity is the constant of the key schedule of Threefish, C240 in the specification.
+	keyParity = 0x1bd11bdaa9fc1a22
+
+	// schemaID is "SHA3" as a little-endian word, it begins the configuration block.
+	schemaID = 0x33414853
+	// version is the version of the configuration block.
+	version = 1
+)
+
+// types of the UBI blocks, as the most significant byte of the tweak
+const (
+	typeKey             = 0
+	typeConfig          = 4
+	typePersonalization = 8
+	typeMessage         = 48
+	typeOutput          = 63
+)
+
+// flags of the tweak
+const (
+	flagFirst = 1 << 62
+	flagFinal = 1 << 63
+)
+
+// threefish represents a Threefish block cipher.
+type threefish struct {
+	words   int
+	encrypt func(key *[maxWords]uint64, tweak *[2]uint64, block *[maxWords]uint64)
+}
+
+var (
+	threefish256  = threefish{words: 4, encrypt: encrypt256}
+	threefish512  = threefish{words: 8, encrypt: encrypt512}
+	threefish1024 = threefish{words: 16, encrypt: encrypt1024}
+)
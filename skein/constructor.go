@@ -0,0 +1,59 @@
+// Package skein implements the Skein-256, Skein-512 and Skein-1024 hash algorithms of the version 1.3
+// of the specification, with any output size, and with the key of Skein-MAC and the personalization string.
+package skein
+
+import (
+	"errors"
+	"fmt"
+	"hash"
+)
+
+//go:generate go run gen.go
+
+// ErrSize is returned when the output size is not positive.
+var ErrSize = errors.New("skein: invalid output size")
+
+// New256 creates a new Skein-256 hash.Hash with the output size in bytes,
+// the key and the personalization are optional.
+func New256(size int, key, personalization []byte) (hash.Hash, error) {
+	return newModel(&threefish256, size, key, personalization)
+}
+
+// New512 creates a new Skein-512 hash.Hash with the output size in bytes,
+// the key and the personalization are optional.
+func New512(size int, key, personalization []byte) (hash.Hash, error) {
+	return newModel(&threefish512, size, key, personalization)
+}
+
+// New1024 creates a new Skein-1024 hash.Hash with the output size in bytes,
+// the key and the personalization are optional.
+func New1024(size int, key, personalization []byte) (hash.Hash, error) {
+	return newModel(&threefish1024, size, key, personalization)
+}
+
+// newModel creates a new Skein model of the Threefish cipher, it computes the chaining value
+// of the key, the configuration and the personalization which all the messages begin with.
+func newModel(cipher *threefish, size int, key, personalization []byte) (hash.Hash, error) {
+	if size <= 0 {
+		return nil, fmt.Errorf("%w: %d", ErrSize, size)
+	}
+
+	h := &model{cipher: cipher, size: size}
+
+	if len(key) > 0 {
+		h.ubi(&h.iv, key, typeKey)
+	}
+
+	var config [32]byte
+	putUint64(config[0:], schemaID|version<<32)
+	putUint64(config[8:], uint64(size)<<3)
+	h.ubi(&h.iv, config[:], typeConfig)
+
+	if len(personalization) > 0 {
+		h.ubi(&h.iv, personalization, typePersonalization)
+	}
+
+	h.Reset()
+
+	return h, nil
+}
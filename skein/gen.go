@@ -0,0 +1,161 @@
+//go:build ignore
+
+// This program generates threefish.go, run it with "go generate".
+//
+// The generated Threefish encryption functions are fully unrolled. Instead of moving the words
+// after every round by the permutation, the generator renames them, so the rotations are constants.
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"os"
+	"strings"
+)
+
+// variant represents the parameters of a Threefish block cipher.
+type variant struct {
+	bits        int
+	rounds      int
+	rotations   [8][]int
+	permutation []int
+}
+
+var variants = []variant{
+	{
+		bits:   256,
+		rounds: 72,
+		rotations: [8][]int{
+			{14, 16}, {52, 57}, {23, 40}, {5, 37}, {25, 33}, {46, 12}, {58, 22}, {32, 32},
+		},
+		permutation: []int{0, 3, 2, 1},
+	},
+	{
+		bits:   512,
+		rounds: 72,
+		rotations: [8][]int{
+			{46, 36, 19, 37}, {33, 27, 14, 42}, {17, 49, 36, 39}, {44, 9, 54, 56},
+			{39, 30, 34, 24}, {13, 50, 10, 17}, {25, 29, 39, 43}, {8, 35, 56, 22},
+		},
+		permutation: []int{2, 1, 4, 7, 6, 5, 0, 3},
+	},
+	{
+		bits:   1024,
+		rounds: 80,
+		rotations: [8][]int{
+			{24, 13, 8, 47, 8, 17, 22, 37}, {38, 19, 10, 55, 49, 18, 23, 52},
+			{33, 4, 51, 13, 34, 41, 59, 17}, {5, 20, 48, 41, 47, 28, 16, 25},
+			{41, 9, 37, 31, 12, 47, 44, 30}, {16, 34, 56, 51, 4, 53, 42, 41},
+			{31, 44, 47, 46, 19, 42, 44, 25}, {9, 48, 35, 52, 23, 31, 37, 20},
+		},
+		permutation: []int{0, 9, 2, 13, 6, 11, 4, 15, 10, 7, 12, 3, 14, 5, 8, 1},
+	},
+}
+
+func main() {
+	var buf bytes.Buffer
+
+	fmt.Fprintln(&buf, "// Code generated by gen.go. DO NOT EDIT.")
+	fmt.Fprintln(&buf)
+	fmt.Fprintln(&buf, "package skein")
+	fmt.Fprintln(&buf)
+	fmt.Fprintln(&buf, `import "math/bits"`)
+
+	for _, v := range variants {
+		encrypt(&buf, v)
+	}
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		panic(err)
+	}
+
+	if err = os.WriteFile("threefish.go", src, 0o644); err != nil {
+		panic(err)
+	}
+}
+
+// encrypt writes the encryption function of the variant, which encrypts the block in place.
+func encrypt(buf *bytes.Buffer, v variant) {
+	n := v.bits / 64
+
+	fmt.Fprintln(buf)
+	fmt.Fprintf(buf, "// encrypt%d encrypts the block in place by Threefish-%d with the key and the tweak.\n", v.bits, v.bits)
+	fmt.Fprintf(buf, "func encrypt%d(key *[maxWords]uint64, tweak *[2]uint64, block *[maxWords]uint64) {\n", v.bits)
+
+	// the extended key has a parity word and the extended tweak the xor of its words
+	keys := make([]string, n)
+	words := make([]string, n)
+	for i := range keys {
+		keys[i] = fmt.Sprintf("k%d", i)
+		words[i] = fmt.Sprintf("key[%d]", i)
+	}
+
+	fmt.Fprintf(buf, "%s := %s\n", strings.Join(keys, ", "), strings.Join(words, ", "))
+	fmt.Fprintf(buf, "k%d := keyParity ^ %s\n", n, strings.Join(keys, " ^ "))
+
+	fmt.Fprintln(buf, "t0, t1 := tweak[0], tweak[1]")
+	fmt.Fprintln(buf, "t2 := t0 ^ t1")
+	fmt.Fprintln(buf)
+
+	names := make([]string, n)
+	for i := range names {
+		names[i] = fmt.Sprintf("v%d", i)
+		fmt.Fprintf(buf, "%s := block[%d]\n", names[i], i)
+	}
+
+	for d := 0; d <= v.rounds; d++ {
+		if d%4 == 0 {
+			s := d / 4
+
+			fmt.Fprintln(buf)
+			fmt.Fprintf(buf, "// sub key %d\n", s)
+
+			for i, name := range names {
+				switch i {
+				case n - 3:
+					fmt.Fprintf(buf, "%s += k%d + t%d\n", name, (s+i)%(n+1), s%3)
+				case n - 2:
+					fmt.Fprintf(buf, "%s += k%d + t%d\n", name, (s+i)%(n+1), (s+1)%3)
+				case n - 1:
+					if s == 0 {
+						fmt.Fprintf(buf, "%s += k%d\n", name, (s+i)%(n+1))
+					} else {
+						fmt.Fprintf(buf, "%s += k%d + %d\n", name, (s+i)%(n+1), s)
+					}
+				default:
+					fmt.Fprintf(buf, "%s += k%d\n", name, (s+i)%(n+1))
+				}
+			}
+		}
+
+		if d == v.rounds {
+			break
+		}
+
+		fmt.Fprintln(buf)
+		fmt.Fprintf(buf, "// round %d\n", d+1)
+
+		for j, rotation := range v.rotations[d%8] {
+			a, b := names[2*j], names[2*j+1]
+			fmt.Fprintf(buf, "%s += %s\n", a, b)
+			fmt.Fprintf(buf, "%s = bits.RotateLeft64(%s, %d) ^ %s\n", b, b, rotation, a)
+		}
+
+		permuted := make([]string, n)
+		for i, p := range v.permutation {
+			permuted[i] = names[p]
+		}
+
+		names = permuted
+	}
+
+	fmt.Fprintln(buf)
+
+	for i, name := range names {
+		fmt.Fprintf(buf, "block[%d] = %s\n", i, name)
+	}
+
+	fmt.Fprintln(buf, "}")
+}
@@ -0,0 +1,137 @@
+package skein
+
+import "encoding/binary"
+
+// model represents a structure for the Skein hash.Hash.
+// The last block of a UBI call is flagged as final, so a full buffer is only processed when more data follows.
+type model struct {
+	cipher      *threefish
+	size        int
+	iv          [maxWords]uint64
+	state       [maxWords]uint64
+	tweak       [2]uint64
+	buffer      [StateSize1024]byte
+	bufferIndex int
+}
+
+// implementation of the hash.Hash
+
+// Reset resets the hash.Hash to its initial state.
+func (r *model) Reset() {
+	r.state = r.iv
+	r.tweak = [2]uint64{0, typeMessage<<56 | flagFirst}
+	r.bufferIndex = 0
+}
+
+// Size returns the number of bytes Sum will return.
+func (r *model) Size() int { return r.size }
+
+// BlockSize returns the hash's underlying block size.
+// The Write method must be able to accept any amount of data,
+// but it may operate more efficiently if all writes are a multiple of the block size.
+func (r *model) BlockSize() int { return 8 * r.cipher.words }
+
+// Write appends the data to the digest.
+func (r *model) Write(p []byte) (int, error) {
+	n := len(p)
+	blockSize := r.BlockSize()
+
+	for len(p) > 0 {
+		if r.bufferIndex == blockSize {
+			r.block(&r.state, &r.tweak, r.buffer[:blockSize], blockSize)
+			r.bufferIndex = 0
+		}
+
+		if r.bufferIndex == 0 {
+			for ; len(p) > blockSize; p = p[blockSize:] {
+				r.block(&r.state, &r.tweak, p, blockSize)
+			}
+		}
+
+		x := copy(r.buffer[r.bufferIndex:blockSize], p)
+		r.bufferIndex += x
+		p = p[x:]
+	}
+
+	return n, nil
+}
+
+// Sum appends the current hash to b and returns the resulting slice.
+// It does not change the underlying hash state.
+func (r *model) Sum(b []byte) []byte {
+	// copy the model to allow other writes to continue and to prevent change of the state
+	s := *r
+	blockSize := s.BlockSize()
+
+	// the last block is padded by zeros
+	for i := s.bufferIndex; i < blockSize; i++ {
+		s.buffer[i] = 0
+	}
+
+	s.tweak[1] |= flagFinal
+	s.block(&s.state, &s.tweak, s.buffer[:blockSize], s.bufferIndex)
+
+	// the output is produced by UBI calls of the counter
+	var counter [8]byte
+	for i := 0; i < s.size; i += blockSize {
+		putUint64(counter[:], uint64(i/blockSize))
+
+		state := s.state
+		s.ubi(&state, counter[:], typeOutput)
+
+		var output [StateSize1024]byte
+		for j := 0; j < s.cipher.words; j++ {
+			putUint64(output[8*j:], state[j])
+		}
+
+		n := blockSize
+		if s.size-i < n {
+			n = s.size - i
+		}
+
+		b = append(b, output[:n]...)
+	}
+
+	return b
+}
+
+// private
+
+// block processes a block of UBI with the number of its bytes in the position of the tweak.
+func (r *model) block(state *[maxWords]uint64, tweak *[2]uint64, p []byte, n int) {
+	var m [maxWords]uint64
+	for i := 0; i < r.cipher.words; i++ {
+		m[i] = binary.LittleEndian.Uint64(p[8*i:])
+	}
+
+	tweak[0] += uint64(n)
+
+	c := m
+	r.cipher.encrypt(state, tweak, &c)
+
+	for i := 0; i < r.cipher.words; i++ {
+		state[i] = c[i] ^ m[i]
+	}
+
+	tweak[1] &^= flagFirst
+}
+
+// ubi processes the whole message of the type into the state.
+func (r *model) ubi(state *[maxWords]uint64, msg []byte, blockType uint64) {
+	blockSize := r.BlockSize()
+	tweak := [2]uint64{0, blockType<<56 | flagFirst}
+
+	for len(msg) > blockSize {
+		r.block(state, &tweak, msg, blockSize)
+		msg = msg[blockSize:]
+	}
+
+	var last [StateSize1024]byte
+	copy(last[:], msg)
+
+	tweak[1] |= flagFinal
+	r.block(state, &tweak, last[:blockSize], len(msg))
+}
+
+// putUint64 writes the word in little-endian.
+func putUint64(b []byte, v uint64) { binary.LittleEndian.PutUint64(b, v) }
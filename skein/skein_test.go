@@ -0,0 +1,127 @@
+package skein
+
+import (
+	"encoding/hex"
+	"errors"
+	"hash"
+	"testing"
+)
+
+// descending returns the bytes 0xff, 0xfe, ... of the length, the messages of the examples of the specification.
+func descending(n int) []byte {
+	p := make([]byte, n)
+	for i := range p {
+		p[i] = byte(0xff - i)
+	}
+
+	return p
+}
+
+func TestExamples(t *testing.T) {
+	cases := []struct {
+		newHash  func(int, []byte, []byte) (hash.Hash, error)
+		size     int
+		input    []byte
+		expected string
+	}{
+		// the examples of the appendix C of the specification
+		{New256, 32, descending(1), "0b98dcd198ea0e50a7a244c444e25c23da30c10fc9a1f270a6637f1f34e67ed2"},
+		{New256, 32, descending(32), "8d0fa4ef777fd759dfd4044e6f6a5ac3c774aec943dcfc07927b723b5dbf408b"},
+		{New256, 32, descending(64), "df28e916630d0b44c4a849dc9a02f07a07cb30f732318256b15d865ac4ae162f"},
+		{New512, 64, descending(1), "71b7bce6fe6452227b9ced6014249e5bf9a9754c3ad618ccc4e0aae16b316cc8ca698d864307ed3e80b6ef1570812ac5272dc409b5a012df2a579102f340617a"},
+		{New512, 64, descending(64), "45863ba3be0c4dfc27e75d358496f4ac9a736a505d9313b42b2f5eada79fc17f63861e947afb1d056aa199575ad3f8c9a3cc1780b5e5fa4cae050e989876625b"},
+		{New1024, 128, descending(128), "1f3e02c46fb80a3fcd2dfbbc7c173800b40c60c2354af551189ebf433c3d85f9ff1803e6d920493179ed7ae7fce69c3581a5a2f82d3e0c7a295574d0cd7d217c484d2f6313d59a7718ead07d0729c24851d7e7d2491b902d489194e6b7d369db0ab7aa106f0ee0a39a42efc54f18d93776080985f907574f995ec6a37153a578"},
+		// the empty message
+		{New256, 32, nil, "c8877087da56e072870daa843f176e9453115929094c3a40c463a196c29bf7ba"},
+		{New512, 32, nil, "39ccc4554a8b31853b9de7a1fe638a24cce6b35a55f2431009e18780335d2621"},
+		{New512, 64, nil, "bc5b4c50925519c290cc634277ae3d6257212395cba733bbad37a4af0fa06af41fca7903d06564fea7a2d3730dbdb80c1f85562dfcc070334ea4d1d9e72cba7a"},
+		{New512, 64, []byte("The quick brown fox jumps over the lazy dog"), "94c2ae036dba8783d0b3f7d6cc111ff810702f5c77707999be7e1c9486ff238a7044de734293147359b4ac7e1d09cd247c351d69826b78dcddd951f0ef912713"},
+	}
+
+	for _, c := range cases {
+		h, err := c.newHash(c.size, nil, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		h.Write(c.input)
+
+		if got := hex.EncodeToString(h.Sum(nil)); got != c.expected {
+			t.Errorf("sum of %d bytes of block size %d is wrong:\n\texpected \"%s\"\n\tgot \"%s\"", len(c.input), h.BlockSize(), c.expected, got)
+		}
+	}
+}
+
+func TestKeyAndPersonalization(t *testing.T) {
+	data := []byte("The quick brown fox jumps over the lazy dog")
+	sums := make(map[string]string)
+
+	for _, c := range []struct {
+		name                 string
+		key, personalization []byte
+	}{
+		{"plain", nil, nil},
+		{"key", []byte("key"), nil},
+		{"personalization", nil, []byte("20081104 me@example.com hashed")},
+		{"both", []byte("key"), []byte("20081104 me@example.com hashed")},
+	} {
+		h, _ := New512(64, c.key, c.personalization)
+		h.Write(data)
+		sum := hex.EncodeToString(h.Sum(nil))
+
+		// the chaining value of the key and the personalization survives a reset
+		h.Reset()
+		h.Write(data)
+		if again := hex.EncodeToString(h.Sum(nil)); again != sum {
+			t.Errorf("'%s' sum after reset is wrong:\n\texpected \"%s\"\n\tgot \"%s\"", c.name, sum, again)
+		}
+
+		for name, other := range sums {
+			if other == sum {
+				t.Errorf("'%s' and '%s' have the same sum", c.name, name)
+			}
+		}
+
+		sums[c.name] = sum
+	}
+}
+
+func TestSize(t *testing.T) {
+	if _, err := New256(0, nil, nil); !errors.Is(err, ErrSize) {
+		t.Errorf("size 0 is accepted")
+	}
+
+	// an output longer than the state uses more output blocks
+	for _, size := range []int{1, 20, 33, 100, 300} {
+		h, _ := New256(size, nil, nil)
+		if got := len(h.Sum(nil)); got != size {
+			t.Errorf("sum of size %d has %d bytes", size, got)
+		}
+	}
+}
+
+func benchmarkSkein(b *testing.B, h hash.Hash) {
+	data := make([]byte, 8192)
+
+	b.SetBytes(int64(len(data)))
+	for i := 0; i < b.N; i++ {
+		h.Reset()
+		h.Write(data)
+		h.Sum(nil)
+	}
+}
+
+func BenchmarkSkein256(b *testing.B) {
+	h, _ := New256(StateSize256, nil, nil)
+	benchmarkSkein(b, h)
+}
+
+func BenchmarkSkein512(b *testing.B) {
+	h, _ := New512(StateSize512, nil, nil)
+	benchmarkSkein(b, h)
+}
+
+func BenchmarkSkein1024(b *testing.B) {
+	h, _ := New1024(StateSize1024, nil, nil)
+	benchmarkSkein(b, h)
+}
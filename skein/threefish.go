@@ -0,0 +1,3365 @@
+// Code generated by gen.go. DO NOT EDIT.
+
+package skein
+
+import "math/bits"
+
+// encrypt256 encrypts the block in place by Threefish-256 with the key and the tweak.
+func encrypt256(key *[maxWords]uint64, tweak *[2]uint64, block *[maxWords]uint64) {
+	k0, k1, k2, k3 := key[0], key[1], key[2], key[3]
+	k4 := keyParity ^ k0 ^ k1 ^ k2 ^ k3
+	t0, t1 := tweak[0], tweak[1]
+	t2 := t0 ^ t1
+
+	v0 := block[0]
+	v1 := block[1]
+	v2 := block[2]
+	v3 := block[3]
+
+	// sub key 0
+	v0 += k0
+	v1 += k1 + t0
+	v2 += k2 + t1
+	v3 += k3
+
+	// round 1
+	v0 += v1
+	v1 = bits.RotateLeft64(v1, 14) ^ v0
+	v2 += v3
+	v3 = bits.RotateLeft64(v3, 16) ^ v2
+
+	// round 2
+	v0 += v3
+	v3 = bits.RotateLeft64(v3, 52) ^ v0
+	v2 += v1
+	v1 = bits.RotateLeft64(v1, 57) ^ v2
+
+	// round 3
+	v0 += v1
+	v1 = bits.RotateLeft64(v1, 23) ^ v0
+	v2 += v3
+	v3 = bits.RotateLeft64(v3, 40) ^ v2
+
+	// round 4
+	v0 += v3
+	v3 = bits.RotateLeft64(v3, 5) ^ v0
+	v2 += v1
+	v1 = bits.RotateLeft64(v1, 37) ^ v2
+
+	// sub key 1
+	v0 += k1
+	v1 += k2 + t1
+	v2 += k3 + t2
+	v3 += k4 + 1
+
+	// round 5
+	v0 += v1
+	v1 = bits.RotateLeft64(v1, 25) ^ v0
+	v2 += v3
+	v3 = bits.RotateLeft64(v3, 33) ^ v2
+
+	// round 6
+	v0 += v3
+	v3 = bits.RotateLeft64(v3, 46) ^ v0
+	v2 += v1
+	v1 = bits.RotateLeft64(v1, 12) ^ v2
+
+	// round 7
+	v0 += v1
+	v1 = bits.RotateLeft64(v1, 58) ^ v0
+	v2 += v3
+	v3 = bits.RotateLeft64(v3, 22) ^ v2
+
+	// round 8
+	v0 += v3
+	v3 = bits.RotateLeft64(v3, 32) ^ v0
+	v2 += v1
+	v1 = bits.RotateLeft64(v1, 32) ^ v2
+
+	// sub key 2
+	v0 += k2
+	v1 += k3 + t2
+	v2 += k4 + t0
+	v3 += k0 + 2
+
+	// round 9
+	v0 += v1
+	v1 = bits.RotateLeft64(v1, 14) ^ v0
+	v2 += v3
+	v3 = bits.RotateLeft64(v3, 16) ^ v2
+
+	// round 10
+	v0 += v3
+	v3 = bits.RotateLeft64(v3, 52) ^ v0
+	v2 += v1
+	v1 = bits.RotateLeft64(v1, 57) ^ v2
+
+	// round 11
+	v0 += v1
+	v1 = bits.RotateLeft64(v1, 23) ^ v0
+	v2 += v3
+	v3 = bits.RotateLeft64(v3, 40) ^ v2
+
+	// round 12
+	v0 += v3
+	v3 = bits.RotateLeft64(v3, 5) ^ v0
+	v2 += v1
+	v1 = bits.RotateLeft64(v1, 37) ^ v2
+
+	// sub key 3
+	v0 += k3
+	v1 += k4 + t0
+	v2 += k0 + t1
+	v3 += k1 + 3
+
+	// round 13
+	v0 += v1
+	v1 = bits.RotateLeft64(v1, 25) ^ v0
+	v2 += v3
+	v3 = bits.RotateLeft64(v3, 33) ^ v2
+
+	// round 14
+	v0 += v3
+	v3 = bits.RotateLeft64(v3, 46) ^ v0
+	v2 += v1
+	v1 = bits.RotateLeft64(v1, 12) ^ v2
+
+	// round 15
+	v0 += v1
+	v1 = bits.RotateLeft64(v1, 58) ^ v0
+	v2 += v3
+	v3 = bits.RotateLeft64(v3, 22) ^ v2
+
+	// round 16
+	v0 += v3
+	v3 = bits.RotateLeft64(v3, 32) ^ v0
+	v2 += v1
+	v1 = bits.RotateLeft64(v1, 32) ^ v2
+
+	// sub key 4
+	v0 += k4
+	v1 += k0 + t1
+	v2 += k1 + t2
+	v3 += k2 + 4
+
+	// round 17
+	v0 += v1
+	v1 = bits.RotateLeft64(v1, 14) ^ v0
+	v2 += v3
+	v3 = bits.RotateLeft64(v3, 16) ^ v2
+
+	// round 18
+	v0 += v3
+	v3 = bits.RotateLeft64(v3, 52) ^ v0
+	v2 += v1
+	v1 = bits.RotateLeft64(v1, 57) ^ v2
+
+	// round 19
+	v0 += v1
+	v1 = bits.RotateLeft64(v1, 23) ^ v0
+	v2 += v3
+	v3 = bits.RotateLeft64(v3, 40) ^ v2
+
+	// round 20
+	v0 += v3
+	v3 = bits.RotateLeft64(v3, 5) ^ v0
+	v2 += v1
+	v1 = bits.RotateLeft64(v1, 37) ^ v2
+
+	// sub key 5
+	v0 += k0
+	v1 += k1 + t2
+	v2 += k2 + t0
+	v3 += k3 + 5
+
+	// round 21
+	v0 += v1
+	v1 = bits.RotateLeft64(v1, 25) ^ v0
+	v2 += v3
+	v3 = bits.RotateLeft64(v3, 33) ^ v2
+
+	// round 22
+	v0 += v3
+	v3 = bits.RotateLeft64(v3, 46) ^ v0
+	v2 += v1
+	v1 = bits.RotateLeft64(v1, 12) ^ v2
+
+	// round 23
+	v0 += v1
+	v1 = bits.RotateLeft64(v1, 58) ^ v0
+	v2 += v3
+	v3 = bits.RotateLeft64(v3, 22) ^ v2
+
+	// round 24
+	v0 += v3
+	v3 = bits.RotateLeft64(v3, 32) ^ v0
+	v2 += v1
+	v1 = bits.RotateLeft64(v1, 32) ^ v2
+
+	// sub key 6
+	v0 += k1
+	v1 += k2 + t0
+	v2 += k3 + t1
+	v3 += k4 + 6
+
+	// round 25
+	v0 += v1
+	v1 = bits.RotateLeft64(v1, 14) ^ v0
+	v2 += v3
+	v3 = bits.RotateLeft64(v3, 16) ^ v2
+
+	// round 26
+	v0 += v3
+	v3 = bits.RotateLeft64(v3, 52) ^ v0
+	v2 += v1
+	v1 = bits.RotateLeft64(v1, 57) ^ v2
+
+	// round 27
+	v0 += v1
+	v1 = bits.RotateLeft64(v1, 23) ^ v0
+	v2 += v3
+	v3 = bits.RotateLeft64(v3, 40) ^ v2
+
+	// round 28
+	v0 += v3
+	v3 = bits.RotateLeft64(v3, 5) ^ v0
+	v2 += v1
+	v1 = bits.RotateLeft64(v1, 37) ^ v2
+
+	// sub key 7
+	v0 += k2
+	v1 += k3 + t1
+	v2 += k4 + t2
+	v3 += k0 + 7
+
+	// round 29
+	v0 += v1
+	v1 = bits.RotateLeft64(v1, 25) ^ v0
+	v2 += v3
+	v3 = bits.RotateLeft64(v3, 33) ^ v2
+
+	// round 30
+	v0 += v3
+	v3 = bits.RotateLeft64(v3, 46) ^ v0
+	v2 += v1
+	v1 = bits.RotateLeft64(v1, 12) ^ v2
+
+	// round 31
+	v0 += v1
+	v1 = bits.RotateLeft64(v1, 58) ^ v0
+	v2 += v3
+	v3 = bits.RotateLeft64(v3, 22) ^ v2
+
+	// round 32
+	v0 += v3
+	v3 = bits.RotateLeft64(v3, 32) ^ v0
+	v2 += v1
+	v1 = bits.RotateLeft64(v1, 32) ^ v2
+
+	// sub key 8
+	v0 += k3
+	v1 += k4 + t2
+	v2 += k0 + t0
+	v3 += k1 + 8
+
+	// round 33
+	v0 += v1
+	v1 = bits.RotateLeft64(v1, 14) ^ v0
+	v2 += v3
+	v3 = bits.RotateLeft64(v3, 16) ^ v2
+
+	// round 34
+	v0 += v3
+	v3 = bits.RotateLeft64(v3, 52) ^ v0
+	v2 += v1
+	v1 = bits.RotateLeft64(v1, 57) ^ v2
+
+	// round 35
+	v0 += v1
+	v1 = bits.RotateLeft64(v1, 23) ^ v0
+	v2 += v3
+	v3 = bits.RotateLeft64(v3, 40) ^ v2
+
+	// round 36
+	v0 += v3
+	v3 = bits.RotateLeft64(v3, 5) ^ v0
+	v2 += v1
+	v1 = bits.RotateLeft64(v1, 37) ^ v2
+
+	// sub key 9
+	v0 += k4
+	v1 += k0 + t0
+	v2 += k1 + t1
+	v3 += k2 + 9
+
+	// round 37
+	v0 += v1
+	v1 = bits.RotateLeft64(v1, 25) ^ v0
+	v2 += v3
+	v3 = bits.RotateLeft64(v3, 33) ^ v2
+
+	// round 38
+	v0 += v3
+	v3 = bits.RotateLeft64(v3, 46) ^ v0
+	v2 += v1
+	v1 = bits.RotateLeft64(v1, 12) ^ v2
+
+	// round 39
+	v0 += v1
+	v1 = bits.RotateLeft64(v1, 58) ^ v0
+	v2 += v3
+	v3 = bits.RotateLeft64(v3, 22) ^ v2
+
+	// round 40
+	v0 += v3
+	v3 = bits.RotateLeft64(v3, 32) ^ v0
+	v2 += v1
+	v1 = bits.RotateLeft64(v1, 32) ^ v2
+
+	// sub key 10
+	v0 += k0
+	v1 += k1 + t1
+	v2 += k2 + t2
+	v3 += k3 + 10
+
+	// round 41
+	v0 += v1
+	v1 = bits.RotateLeft64(v1, 14) ^ v0
+	v2 += v3
+	v3 = bits.RotateLeft64(v3, 16) ^ v2
+
+	// round 42
+	v0 += v3
+	v3 = bits.RotateLeft64(v3, 52) ^ v0
+	v2 += v1
+	v1 = bits.RotateLeft64(v1, 57) ^ v2
+
+	// round 43
+	v0 += v1
+	v1 = bits.RotateLeft64(v1, 23) ^ v0
+	v2 += v3
+	v3 = bits.RotateLeft64(v3, 40) ^ v2
+
+	// round 44
+	v0 += v3
+	v3 = bits.RotateLeft64(v3, 5) ^ v0
+	v2 += v1
+	v1 = bits.RotateLeft64(v1, 37) ^ v2
+
+	// sub key 11
+	v0 += k1
+	v1 += k2 + t2
+	v2 += k3 + t0
+	v3 += k4 + 11
+
+	// round 45
+	v0 += v1
+	v1 = bits.RotateLeft64(v1, 25) ^ v0
+	v2 += v3
+	v3 = bits.RotateLeft64(v3, 33) ^ v2
+
+	// round 46
+	v0 += v3
+	v3 = bits.RotateLeft64(v3, 46) ^ v0
+	v2 += v1
+	v1 = bits.RotateLeft64(v1, 12) ^ v2
+
+	// round 47
+	v0 += v1
+	v1 = bits.RotateLeft64(v1, 58) ^ v0
+	v2 += v3
+	v3 = bits.RotateLeft64(v3, 22) ^ v2
+
+	// round 48
+	v0 += v3
+	v3 = bits.RotateLeft64(v3, 32) ^ v0
+	v2 += v1
+	v1 = bits.RotateLeft64(v1, 32) ^ v2
+
+	// sub key 12
+	v0 += k2
+	v1 += k3 + t0
+	v2 += k4 + t1
+	v3 += k0 + 12
+
+	// round 49
+	v0 += v1
+	v1 = bits.RotateLeft64(v1, 14) ^ v0
+	v2 += v3
+	v3 = bits.RotateLeft64(v3, 16) ^ v2
+
+	// round 50
+	v0 += v3
+	v3 = bits.RotateLeft64(v3, 52) ^ v0
+	v2 += v1
+	v1 = bits.RotateLeft64(v1, 57) ^ v2
+
+	// round 51
+	v0 += v1
+	v1 = bits.RotateLeft64(v1, 23) ^ v0
+	v2 += v3
+	v3 = bits.RotateLeft64(v3, 40) ^ v2
+
+	// round 52
+	v0 += v3
+	v3 = bits.RotateLeft64(v3, 5) ^ v0
+	v2 += v1
+	v1 = bits.RotateLeft64(v1, 37) ^ v2
+
+	// sub key 13
+	v0 += k3
+	v1 += k4 + t1
+	v2 += k0 + t2
+	v3 += k1 + 13
+
+	// round 53
+	v0 += v1
+	v1 = bits.RotateLeft64(v1, 25) ^ v0
+	v2 += v3
+	v3 = bits.RotateLeft64(v3, 33) ^ v2
+
+	// round 54
+	v0 += v3
+	v3 = bits.RotateLeft64(v3, 46) ^ v0
+	v2 += v1
+	v1 = bits.RotateLeft64(v1, 12) ^ v2
+
+	// round 55
+	v0 += v1
+	v1 = bits.RotateLeft64(v1, 58) ^ v0
+	v2 += v3
+	v3 = bits.RotateLeft64(v3, 22) ^ v2
+
+	// round 56
+	v0 += v3
+	v3 = bits.RotateLeft64(v3, 32) ^ v0
+	v2 += v1
+	v1 = bits.RotateLeft64(v1, 32) ^ v2
+
+	// sub key 14
+	v0 += k4
+	v1 += k0 + t2
+	v2 += k1 + t0
+	v3 += k2 + 14
+
+	// round 57
+	v0 += v1
+	v1 = bits.RotateLeft64(v1, 14) ^ v0
+	v2 += v3
+	v3 = bits.RotateLeft64(v3, 16) ^ v2
+
+	// round 58
+	v0 += v3
+	v3 = bits.RotateLeft64(v3, 52) ^ v0
+	v2 += v1
+	v1 = bits.RotateLeft64(v1, 57) ^ v2
+
+	// round 59
+	v0 += v1
+	v1 = bits.RotateLeft64(v1, 23) ^ v0
+	v2 += v3
+	v3 = bits.RotateLeft64(v3, 40) ^ v2
+
+	// round 60
+	v0 += v3
+	v3 = bits.RotateLeft64(v3, 5) ^ v0
+	v2 += v1
+	v1 = bits.RotateLeft64(v1, 37) ^ v2
+
+	// sub key 15
+	v0 += k0
+	v1 += k1 + t0
+	v2 += k2 + t1
+	v3 += k3 + 15
+
+	// round 61
+	v0 += v1
+	v1 = bits.RotateLeft64(v1, 25) ^ v0
+	v2 += v3
+	v3 = bits.RotateLeft64(v3, 33) ^ v2
+
+	// round 62
+	v0 += v3
+	v3 = bits.RotateLeft64(v3, 46) ^ v0
+	v2 += v1
+	v1 = bits.RotateLeft64(v1, 12) ^ v2
+
+	// round 63
+	v0 += v1
+	v1 = bits.RotateLeft64(v1, 58) ^ v0
+	v2 += v3
+	v3 = bits.RotateLeft64(v3, 22) ^ v2
+
+	// round 64
+	v0 += v3
+	v3 = bits.RotateLeft64(v3, 32) ^ v0
+	v2 += v1
+	v1 = bits.RotateLeft64(v1, 32) ^ v2
+
+	// sub key 16
+	v0 += k1
+	v1 += k2 + t1
+	v2 += k3 + t2
+	v3 += k4 + 16
+
+	// round 65
+	v0 += v1
+	v1 = bits.RotateLeft64(v1, 14) ^ v0
+	v2 += v3
+	v3 = bits.RotateLeft64(v3, 16) ^ v2
+
+	// round 66
+	v0 += v3
+	v3 = bits.RotateLeft64(v3, 52) ^ v0
+	v2 += v1
+	v1 = bits.RotateLeft64(v1, 57) ^ v2
+
+	// round 67
+	v0 += v1
+	v1 = bits.RotateLeft64(v1, 23) ^ v0
+	v2 += v3
+	v3 = bits.RotateLeft64(v3, 40) ^ v2
+
+	// round 68
+	v0 += v3
+	v3 = bits.RotateLeft64(v3, 5) ^ v0
+	v2 += v1
+	v1 = bits.RotateLeft64(v1, 37) ^ v2
+
+	// sub key 17
+	v0 += k2
+	v1 += k3 + t2
+	v2 += k4 + t0
+	v3 += k0 + 17
+
+	// round 69
+	v0 += v1
+	v1 = bits.RotateLeft64(v1, 25) ^ v0
+	v2 += v3
+	v3 = bits.RotateLeft64(v3, 33) ^ v2
+
+	// round 70
+	v0 += v3
+	v3 = bits.RotateLeft64(v3, 46) ^ v0
+	v2 += v1
+	v1 = bits.RotateLeft64(v1, 12) ^ v2
+
+	// round 71
+	v0 += v1
+	v1 = bits.RotateLeft64(v1, 58) ^ v0
+	v2 += v3
+	v3 = bits.RotateLeft64(v3, 22) ^ v2
+
+	// round 72
+	v0 += v3
+	v3 = bits.RotateLeft64(v3, 32) ^ v0
+	v2 += v1
+	v1 = bits.RotateLeft64(v1, 32) ^ v2
+
+	// sub key 18
+	v0 += k3
+	v1 += k4 + t0
+	v2 += k0 + t1
+	v3 += k1 + 18
+
+	block[0] = v0
+	block[1] = v1
+	block[2] = v2
+	block[3] = v3
+}
+
+// encrypt512 encrypts the block in place by Threefish-512 with the key and the tweak.
+func encrypt512(key *[maxWords]uint64, tweak *[2]uint64, block *[maxWords]uint64) {
+	k0, k1, k2, k3, k4, k5, k6, k7 := key[0], key[1], key[2], key[3], key[4], key[5], key[6], key[7]
+	k8 := keyParity ^ k0 ^ k1 ^ k2 ^ k3 ^ k4 ^ k5 ^ k6 ^ k7
+	t0, t1 := tweak[0], tweak[1]
+	t2 := t0 ^ t1
+
+	v0 := block[0]
+	v1 := block[1]
+	v2 := block[2]
+	v3 := block[3]
+	v4 := block[4]
+	v5 := block[5]
+	v6 := block[6]
+	v7 := block[7]
+
+	// sub key 0
+	v0 += k0
+	v1 += k1
+	v2 += k2
+	v3 += k3
+	v4 += k4
+	v5 += k5 + t0
+	v6 += k6 + t1
+	v7 += k7
+
+	// round 1
+	v0 += v1
+	v1 = bits.RotateLeft64(v1, 46) ^ v0
+	v2 += v3
+	v3 = bits.RotateLeft64(v3, 36) ^ v2
+	v4 += v5
+	v5 = bits.RotateLeft64(v5, 19) ^ v4
+	v6 += v7
+	v7 = bits.RotateLeft64(v7, 37) ^ v6
+
+	// round 2
+	v2 += v1
+	v1 = bits.RotateLeft64(v1, 33) ^ v2
+	v4 += v7
+	v7 = bits.RotateLeft64(v7, 27) ^ v4
+	v6 += v5
+	v5 = bits.RotateLeft64(v5, 14) ^ v6
+	v0 += v3
+	v3 = bits.RotateLeft64(v3, 42) ^ v0
+
+	// round 3
+	v4 += v1
+	v1 = bits.RotateLeft64(v1, 17) ^ v4
+	v6 += v3
+	v3 = bits.RotateLeft64(v3, 49) ^ v6
+	v0 += v5
+	v5 = bits.RotateLeft64(v5, 36) ^ v0
+	v2 += v7
+	v7 = bits.RotateLeft64(v7, 39) ^ v2
+
+	// round 4
+	v6 += v1
+	v1 = bits.RotateLeft64(v1, 44) ^ v6
+	v0 += v7
+	v7 = bits.RotateLeft64(v7, 9) ^ v0
+	v2 += v5
+	v5 = bits.RotateLeft64(v5, 54) ^ v2
+	v4 += v3
+	v3 = bits.RotateLeft64(v3, 56) ^ v4
+
+	// sub key 1
+	v0 += k1
+	v1 += k2
+	v2 += k3
+	v3 += k4
+	v4 += k5
+	v5 += k6 + t1
+	v6 += k7 + t2
+	v7 += k8 + 1
+
+	// round 5
+	v0 += v1
+	v1 = bits.RotateLeft64(v1, 39) ^ v0
+	v2 += v3
+	v3 = bits.RotateLeft64(v3, 30) ^ v2
+	v4 += v5
+	v5 = bits.RotateLeft64(v5, 34) ^ v4
+	v6 += v7
+	v7 = bits.RotateLeft64(v7, 24) ^ v6
+
+	// round 6
+	v2 += v1
+	v1 = bits.RotateLeft64(v1, 13) ^ v2
+	v4 += v7
+	v7 = bits.RotateLeft64(v7, 50) ^ v4
+	v6 += v5
+	v5 = bits.RotateLeft64(v5, 10) ^ v6
+	v0 += v3
+	v3 = bits.RotateLeft64(v3, 17) ^ v0
+
+	// round 7
+	v4 += v1
+	v1 = bits.RotateLeft64(v1, 25) ^ v4
+	v6 += v3
+	v3 = bits.RotateLeft64(v3, 29) ^ v6
+	v0 += v5
+	v5 = bits.RotateLeft64(v5, 39) ^ v0
+	v2 += v7
+	v7 = bits.RotateLeft64(v7, 43) ^ v2
+
+	// round 8
+	v6 += v1
+	v1 = bits.RotateLeft64(v1, 8) ^ v6
+	v0 += v7
+	v7 = bits.RotateLeft64(v7, 35) ^ v0
+	v2 += v5
+	v5 = bits.RotateLeft64(v5, 56) ^ v2
+	v4 += v3
+	v3 = bits.RotateLeft64(v3, 22) ^ v4
+
+	// sub key 2
+	v0 += k2
+	v1 += k3
+	v2 += k4
+	v3 += k5
+	v4 += k6
+	v5 += k7 + t2
+	v6 += k8 + t0
+	v7 += k0 + 2
+
+	// round 9
+	v0 += v1
+	v1 = bits.RotateLeft64(v1, 46) ^ v0
+	v2 += v3
+	v3 = bits.RotateLeft64(v3, 36) ^ v2
+	v4 += v5
+	v5 = bits.RotateLeft64(v5, 19) ^ v4
+	v6 += v7
+	v7 = bits.RotateLeft64(v7, 37) ^ v6
+
+	// round 10
+	v2 += v1
+	v1 = bits.RotateLeft64(v1, 33) ^ v2
+	v4 += v7
+	v7 = bits.RotateLeft64(v7, 27) ^ v4
+	v6 += v5
+	v5 = bits.RotateLeft64(v5, 14) ^ v6
+	v0 += v3
+	v3 = bits.RotateLeft64(v3, 42) ^ v0
+
+	// round 11
+	v4 += v1
+	v1 = bits.RotateLeft64(v1, 17) ^ v4
+	v6 += v3
+	v3 = bits.RotateLeft64(v3, 49) ^ v6
+	v0 += v5
+	v5 = bits.RotateLeft64(v5, 36) ^ v0
+	v2 += v7
+	v7 = bits.RotateLeft64(v7, 39) ^ v2
+
+	// round 12
+	v6 += v1
+	v1 = bits.RotateLeft64(v1, 44) ^ v6
+	v0 += v7
+	v7 = bits.RotateLeft64(v7, 9) ^ v0
+	v2 += v5
+	v5 = bits.RotateLeft64(v5, 54) ^ v2
+	v4 += v3
+	v3 = bits.RotateLeft64(v3, 56) ^ v4
+
+	// sub key 3
+	v0 += k3
+	v1 += k4
+	v2 += k5
+	v3 += k6
+	v4 += k7
+	v5 += k8 + t0
+	v6 += k0 + t1
+	v7 += k1 + 3
+
+	// round 13
+	v0 += v1
+	v1 = bits.RotateLeft64(v1, 39) ^ v0
+	v2 += v3
+	v3 = bits.RotateLeft64(v3, 30) ^ v2
+	v4 += v5
+	v5 = bits.RotateLeft64(v5, 34) ^ v4
+	v6 += v7
+	v7 = bits.RotateLeft64(v7, 24) ^ v6
+
+	// round 14
+	v2 += v1
+	v1 = bits.RotateLeft64(v1, 13) ^ v2
+	v4 += v7
+	v7 = bits.RotateLeft64(v7, 50) ^ v4
+	v6 += v5
+	v5 = bits.RotateLeft64(v5, 10) ^ v6
+	v0 += v3
+	v3 = bits.RotateLeft64(v3, 17) ^ v0
+
+	// round 15
+	v4 += v1
+	v1 = bits.RotateLeft64(v1, 25) ^ v4
+	v6 += v3
+	v3 = bits.RotateLeft64(v3, 29) ^ v6
+	v0 += v5
+	v5 = bits.RotateLeft64(v5, 39) ^ v0
+	v2 += v7
+	v7 = bits.RotateLeft64(v7, 43) ^ v2
+
+	// round 16
+	v6 += v1
+	v1 = bits.RotateLeft64(v1, 8) ^ v6
+	v0 += v7
+	v7 = bits.RotateLeft64(v7, 35) ^ v0
+	v2 += v5
+	v5 = bits.RotateLeft64(v5, 56) ^ v2
+	v4 += v3
+	v3 = bits.RotateLeft64(v3, 22) ^ v4
+
+	// sub key 4
+	v0 += k4
+	v1 += k5
+	v2 += k6
+	v3 += k7
+	v4 += k8
+	v5 += k0 + t1
+	v6 += k1 + t2
+	v7 += k2 + 4
+
+	// round 17
+	v0 += v1
+	v1 = bits.RotateLeft64(v1, 46) ^ v0
+	v2 += v3
+	v3 = bits.RotateLeft64(v3, 36) ^ v2
+	v4 += v5
+	v5 = bits.RotateLeft64(v5, 19) ^ v4
+	v6 += v7
+	v7 = bits.RotateLeft64(v7, 37) ^ v6
+
+	// round 18
+	v2 += v1
+	v1 = bits.RotateLeft64(v1, 33) ^ v2
+	v4 += v7
+	v7 = bits.RotateLeft64(v7, 27) ^ v4
+	v6 += v5
+	v5 = bits.RotateLeft64(v5, 14) ^ v6
+	v0 += v3
+	v3 = bits.RotateLeft64(v3, 42) ^ v0
+
+	// round 19
+	v4 += v1
+	v1 = bits.RotateLeft64(v1, 17) ^ v4
+	v6 += v3
+	v3 = bits.RotateLeft64(v3, 49) ^ v6
+	v0 += v5
+	v5 = bits.RotateLeft64(v5, 36) ^ v0
+	v2 += v7
+	v7 = bits.RotateLeft64(v7, 39) ^ v2
+
+	// round 20
+	v6 += v1
+	v1 = bits.RotateLeft64(v1, 44) ^ v6
+	v0 += v7
+	v7 = bits.RotateLeft64(v7, 9) ^ v0
+	v2 += v5
+	v5 = bits.RotateLeft64(v5, 54) ^ v2
+	v4 += v3
+	v3 = bits.RotateLeft64(v3, 56) ^ v4
+
+	// sub key 5
+	v0 += k5
+	v1 += k6
+	v2 += k7
+	v3 += k8
+	v4 += k0
+	v5 += k1 + t2
+	v6 += k2 + t0
+	v7 += k3 + 5
+
+	// round 21
+	v0 += v1
+	v1 = bits.RotateLeft64(v1, 39) ^ v0
+	v2 += v3
+	v3 = bits.RotateLeft64(v3, 30) ^ v2
+	v4 += v5
+	v5 = bits.RotateLeft64(v5, 34) ^ v4
+	v6 += v7
+	v7 = bits.RotateLeft64(v7, 24) ^ v6
+
+	// round 22
+	v2 += v1
+	v1 = bits.RotateLeft64(v1, 13) ^ v2
+	v4 += v7
+	v7 = bits.RotateLeft64(v7, 50) ^ v4
+	v6 += v5
+	v5 = bits.RotateLeft64(v5, 10) ^ v6
+	v0 += v3
+	v3 = bits.RotateLeft64(v3, 17) ^ v0
+
+	// round 23
+	v4 += v1
+	v1 = bits.RotateLeft64(v1, 25) ^ v4
+	v6 += v3
+	v3 = bits.RotateLeft64(v3, 29) ^ v6
+	v0 += v5
+	v5 = bits.RotateLeft64(v5, 39) ^ v0
+	v2 += v7
+	v7 = bits.RotateLeft64(v7, 43) ^ v2
+
+	// round 24
+	v6 += v1
+	v1 = bits.RotateLeft64(v1, 8) ^ v6
+	v0 += v7
+	v7 = bits.RotateLeft64(v7, 35) ^ v0
+	v2 += v5
+	v5 = bits.RotateLeft64(v5, 56) ^ v2
+	v4 += v3
+	v3 = bits.RotateLeft64(v3, 22) ^ v4
+
+	// sub key 6
+	v0 += k6
+	v1 += k7
+	v2 += k8
+	v3 += k0
+	v4 += k1
+	v5 += k2 + t0
+	v6 += k3 + t1
+	v7 += k4 + 6
+
+	// round 25
+	v0 += v1
+	v1 = bits.RotateLeft64(v1, 46) ^ v0
+	v2 += v3
+	v3 = bits.RotateLeft64(v3, 36) ^ v2
+	v4 += v5
+	v5 = bits.RotateLeft64(v5, 19) ^ v4
+	v6 += v7
+	v7 = bits.RotateLeft64(v7, 37) ^ v6
+
+	// round 26
+	v2 += v1
+	v1 = bits.RotateLeft64(v1, 33) ^ v2
+	v4 += v7
+	v7 = bits.RotateLeft64(v7, 27) ^ v4
+	v6 += v5
+	v5 = bits.RotateLeft64(v5, 14) ^ v6
+	v0 += v3
+	v3 = bits.RotateLeft64(v3, 42) ^ v0
+
+	// round 27
+	v4 += v1
+	v1 = bits.RotateLeft64(v1, 17) ^ v4
+	v6 += v3
+	v3 = bits.RotateLeft64(v3, 49) ^ v6
+	v0 += v5
+	v5 = bits.RotateLeft64(v5, 36) ^ v0
+	v2 += v7
+	v7 = bits.RotateLeft64(v7, 39) ^ v2
+
+	// round 28
+	v6 += v1
+	v1 = bits.RotateLeft64(v1, 44) ^ v6
+	v0 += v7
+	v7 = bits.RotateLeft64(v7, 9) ^ v0
+	v2 += v5
+	v5 = bits.RotateLeft64(v5, 54) ^ v2
+	v4 += v3
+	v3 = bits.RotateLeft64(v3, 56) ^ v4
+
+	// sub key 7
+	v0 += k7
+	v1 += k8
+	v2 += k0
+	v3 += k1
+	v4 += k2
+	v5 += k3 + t1
+	v6 += k4 + t2
+	v7 += k5 + 7
+
+	// round 29
+	v0 += v1
+	v1 = bits.RotateLeft64(v1, 39) ^ v0
+	v2 += v3
+	v3 = bits.RotateLeft64(v3, 30) ^ v2
+	v4 += v5
+	v5 = bits.RotateLeft64(v5, 34) ^ v4
+	v6 += v7
+	v7 = bits.RotateLeft64(v7, 24) ^ v6
+
+	// round 30
+	v2 += v1
+	v1 = bits.RotateLeft64(v1, 13) ^ v2
+	v4 += v7
+	v7 = bits.RotateLeft64(v7, 50) ^ v4
+	v6 += v5
+	v5 = bits.RotateLeft64(v5, 10) ^ v6
+	v0 += v3
+	v3 = bits.RotateLeft64(v3, 17) ^ v0
+
+	// round 31
+	v4 += v1
+	v1 = bits.RotateLeft64(v1, 25) ^ v4
+	v6 += v3
+	v3 = bits.RotateLeft64(v3, 29) ^ v6
+	v0 += v5
+	v5 = bits.RotateLeft64(v5, 39) ^ v0
+	v2 += v7
+	v7 = bits.RotateLeft64(v7, 43) ^ v2
+
+	// round 32
+	v6 += v1
+	v1 = bits.RotateLeft64(v1, 8) ^ v6
+	v0 += v7
+	v7 = bits.RotateLeft64(v7, 35) ^ v0
+	v2 += v5
+	v5 = bits.RotateLeft64(v5, 56) ^ v2
+	v4 += v3
+	v3 = bits.RotateLeft64(v3, 22) ^ v4
+
+	// sub key 8
+	v0 += k8
+	v1 += k0
+	v2 += k1
+	v3 += k2
+	v4 += k3
+	v5 += k4 + t2
+	v6 += k5 + t0
+	v7 += k6 + 8
+
+	// round 33
+	v0 += v1
+	v1 = bits.RotateLeft64(v1, 46) ^ v0
+	v2 += v3
+	v3 = bits.RotateLeft64(v3, 36) ^ v2
+	v4 += v5
+	v5 = bits.RotateLeft64(v5, 19) ^ v4
+	v6 += v7
+	v7 = bits.RotateLeft64(v7, 37) ^ v6
+
+	// round 34
+	v2 += v1
+	v1 = bits.RotateLeft64(v1, 33) ^ v2
+	v4 += v7
+	v7 = bits.RotateLeft64(v7, 27) ^ v4
+	v6 += v5
+	v5 = bits.RotateLeft64(v5, 14) ^ v6
+	v0 += v3
+	v3 = bits.RotateLeft64(v3, 42) ^ v0
+
+	// round 35
+	v4 += v1
+	v1 = bits.RotateLeft64(v1, 17) ^ v4
+	v6 += v3
+	v3 = bits.RotateLeft64(v3, 49) ^ v6
+	v0 += v5
+	v5 = bits.RotateLeft64(v5, 36) ^ v0
+	v2 += v7
+	v7 = bits.RotateLeft64(v7, 39) ^ v2
+
+	// round 36
+	v6 += v1
+	v1 = bits.RotateLeft64(v1, 44) ^ v6
+	v0 += v7
+	v7 = bits.RotateLeft64(v7, 9) ^ v0
+	v2 += v5
+	v5 = bits.RotateLeft64(v5, 54) ^ v2
+	v4 += v3
+	v3 = bits.RotateLeft64(v3, 56) ^ v4
+
+	// sub key 9
+	v0 += k0
+	v1 += k1
+	v2 += k2
+	v3 += k3
+	v4 += k4
+	v5 += k5 + t0
+	v6 += k6 + t1
+	v7 += k7 + 9
+
+	// round 37
+	v0 += v1
+	v1 = bits.RotateLeft64(v1, 39) ^ v0
+	v2 += v3
+	v3 = bits.RotateLeft64(v3, 30) ^ v2
+	v4 += v5
+	v5 = bits.RotateLeft64(v5, 34) ^ v4
+	v6 += v7
+	v7 = bits.RotateLeft64(v7, 24) ^ v6
+
+	// round 38
+	v2 += v1
+	v1 = bits.RotateLeft64(v1, 13) ^ v2
+	v4 += v7
+	v7 = bits.RotateLeft64(v7, 50) ^ v4
+	v6 += v5
+	v5 = bits.RotateLeft64(v5, 10) ^ v6
+	v0 += v3
+	v3 = bits.RotateLeft64(v3, 17) ^ v0
+
+	// round 39
+	v4 += v1
+	v1 = bits.RotateLeft64(v1, 25) ^ v4
+	v6 += v3
+	v3 = bits.RotateLeft64(v3, 29) ^ v6
+	v0 += v5
+	v5 = bits.RotateLeft64(v5, 39) ^ v0
+	v2 += v7
+	v7 = bits.RotateLeft64(v7, 43) ^ v2
+
+	// round 40
+	v6 += v1
+	v1 = bits.RotateLeft64(v1, 8) ^ v6
+	v0 += v7
+	v7 = bits.RotateLeft64(v7, 35) ^ v0
+	v2 += v5
+	v5 = bits.RotateLeft64(v5, 56) ^ v2
+	v4 += v3
+	v3 = bits.RotateLeft64(v3, 22) ^ v4
+
+	// sub key 10
+	v0 += k1
+	v1 += k2
+	v2 += k3
+	v3 += k4
+	v4 += k5
+	v5 += k6 + t1
+	v6 += k7 + t2
+	v7 += k8 + 10
+
+	// round 41
+	v0 += v1
+	v1 = bits.RotateLeft64(v1, 46) ^ v0
+	v2 += v3
+	v3 = bits.RotateLeft64(v3, 36) ^ v2
+	v4 += v5
+	v5 = bits.RotateLeft64(v5, 19) ^ v4
+	v6 += v7
+	v7 = bits.RotateLeft64(v7, 37) ^ v6
+
+	// round 42
+	v2 += v1
+	v1 = bits.RotateLeft64(v1, 33) ^ v2
+	v4 += v7
+	v7 = bits.RotateLeft64(v7, 27) ^ v4
+	v6 += v5
+	v5 = bits.RotateLeft64(v5, 14) ^ v6
+	v0 += v3
+	v3 = bits.RotateLeft64(v3, 42) ^ v0
+
+	// round 43
+	v4 += v1
+	v1 = bits.RotateLeft64(v1, 17) ^ v4
+	v6 += v3
+	v3 = bits.RotateLeft64(v3, 49) ^ v6
+	v0 += v5
+	v5 = bits.RotateLeft64(v5, 36) ^ v0
+	v2 += v7
+	v7 = bits.RotateLeft64(v7, 39) ^ v2
+
+	// round 44
+	v6 += v1
+	v1 = bits.RotateLeft64(v1, 44) ^ v6
+	v0 += v7
+	v7 = bits.RotateLeft64(v7, 9) ^ v0
+	v2 += v5
+	v5 = bits.RotateLeft64(v5, 54) ^ v2
+	v4 += v3
+	v3 = bits.RotateLeft64(v3, 56) ^ v4
+
+	// sub key 11
+	v0 += k2
+	v1 += k3
+	v2 += k4
+	v3 += k5
+	v4 += k6
+	v5 += k7 + t2
+	v6 += k8 + t0
+	v7 += k0 + 11
+
+	// round 45
+	v0 += v1
+	v1 = bits.RotateLeft64(v1, 39) ^ v0
+	v2 += v3
+	v3 = bits.RotateLeft64(v3, 30) ^ v2
+	v4 += v5
+	v5 = bits.RotateLeft64(v5, 34) ^ v4
+	v6 += v7
+	v7 = bits.RotateLeft64(v7, 24) ^ v6
+
+	// round 46
+	v2 += v1
+	v1 = bits.RotateLeft64(v1, 13) ^ v2
+	v4 += v7
+	v7 = bits.RotateLeft64(v7, 50) ^ v4
+	v6 += v5
+	v5 = bits.RotateLeft64(v5, 10) ^ v6
+	v0 += v3
+	v3 = bits.RotateLeft64(v3, 17) ^ v0
+
+	// round 47
+	v4 += v1
+	v1 = bits.RotateLeft64(v1, 25) ^ v4
+	v6 += v3
+	v3 = bits.RotateLeft64(v3, 29) ^ v6
+	v0 += v5
+	v5 = bits.RotateLeft64(v5, 39) ^ v0
+	v2 += v7
+	v7 = bits.RotateLeft64(v7, 43) ^ v2
+
+	// round 48
+	v6 += v1
+	v1 = bits.RotateLeft64(v1, 8) ^ v6
+	v0 += v7
+	v7 = bits.RotateLeft64(v7, 35) ^ v0
+	v2 += v5
+	v5 = bits.RotateLeft64(v5, 56) ^ v2
+	v4 += v3
+	v3 = bits.RotateLeft64(v3, 22) ^ v4
+
+	// sub key 12
+	v0 += k3
+	v1 += k4
+	v2 += k5
+	v3 += k6
+	v4 += k7
+	v5 += k8 + t0
+	v6 += k0 + t1
+	v7 += k1 + 12
+
+	// round 49
+	v0 += v1
+	v1 = bits.RotateLeft64(v1, 46) ^ v0
+	v2 += v3
+	v3 = bits.RotateLeft64(v3, 36) ^ v2
+	v4 += v5
+	v5 = bits.RotateLeft64(v5, 19) ^ v4
+	v6 += v7
+	v7 = bits.RotateLeft64(v7, 37) ^ v6
+
+	// round 50
+	v2 += v1
+	v1 = bits.RotateLeft64(v1, 33) ^ v2
+	v4 += v7
+	v7 = bits.RotateLeft64(v7, 27) ^ v4
+	v6 += v5
+	v5 = bits.RotateLeft64(v5, 14) ^ v6
+	v0 += v3
+	v3 = bits.RotateLeft64(v3, 42) ^ v0
+
+	// round 51
+	v4 += v1
+	v1 = bits.RotateLeft64(v1, 17) ^ v4
+	v6 += v3
+	v3 = bits.RotateLeft64(v3, 49) ^ v6
+	v0 += v5
+	v5 = bits.RotateLeft64(v5, 36) ^ v0
+	v2 += v7
+	v7 = bits.RotateLeft64(v7, 39) ^ v2
+
+	// round 52
+	v6 += v1
+	v1 = bits.RotateLeft64(v1, 44) ^ v6
+	v0 += v7
+	v7 = bits.RotateLeft64(v7, 9) ^ v0
+	v2 += v5
+	v5 = bits.RotateLeft64(v5, 54) ^ v2
+	v4 += v3
+	v3 = bits.RotateLeft64(v3, 56) ^ v4
+
+	// sub key 13
+	v0 += k4
+	v1 += k5
+	v2 += k6
+	v3 += k7
+	v4 += k8
+	v5 += k0 + t1
+	v6 += k1 + t2
+	v7 += k2 + 13
+
+	// round 53
+	v0 += v1
+	v1 = bits.RotateLeft64(v1, 39) ^ v0
+	v2 += v3
+	v3 = bits.RotateLeft64(v3, 30) ^ v2
+	v4 += v5
+	v5 = bits.RotateLeft64(v5, 34) ^ v4
+	v6 += v7
+	v7 = bits.RotateLeft64(v7, 24) ^ v6
+
+	// round 54
+	v2 += v1
+	v1 = bits.RotateLeft64(v1, 13) ^ v2
+	v4 += v7
+	v7 = bits.RotateLeft64(v7, 50) ^ v4
+	v6 += v5
+	v5 = bits.RotateLeft64(v5, 10) ^ v6
+	v0 += v3
+	v3 = bits.RotateLeft64(v3, 17) ^ v0
+
+	// round 55
+	v4 += v1
+	v1 = bits.RotateLeft64(v1, 25) ^ v4
+	v6 += v3
+	v3 = bits.RotateLeft64(v3, 29) ^ v6
+	v0 += v5
+	v5 = bits.RotateLeft64(v5, 39) ^ v0
+	v2 += v7
+	v7 = bits.RotateLeft64(v7, 43) ^ v2
+
+	// round 56
+	v6 += v1
+	v1 = bits.RotateLeft64(v1, 8) ^ v6
+	v0 += v7
+	v7 = bits.RotateLeft64(v7, 35) ^ v0
+	v2 += v5
+	v5 = bits.RotateLeft64(v5, 56) ^ v2
+	v4 += v3
+	v3 = bits.RotateLeft64(v3, 22) ^ v4
+
+	// sub key 14
+	v0 += k5
+	v1 += k6
+	v2 += k7
+	v3 += k8
+	v4 += k0
+	v5 += k1 + t2
+	v6 += k2 + t0
+	v7 += k3 + 14
+
+	// round 57
+	v0 += v1
+	v1 = bits.RotateLeft64(v1, 46) ^ v0
+	v2 += v3
+	v3 = bits.RotateLeft64(v3, 36) ^ v2
+	v4 += v5
+	v5 = bits.RotateLeft64(v5, 19) ^ v4
+	v6 += v7
+	v7 = bits.RotateLeft64(v7, 37) ^ v6
+
+	// round 58
+	v2 += v1
+	v1 = bits.RotateLeft64(v1, 33) ^ v2
+	v4 += v7
+	v7 = bits.RotateLeft64(v7, 27) ^ v4
+	v6 += v5
+	v5 = bits.RotateLeft64(v5, 14) ^ v6
+	v0 += v3
+	v3 = bits.RotateLeft64(v3, 42) ^ v0
+
+	// round 59
+	v4 += v1
+	v1 = bits.RotateLeft64(v1, 17) ^ v4
+	v6 += v3
+	v3 = bits.RotateLeft64(v3, 49) ^ v6
+	v0 += v5
+	v5 = bits.RotateLeft64(v5, 36) ^ v0
+	v2 += v7
+	v7 = bits.RotateLeft64(v7, 39) ^ v2
+
+	// round 60
+	v6 += v1
+	v1 = bits.RotateLeft64(v1, 44) ^ v6
+	v0 += v7
+	v7 = bits.RotateLeft64(v7, 9) ^ v0
+	v2 += v5
+	v5 = bits.RotateLeft64(v5, 54) ^ v2
+	v4 += v3
+	v3 = bits.RotateLeft64(v3, 56) ^ v4
+
+	// sub key 15
+	v0 += k6
+	v1 += k7
+	v2 += k8
+	v3 += k0
+	v4 += k1
+	v5 += k2 + t0
+	v6 += k3 + t1
+	v7 += k4 + 15
+
+	// round 61
+	v0 += v1
+	v1 = bits.RotateLeft64(v1, 39) ^ v0
+	v2 += v3
+	v3 = bits.RotateLeft64(v3, 30) ^ v2
+	v4 += v5
+	v5 = bits.RotateLeft64(v5, 34) ^ v4
+	v6 += v7
+	v7 = bits.RotateLeft64(v7, 24) ^ v6
+
+	// round 62
+	v2 += v1
+	v1 = bits.RotateLeft64(v1, 13) ^ v2
+	v4 += v7
+	v7 = bits.RotateLeft64(v7, 50) ^ v4
+	v6 += v5
+	v5 = bits.RotateLeft64(v5, 10) ^ v6
+	v0 += v3
+	v3 = bits.RotateLeft64(v3, 17) ^ v0
+
+	// round 63
+	v4 += v1
+	v1 = bits.RotateLeft64(v1, 25) ^ v4
+	v6 += v3
+	v3 = bits.RotateLeft64(v3, 29) ^ v6
+	v0 += v5
+	v5 = bits.RotateLeft64(v5, 39) ^ v0
+	v2 += v7
+	v7 = bits.RotateLeft64(v7, 43) ^ v2
+
+	// round 64
+	v6 += v1
+	v1 = bits.RotateLeft64(v1, 8) ^ v6
+	v0 += v7
+	v7 = bits.RotateLeft64(v7, 35) ^ v0
+	v2 += v5
+	v5 = bits.RotateLeft64(v5, 56) ^ v2
+	v4 += v3
+	v3 = bits.RotateLeft64(v3, 22) ^ v4
+
+	// sub key 16
+	v0 += k7
+	v1 += k8
+	v2 += k0
+	v3 += k1
+	v4 += k2
+	v5 += k3 + t1
+	v6 += k4 + t2
+	v7 += k5 + 16
+
+	// round 65
+	v0 += v1
+	v1 = bits.RotateLeft64(v1, 46) ^ v0
+	v2 += v3
+	v3 = bits.RotateLeft64(v3, 36) ^ v2
+	v4 += v5
+	v5 = bits.RotateLeft64(v5, 19) ^ v4
+	v6 += v7
+	v7 = bits.RotateLeft64(v7, 37) ^ v6
+
+	// round 66
+	v2 += v1
+	v1 = bits.RotateLeft64(v1, 33) ^ v2
+	v4 += v7
+	v7 = bits.RotateLeft64(v7, 27) ^ v4
+	v6 += v5
+	v5 = bits.RotateLeft64(v5, 14) ^ v6
+	v0 += v3
+	v3 = bits.RotateLeft64(v3, 42) ^ v0
+
+	// round 67
+	v4 += v1
+	v1 = bits.RotateLeft64(v1, 17) ^ v4
+	v6 += v3
+	v3 = bits.RotateLeft64(v3, 49) ^ v6
+	v0 += v5
+	v5 = bits.RotateLeft64(v5, 36) ^ v0
+	v2 += v7
+	v7 = bits.RotateLeft64(v7, 39) ^ v2
+
+	// round 68
+	v6 += v1
+	v1 = bits.RotateLeft64(v1, 44) ^ v6
+	v0 += v7
+	v7 = bits.RotateLeft64(v7, 9) ^ v0
+	v2 += v5
+	v5 = bits.RotateLeft64(v5, 54) ^ v2
+	v4 += v3
+	v3 = bits.RotateLeft64(v3, 56) ^ v4
+
+	// sub key 17
+	v0 += k8
+	v1 += k0
+	v2 += k1
+	v3 += k2
+	v4 += k3
+	v5 += k4 + t2
+	v6 += k5 + t0
+	v7 += k6 + 17
+
+	// round 69
+	v0 += v1
+	v1 = bits.RotateLeft64(v1, 39) ^ v0
+	v2 += v3
+	v3 = bits.RotateLeft64(v3, 30) ^ v2
+	v4 += v5
+	v5 = bits.RotateLeft64(v5, 34) ^ v4
+	v6 += v7
+	v7 = bits.RotateLeft64(v7, 24) ^ v6
+
+	// round 70
+	v2 += v1
+	v1 = bits.RotateLeft64(v1, 13) ^ v2
+	v4 += v7
+	v7 = bits.RotateLeft64(v7, 50) ^ v4
+	v6 += v5
+	v5 = bits.RotateLeft64(v5, 10) ^ v6
+	v0 += v3
+	v3 = bits.RotateLeft64(v3, 17) ^ v0
+
+	// round 71
+	v4 += v1
+	v1 = bits.RotateLeft64(v1, 25) ^ v4
+	v6 += v3
+	v3 = bits.RotateLeft64(v3, 29) ^ v6
+	v0 += v5
+	v5 = bits.RotateLeft64(v5, 39) ^ v0
+	v2 += v7
+	v7 = bits.RotateLeft64(v7, 43) ^ v2
+
+	// round 72
+	v6 += v1
+	v1 = bits.RotateLeft64(v1, 8) ^ v6
+	v0 += v7
+	v7 = bits.RotateLeft64(v7, 35) ^ v0
+	v2 += v5
+	v5 = bits.RotateLeft64(v5, 56) ^ v2
+	v4 += v3
+	v3 = bits.RotateLeft64(v3, 22) ^ v4
+
+	// sub key 18
+	v0 += k0
+	v1 += k1
+	v2 += k2
+	v3 += k3
+	v4 += k4
+	v5 += k5 + t0
+	v6 += k6 + t1
+	v7 += k7 + 18
+
+	block[0] = v0
+	block[1] = v1
+	block[2] = v2
+	block[3] = v3
+	block[4] = v4
+	block[5] = v5
+	block[6] = v6
+	block[7] = v7
+}
+
+// encrypt1024 encrypts the block in place by Threefish-1024 with the key and the tweak.
+func encrypt1024(key *[maxWords]uint64, tweak *[2]uint64, block *[maxWords]uint64) {
+	k0, k1, k2, k3, k4, k5, k6, k7, k8, k9, k10, k11, k12, k13, k14, k15 := key[0], key[1], key[2], key[3], key[4], key[5], key[6], key[7], key[8], key[9], key[10], key[11], key[12], key[13], key[14], key[15]
+	k16 := keyParity ^ k0 ^ k1 ^ k2 ^ k3 ^ k4 ^ k5 ^ k6 ^ k7 ^ k8 ^ k9 ^ k10 ^ k11 ^ k12 ^ k13 ^ k14 ^ k15
+	t0, t1 := tweak[0], tweak[1]
+	t2 := t0 ^ t1
+
+	v0 := block[0]
+	v1 := block[1]
+	v2 := block[2]
+	v3 := block[3]
+	v4 := block[4]
+	v5 := block[5]
+	v6 := block[6]
+	v7 := block[7]
+	v8 := block[8]
+	v9 := block[9]
+	v10 := block[10]
+	v11 := block[11]
+	v12 := block[12]
+	v13 := block[13]
+	v14 := block[14]
+	v15 := block[15]
+
+	// sub key 0
+	v0 += k0
+	v1 += k1
+	v2 += k2
+	v3 += k3
+	v4 += k4
+	v5 += k5
+	v6 += k6
+	v7 += k7
+	v8 += k8
+	v9 += k9
+	v10 += k10
+	v11 += k11
+	v12 += k12
+	v13 += k13 + t0
+	v14 += k14 + t1
+	v15 += k15
+
+	// round 1
+	v0 += v1
+	v1 = bits.RotateLeft64(v1, 24) ^ v0
+	v2 += v3
+	v3 = bits.RotateLeft64(v3, 13) ^ v2
+	v4 += v5
+	v5 = bits.RotateLeft64(v5, 8) ^ v4
+	v6 += v7
+	v7 = bits.RotateLeft64(v7, 47) ^ v6
+	v8 += v9
+	v9 = bits.RotateLeft64(v9, 8) ^ v8
+	v10 += v11
+	v11 = bits.RotateLeft64(v11, 17) ^ v10
+	v12 += v13
+	v13 = bits.RotateLeft64(v13, 22) ^ v12
+	v14 += v15
+	v15 = bits.RotateLeft64(v15, 37) ^ v14
+
+	// round 2
+	v0 += v9
+	v9 = bits.RotateLeft64(v9, 38) ^ v0
+	v2 += v13
+	v13 = bits.RotateLeft64(v13, 19) ^ v2
+	v6 += v11
+	v11 = bits.RotateLeft64(v11, 10) ^ v6
+	v4 += v15
+	v15 = bits.RotateLeft64(v15, 55) ^ v4
+	v10 += v7
+	v7 = bits.RotateLeft64(v7, 49) ^ v10
+	v12 += v3
+	v3 = bits.RotateLeft64(v3, 18) ^ v12
+	v14 += v5
+	v5 = bits.RotateLeft64(v5, 23) ^ v14
+	v8 += v1
+	v1 = bits.RotateLeft64(v1, 52) ^ v8
+
+	// round 3
+	v0 += v7
+	v7 = bits.RotateLeft64(v7, 33) ^ v0
+	v2 += v5
+	v5 = bits.RotateLeft64(v5, 4) ^ v2
+	v4 += v3
+	v3 = bits.RotateLeft64(v3, 51) ^ v4
+	v6 += v1
+	v1 = bits.RotateLeft64(v1, 13) ^ v6
+	v12 += v15
+	v15 = bits.RotateLeft64(v15, 34) ^ v12
+	v14 += v13
+	v13 = bits.RotateLeft64(v13, 41) ^ v14
+	v8 += v11
+	v11 = bits.RotateLeft64(v11, 59) ^ v8
+	v10 += v9
+	v9 = bits.RotateLeft64(v9, 17) ^ v10
+
+	// round 4
+	v0 += v15
+	v15 = bits.RotateLeft64(v15, 5) ^ v0
+	v2 += v11
+	v11 = bits.RotateLeft64(v11, 20) ^ v2
+	v6 += v13
+	v13 = bits.RotateLeft64(v13, 48) ^ v6
+	v4 += v9
+	v9 = bits.RotateLeft64(v9, 41) ^ v4
+	v14 += v1
+	v1 = bits.RotateLeft64(v1, 47) ^ v14
+	v8 += v5
+	v5 = bits.RotateLeft64(v5, 28) ^ v8
+	v10 += v3
+	v3 = bits.RotateLeft64(v3, 16) ^ v10
+	v12 += v7
+	v7 = bits.RotateLeft64(v7, 25) ^ v12
+
+	// sub key 1
+	v0 += k1
+	v1 += k2
+	v2 += k3
+	v3 += k4
+	v4 += k5
+	v5 += k6
+	v6 += k7
+	v7 += k8
+	v8 += k9
+	v9 += k10
+	v10 += k11
+	v11 += k12
+	v12 += k13
+	v13 += k14 + t1
+	v14 += k15 + t2
+	v15 += k16 + 1
+
+	// round 5
+	v0 += v1
+	v1 = bits.RotateLeft64(v1, 41) ^ v0
+	v2 += v3
+	v3 = bits.RotateLeft64(v3, 9) ^ v2
+	v4 += v5
+	v5 = bits.RotateLeft64(v5, 37) ^ v4
+	v6 += v7
+	v7 = bits.RotateLeft64(v7, 31) ^ v6
+	v8 += v9
+	v9 = bits.RotateLeft64(v9, 12) ^ v8
+	v10 += v11
+	v11 = bits.RotateLeft64(v11, 47) ^ v10
+	v12 += v13
+	v13 = bits.RotateLeft64(v13, 44) ^ v12
+	v14 += v15
+	v15 = bits.RotateLeft64(v15, 30) ^ v14
+
+	// round 6
+	v0 += v9
+	v9 = bits.RotateLeft64(v9, 16) ^ v0
+	v2 += v13
+	v13 = bits.RotateLeft64(v13, 34) ^ v2
+	v6 += v11
+	v11 = bits.RotateLeft64(v11, 56) ^ v6
+	v4 += v15
+	v15 = bits.RotateLeft64(v15, 51) ^ v4
+	v10 += v7
+	v7 = bits.RotateLeft64(v7, 4) ^ v10
+	v12 += v3
+	v3 = bits.RotateLeft64(v3, 53) ^ v12
+	v14 += v5
+	v5 = bits.RotateLeft64(v5, 42) ^ v14
+	v8 += v1
+	v1 = bits.RotateLeft64(v1, 41) ^ v8
+
+	// round 7
+	v0 += v7
+	v7 = bits.RotateLeft64(v7, 31) ^ v0
+	v2 += v5
+	v5 = bits.RotateLeft64(v5, 44) ^ v2
+	v4 += v3
+	v3 = bits.RotateLeft64(v3, 47) ^ v4
+	v6 += v1
+	v1 = bits.RotateLeft64(v1, 46) ^ v6
+	v12 += v15
+	v15 = bits.RotateLeft64(v15, 19) ^ v12
+	v14 += v13
+	v13 = bits.RotateLeft64(v13, 42) ^ v14
+	v8 += v11
+	v11 = bits.RotateLeft64(v11, 44) ^ v8
+	v10 += v9
+	v9 = bits.RotateLeft64(v9, 25) ^ v10
+
+	// round 8
+	v0 += v15
+	v15 = bits.RotateLeft64(v15, 9) ^ v0
+	v2 += v11
+	v11 = bits.RotateLeft64(v11, 48) ^ v2
+	v6 += v13
+	v13 = bits.RotateLeft64(v13, 35) ^ v6
+	v4 += v9
+	v9 = bits.RotateLeft64(v9, 52) ^ v4
+	v14 += v1
+	v1 = bits.RotateLeft64(v1, 23) ^ v14
+	v8 += v5
+	v5 = bits.RotateLeft64(v5, 31) ^ v8
+	v10 += v3
+	v3 = bits.RotateLeft64(v3, 37) ^ v10
+	v12 += v7
+	v7 = bits.RotateLeft64(v7, 20) ^ v12
+
+	// sub key 2
+	v0 += k2
+	v1 += k3
+	v2 += k4
+	v3 += k5
+	v4 += k6
+	v5 += k7
+	v6 += k8
+	v7 += k9
+	v8 += k10
+	v9 += k11
+	v10 += k12
+	v11 += k13
+	v12 += k14
+	v13 += k15 + t2
+	v14 += k16 + t0
+	v15 += k0 + 2
+
+	// round 9
+	v0 += v1
+	v1 = bits.RotateLeft64(v1, 24) ^ v0
+	v2 += v3
+	v3 = bits.RotateLeft64(v3, 13) ^ v2
+	v4 += v5
+	v5 = bits.RotateLeft64(v5, 8) ^ v4
+	v6 += v7
+	v7 = bits.RotateLeft64(v7, 47) ^ v6
+	v8 += v9
+	v9 = bits.RotateLeft64(v9, 8) ^ v8
+	v10 += v11
+	v11 = bits.RotateLeft64(v11, 17) ^ v10
+	v12 += v13
+	v13 = bits.RotateLeft64(v13, 22) ^ v12
+	v14 += v15
+	v15 = bits.RotateLeft64(v15, 37) ^ v14
+
+	// round 10
+	v0 += v9
+	v9 = bits.RotateLeft64(v9, 38) ^ v0
+	v2 += v13
+	v13 = bits.RotateLeft64(v13, 19) ^ v2
+	v6 += v11
+	v11 = bits.RotateLeft64(v11, 10) ^ v6
+	v4 += v15
+	v15 = bits.RotateLeft64(v15, 55) ^ v4
+	v10 += v7
+	v7 = bits.RotateLeft64(v7, 49) ^ v10
+	v12 += v3
+	v3 = bits.RotateLeft64(v3, 18) ^ v12
+	v14 += v5
+	v5 = bits.RotateLeft64(v5, 23) ^ v14
+	v8 += v1
+	v1 = bits.RotateLeft64(v1, 52) ^ v8
+
+	// round 11
+	v0 += v7
+	v7 = bits.RotateLeft64(v7, 33) ^ v0
+	v2 += v5
+	v5 = bits.RotateLeft64(v5, 4) ^ v2
+	v4 += v3
+	v3 = bits.RotateLeft64(v3, 51) ^ v4
+	v6 += v1
+	v1 = bits.RotateLeft64(v1, 13) ^ v6
+	v12 += v15
+	v15 = bits.RotateLeft64(v15, 34) ^ v12
+	v14 += v13
+	v13 = bits.RotateLeft64(v13, 41) ^ v14
+	v8 += v11
+	v11 = bits.RotateLeft64(v11, 59) ^ v8
+	v10 += v9
+	v9 = bits.RotateLeft64(v9, 17) ^ v10
+
+	// round 12
+	v0 += v15
+	v15 = bits.RotateLeft64(v15, 5) ^ v0
+	v2 += v11
+	v11 = bits.RotateLeft64(v11, 20) ^ v2
+	v6 += v13
+	v13 = bits.RotateLeft64(v13, 48) ^ v6
+	v4 += v9
+	v9 = bits.RotateLeft64(v9, 41) ^ v4
+	v14 += v1
+	v1 = bits.RotateLeft64(v1, 47) ^ v14
+	v8 += v5
+	v5 = bits.RotateLeft64(v5, 28) ^ v8
+	v10 += v3
+	v3 = bits.RotateLeft64(v3, 16) ^ v10
+	v12 += v7
+	v7 = bits.RotateLeft64(v7, 25) ^ v12
+
+	// sub key 3
+	v0 += k3
+	v1 += k4
+	v2 += k5
+	v3 += k6
+	v4 += k7
+	v5 += k8
+	v6 += k9
+	v7 += k10
+	v8 += k11
+	v9 += k12
+	v10 += k13
+	v11 += k14
+	v12 += k15
+	v13 += k16 + t0
+	v14 += k0 + t1
+	v15 += k1 + 3
+
+	// round 13
+	v0 += v1
+	v1 = bits.RotateLeft64(v1, 41) ^ v0
+	v2 += v3
+	v3 = bits.RotateLeft64(v3, 9) ^ v2
+	v4 += v5
+	v5 = bits.RotateLeft64(v5, 37) ^ v4
+	v6 += v7
+	v7 = bits.RotateLeft64(v7, 31) ^ v6
+	v8 += v9
+	v9 = bits.RotateLeft64(v9, 12) ^ v8
+	v10 += v11
+	v11 = bits.RotateLeft64(v11, 47) ^ v10
+	v12 += v13
+	v13 = bits.RotateLeft64(v13, 44) ^ v12
+	v14 += v15
+	v15 = bits.RotateLeft64(v15, 30) ^ v14
+
+	// round 14
+	v0 += v9
+	v9 = bits.RotateLeft64(v9, 16) ^ v0
+	v2 += v13
+	v13 = bits.RotateLeft64(v13, 34) ^ v2
+	v6 += v11
+	v11 = bits.RotateLeft64(v11, 56) ^ v6
+	v4 += v15
+	v15 = bits.RotateLeft64(v15, 51) ^ v4
+	v10 += v7
+	v7 = bits.RotateLeft64(v7, 4) ^ v10
+	v12 += v3
+	v3 = bits.RotateLeft64(v3, 53) ^ v12
+	v14 += v5
+	v5 = bits.RotateLeft64(v5, 42) ^ v14
+	v8 += v1
+	v1 = bits.RotateLeft64(v1, 41) ^ v8
+
+	// round 15
+	v0 += v7
+	v7 = bits.RotateLeft64(v7, 31) ^ v0
+	v2 += v5
+	v5 = bits.RotateLeft64(v5, 44) ^ v2
+	v4 += v3
+	v3 = bits.RotateLeft64(v3, 47) ^ v4
+	v6 += v1
+	v1 = bits.RotateLeft64(v1, 46) ^ v6
+	v12 += v15
+	v15 = bits.RotateLeft64(v15, 19) ^ v12
+	v14 += v13
+	v13 = bits.RotateLeft64(v13, 42) ^ v14
+	v8 += v11
+	v11 = bits.RotateLeft64(v11, 44) ^ v8
+	v10 += v9
+	v9 = bits.RotateLeft64(v9, 25) ^ v10
+
+	// round 16
+	v0 += v15
+	v15 = bits.RotateLeft64(v15, 9) ^ v0
+	v2 += v11
+	v11 = bits.RotateLeft64(v11, 48) ^ v2
+	v6 += v13
+	v13 = bits.RotateLeft64(v13, 35) ^ v6
+	v4 += v9
+	v9 = bits.RotateLeft64(v9, 52) ^ v4
+	v14 += v1
+	v1 = bits.RotateLeft64(v1, 23) ^ v14
+	v8 += v5
+	v5 = bits.RotateLeft64(v5, 31) ^ v8
+	v10 += v3
+	v3 = bits.RotateLeft64(v3, 37) ^ v10
+	v12 += v7
+	v7 = bits.RotateLeft64(v7, 20) ^ v12
+
+	// sub key 4
+	v0 += k4
+	v1 += k5
+	v2 += k6
+	v3 += k7
+	v4 += k8
+	v5 += k9
+	v6 += k10
+	v7 += k11
+	v8 += k12
+	v9 += k13
+	v10 += k14
+	v11 += k15
+	v12 += k16
+	v13 += k0 + t1
+	v14 += k1 + t2
+	v15 += k2 + 4
+
+	// round 17
+	v0 += v1
+	v1 = bits.RotateLeft64(v1, 24) ^ v0
+	v2 += v3
+	v3 = bits.RotateLeft64(v3, 13) ^ v2
+	v4 += v5
+	v5 = bits.RotateLeft64(v5, 8) ^ v4
+	v6 += v7
+	v7 = bits.RotateLeft64(v7, 47) ^ v6
+	v8 += v9
+	v9 = bits.RotateLeft64(v9, 8) ^ v8
+	v10 += v11
+	v11 = bits.RotateLeft64(v11, 17) ^ v10
+	v12 += v13
+	v13 = bits.RotateLeft64(v13, 22) ^ v12
+	v14 += v15
+	v15 = bits.RotateLeft64(v15, 37) ^ v14
+
+	// round 18
+	v0 += v9
+	v9 = bits.RotateLeft64(v9, 38) ^ v0
+	v2 += v13
+	v13 = bits.RotateLeft64(v13, 19) ^ v2
+	v6 += v11
+	v11 = bits.RotateLeft64(v11, 10) ^ v6
+	v4 += v15
+	v15 = bits.RotateLeft64(v15, 55) ^ v4
+	v10 += v7
+	v7 = bits.RotateLeft64(v7, 49) ^ v10
+	v12 += v3
+	v3 = bits.RotateLeft64(v3, 18) ^ v12
+	v14 += v5
+	v5 = bits.RotateLeft64(v5, 23) ^ v14
+	v8 += v1
+	v1 = bits.RotateLeft64(v1, 52) ^ v8
+
+	// round 19
+	v0 += v7
+	v7 = bits.RotateLeft64(v7, 33) ^ v0
+	v2 += v5
+	v5 = bits.RotateLeft64(v5, 4) ^ v2
+	v4 += v3
+	v3 = bits.RotateLeft64(v3, 51) ^ v4
+	v6 += v1
+	v1 = bits.RotateLeft64(v1, 13) ^ v6
+	v12 += v15
+	v15 = bits.RotateLeft64(v15, 34) ^ v12
+	v14 += v13
+	v13 = bits.RotateLeft64(v13, 41) ^ v14
+	v8 += v11
+	v11 = bits.RotateLeft64(v11, 59) ^ v8
+	v10 += v9
+	v9 = bits.RotateLeft64(v9, 17) ^ v10
+
+	// round 20
+	v0 += v15
+	v15 = bits.RotateLeft64(v15, 5) ^ v0
+	v2 += v11
+	v11 = bits.RotateLeft64(v11, 20) ^ v2
+	v6 += v13
+	v13 = bits.RotateLeft64(v13, 48) ^ v6
+	v4 += v9
+	v9 = bits.RotateLeft64(v9, 41) ^ v4
+	v14 += v1
+	v1 = bits.RotateLeft64(v1, 47) ^ v14
+	v8 += v5
+	v5 = bits.RotateLeft64(v5, 28) ^ v8
+	v10 += v3
+	v3 = bits.RotateLeft64(v3, 16) ^ v10
+	v12 += v7
+	v7 = bits.RotateLeft64(v7, 25) ^ v12
+
+	// sub key 5
+	v0 += k5
+	v1 += k6
+	v2 += k7
+	v3 += k8
+	v4 += k9
+	v5 += k10
+	v6 += k11
+	v7 += k12
+	v8 += k13
+	v9 += k14
+	v10 += k15
+	v11 += k16
+	v12 += k0
+	v13 += k1 + t2
+	v14 += k2 + t0
+	v15 += k3 + 5
+
+	// round 21
+	v0 += v1
+	v1 = bits.RotateLeft64(v1, 41) ^ v0
+	v2 += v3
+	v3 = bits.RotateLeft64(v3, 9) ^ v2
+	v4 += v5
+	v5 = bits.RotateLeft64(v5, 37) ^ v4
+	v6 += v7
+	v7 = bits.RotateLeft64(v7, 31) ^ v6
+	v8 += v9
+	v9 = bits.RotateLeft64(v9, 12) ^ v8
+	v10 += v11
+	v11 = bits.RotateLeft64(v11, 47) ^ v10
+	v12 += v13
+	v13 = bits.RotateLeft64(v13, 44) ^ v12
+	v14 += v15
+	v15 = bits.RotateLeft64(v15, 30) ^ v14
+
+	// round 22
+	v0 += v9
+	v9 = bits.RotateLeft64(v9, 16) ^ v0
+	v2 += v13
+	v13 = bits.RotateLeft64(v13, 34) ^ v2
+	v6 += v11
+	v11 = bits.RotateLeft64(v11, 56) ^ v6
+	v4 += v15
+	v15 = bits.RotateLeft64(v15, 51) ^ v4
+	v10 += v7
+	v7 = bits.RotateLeft64(v7, 4) ^ v10
+	v12 += v3
+	v3 = bits.RotateLeft64(v3, 53) ^ v12
+	v14 += v5
+	v5 = bits.RotateLeft64(v5, 42) ^ v14
+	v8 += v1
+	v1 = bits.RotateLeft64(v1, 41) ^ v8
+
+	// round 23
+	v0 += v7
+	v7 = bits.RotateLeft64(v7, 31) ^ v0
+	v2 += v5
+	v5 = bits.RotateLeft64(v5, 44) ^ v2
+	v4 += v3
+	v3 = bits.RotateLeft64(v3, 47) ^ v4
+	v6 += v1
+	v1 = bits.RotateLeft64(v1, 46) ^ v6
+	v12 += v15
+	v15 = bits.RotateLeft64(v15, 19) ^ v12
+	v14 += v13
+	v13 = bits.RotateLeft64(v13, 42) ^ v14
+	v8 += v11
+	v11 = bits.RotateLeft64(v11, 44) ^ v8
+	v10 += v9
+	v9 = bits.RotateLeft64(v9, 25) ^ v10
+
+	// round 24
+	v0 += v15
+	v15 = bits.RotateLeft64(v15, 9) ^ v0
+	v2 += v11
+	v11 = bits.RotateLeft64(v11, 48) ^ v2
+	v6 += v13
+	v13 = bits.RotateLeft64(v13, 35) ^ v6
+	v4 += v9
+	v9 = bits.RotateLeft64(v9, 52) ^ v4
+	v14 += v1
+	v1 = bits.RotateLeft64(v1, 23) ^ v14
+	v8 += v5
+	v5 = bits.RotateLeft64(v5, 31) ^ v8
+	v10 += v3
+	v3 = bits.RotateLeft64(v3, 37) ^ v10
+	v12 += v7
+	v7 = bits.RotateLeft64(v7, 20) ^ v12
+
+	// sub key 6
+	v0 += k6
+	v1 += k7
+	v2 += k8
+	v3 += k9
+	v4 += k10
+	v5 += k11
+	v6 += k12
+	v7 += k13
+	v8 += k14
+	v9 += k15
+	v10 += k16
+	v11 += k0
+	v12 += k1
+	v13 += k2 + t0
+	v14 += k3 + t1
+	v15 += k4 + 6
+
+	// round 25
+	v0 += v1
+	v1 = bits.RotateLeft64(v1, 24) ^ v0
+	v2 += v3
+	v3 = bits.RotateLeft64(v3, 13) ^ v2
+	v4 += v5
+	v5 = bits.RotateLeft64(v5, 8) ^ v4
+	v6 += v7
+	v7 = bits.RotateLeft64(v7, 47) ^ v6
+	v8 += v9
+	v9 = bits.RotateLeft64(v9, 8) ^ v8
+	v10 += v11
+	v11 = bits.RotateLeft64(v11, 17) ^ v10
+	v12 += v13
+	v13 = bits.RotateLeft64(v13, 22) ^ v12
+	v14 += v15
+	v15 = bits.RotateLeft64(v15, 37) ^ v14
+
+	// round 26
+	v0 += v9
+	v9 = bits.RotateLeft64(v9, 38) ^ v0
+	v2 += v13
+	v13 = bits.RotateLeft64(v13, 19) ^ v2
+	v6 += v11
+	v11 = bits.RotateLeft64(v11, 10) ^ v6
+	v4 += v15
+	v15 = bits.RotateLeft64(v15, 55) ^ v4
+	v10 += v7
+	v7 = bits.RotateLeft64(v7, 49) ^ v10
+	v12 += v3
+	v3 = bits.RotateLeft64(v3, 18) ^ v12
+	v14 += v5
+	v5 = bits.RotateLeft64(v5, 23) ^ v14
+	v8 += v1
+	v1 = bits.RotateLeft64(v1, 52) ^ v8
+
+	// round 27
+	v0 += v7
+	v7 = bits.RotateLeft64(v7, 33) ^ v0
+	v2 += v5
+	v5 = bits.RotateLeft64(v5, 4) ^ v2
+	v4 += v3
+	v3 = bits.RotateLeft64(v3, 51) ^ v4
+	v6 += v1
+	v1 = bits.RotateLeft64(v1, 13) ^ v6
+	v12 += v15
+	v15 = bits.RotateLeft64(v15, 34) ^ v12
+	v14 += v13
+	v13 = bits.RotateLeft64(v13, 41) ^ v14
+	v8 += v11
+	v11 = bits.RotateLeft64(v11, 59) ^ v8
+	v10 += v9
+	v9 = bits.RotateLeft64(v9, 17) ^ v10
+
+	// round 28
+	v0 += v15
+	v15 = bits.RotateLeft64(v15, 5) ^ v0
+	v2 += v11
+	v11 = bits.RotateLeft64(v11, 20) ^ v2
+	v6 += v13
+	v13 = bits.RotateLeft64(v13, 48) ^ v6
+	v4 += v9
+	v9 = bits.RotateLeft64(v9, 41) ^ v4
+	v14 += v1
+	v1 = bits.RotateLeft64(v1, 47) ^ v14
+	v8 += v5
+	v5 = bits.RotateLeft64(v5, 28) ^ v8
+	v10 += v3
+	v3 = bits.RotateLeft64(v3, 16) ^ v10
+	v12 += v7
+	v7 = bits.RotateLeft64(v7, 25) ^ v12
+
+	// sub key 7
+	v0 += k7
+	v1 += k8
+	v2 += k9
+	v3 += k10
+	v4 += k11
+	v5 += k12
+	v6 += k13
+	v7 += k14
+	v8 += k15
+	v9 += k16
+	v10 += k0
+	v11 += k1
+	v12 += k2
+	v13 += k3 + t1
+	v14 += k4 + t2
+	v15 += k5 + 7
+
+	// round 29
+	v0 += v1
+	v1 = bits.RotateLeft64(v1, 41) ^ v0
+	v2 += v3
+	v3 = bits.RotateLeft64(v3, 9) ^ v2
+	v4 += v5
+	v5 = bits.RotateLeft64(v5, 37) ^ v4
+	v6 += v7
+	v7 = bits.RotateLeft64(v7, 31) ^ v6
+	v8 += v9
+	v9 = bits.RotateLeft64(v9, 12) ^ v8
+	v10 += v11
+	v11 = bits.RotateLeft64(v11, 47) ^ v10
+	v12 += v13
+	v13 = bits.RotateLeft64(v13, 44) ^ v12
+	v14 += v15
+	v15 = bits.RotateLeft64(v15, 30) ^ v14
+
+	// round 30
+	v0 += v9
+	v9 = bits.RotateLeft64(v9, 16) ^ v0
+	v2 += v13
+	v13 = bits.RotateLeft64(v13, 34) ^ v2
+	v6 += v11
+	v11 = bits.RotateLeft64(v11, 56) ^ v6
+	v4 += v15
+	v15 = bits.RotateLeft64(v15, 51) ^ v4
+	v10 += v7
+	v7 = bits.RotateLeft64(v7, 4) ^ v10
+	v12 += v3
+	v3 = bits.RotateLeft64(v3, 53) ^ v12
+	v14 += v5
+	v5 = bits.RotateLeft64(v5, 42) ^ v14
+	v8 += v1
+	v1 = bits.RotateLeft64(v1, 41) ^ v8
+
+	// round 31
+	v0 += v7
+	v7 = bits.RotateLeft64(v7, 31) ^ v0
+	v2 += v5
+	v5 = bits.RotateLeft64(v5, 44) ^ v2
+	v4 += v3
+	v3 = bits.RotateLeft64(v3, 47) ^ v4
+	v6 += v1
+	v1 = bits.RotateLeft64(v1, 46) ^ v6
+	v12 += v15
+	v15 = bits.RotateLeft64(v15, 19) ^ v12
+	v14 += v13
+	v13 = bits.RotateLeft64(v13, 42) ^ v14
+	v8 += v11
+	v11 = bits.RotateLeft64(v11, 44) ^ v8
+	v10 += v9
+	v9 = bits.RotateLeft64(v9, 25) ^ v10
+
+	// round 32
+	v0 += v15
+	v15 = bits.RotateLeft64(v15, 9) ^ v0
+	v2 += v11
+	v11 = bits.RotateLeft64(v11, 48) ^ v2
+	v6 += v13
+	v13 = bits.RotateLeft64(v13, 35) ^ v6
+	v4 += v9
+	v9 = bits.RotateLeft64(v9, 52) ^ v4
+	v14 += v1
+	v1 = bits.RotateLeft64(v1, 23) ^ v14
+	v8 += v5
+	v5 = bits.RotateLeft64(v5, 31) ^ v8
+	v10 += v3
+	v3 = bits.RotateLeft64(v3, 37) ^ v10
+	v12 += v7
+	v7 = bits.RotateLeft64(v7, 20) ^ v12
+
+	// sub key 8
+	v0 += k8
+	v1 += k9
+	v2 += k10
+	v3 += k11
+	v4 += k12
+	v5 += k13
+	v6 += k14
+	v7 += k15
+	v8 += k16
+	v9 += k0
+	v10 += k1
+	v11 += k2
+	v12 += k3
+	v13 += k4 + t2
+	v14 += k5 + t0
+	v15 += k6 + 8
+
+	// round 33
+	v0 += v1
+	v1 = bits.RotateLeft64(v1, 24) ^ v0
+	v2 += v3
+	v3 = bits.RotateLeft64(v3, 13) ^ v2
+	v4 += v5
+	v5 = bits.RotateLeft64(v5, 8) ^ v4
+	v6 += v7
+	v7 = bits.RotateLeft64(v7, 47) ^ v6
+	v8 += v9
+	v9 = bits.RotateLeft64(v9, 8) ^ v8
+	v10 += v11
+	v11 = bits.RotateLeft64(v11, 17) ^ v10
+	v12 += v13
+	v13 = bits.RotateLeft64(v13, 22) ^ v12
+	v14 += v15
+	v15 = bits.RotateLeft64(v15, 37) ^ v14
+
+	// round 34
+	v0 += v9
+	v9 = bits.RotateLeft64(v9, 38) ^ v0
+	v2 += v13
+	v13 = bits.RotateLeft64(v13, 19) ^ v2
+	v6 += v11
+	v11 = bits.RotateLeft64(v11, 10) ^ v6
+	v4 += v15
+	v15 = bits.RotateLeft64(v15, 55) ^ v4
+	v10 += v7
+	v7 = bits.RotateLeft64(v7, 49) ^ v10
+	v12 += v3
+	v3 = bits.RotateLeft64(v3, 18) ^ v12
+	v14 += v5
+	v5 = bits.RotateLeft64(v5, 23) ^ v14
+	v8 += v1
+	v1 = bits.RotateLeft64(v1, 52) ^ v8
+
+	// round 35
+	v0 += v7
+	v7 = bits.RotateLeft64(v7, 33) ^ v0
+	v2 += v5
+	v5 = bits.RotateLeft64(v5, 4) ^ v2
+	v4 += v3
+	v3 = bits.RotateLeft64(v3, 51) ^ v4
+	v6 += v1
+	v1 = bits.RotateLeft64(v1, 13) ^ v6
+	v12 += v15
+	v15 = bits.RotateLeft64(v15, 34) ^ v12
+	v14 += v13
+	v13 = bits.RotateLeft64(v13, 41) ^ v14
+	v8 += v11
+	v11 = bits.RotateLeft64(v11, 59) ^ v8
+	v10 += v9
+	v9 = bits.RotateLeft64(v9, 17) ^ v10
+
+	// round 36
+	v0 += v15
+	v15 = bits.RotateLeft64(v15, 5) ^ v0
+	v2 += v11
+	v11 = bits.RotateLeft64(v11, 20) ^ v2
+	v6 += v13
+	v13 = bits.RotateLeft64(v13, 48) ^ v6
+	v4 += v9
+	v9 = bits.RotateLeft64(v9, 41) ^ v4
+	v14 += v1
+	v1 = bits.RotateLeft64(v1, 47) ^ v14
+	v8 += v5
+	v5 = bits.RotateLeft64(v5, 28) ^ v8
+	v10 += v3
+	v3 = bits.RotateLeft64(v3, 16) ^ v10
+	v12 += v7
+	v7 = bits.RotateLeft64(v7, 25) ^ v12
+
+	// sub key 9
+	v0 += k9
+	v1 += k10
+	v2 += k11
+	v3 += k12
+	v4 += k13
+	v5 += k14
+	v6 += k15
+	v7 += k16
+	v8 += k0
+	v9 += k1
+	v10 += k2
+	v11 += k3
+	v12 += k4
+	v13 += k5 + t0
+	v14 += k6 + t1
+	v15 += k7 + 9
+
+	// round 37
+	v0 += v1
+	v1 = bits.RotateLeft64(v1, 41) ^ v0
+	v2 += v3
+	v3 = bits.RotateLeft64(v3, 9) ^ v2
+	v4 += v5
+	v5 = bits.RotateLeft64(v5, 37) ^ v4
+	v6 += v7
+	v7 = bits.RotateLeft64(v7, 31) ^ v6
+	v8 += v9
+	v9 = bits.RotateLeft64(v9, 12) ^ v8
+	v10 += v11
+	v11 = bits.RotateLeft64(v11, 47) ^ v10
+	v12 += v13
+	v13 = bits.RotateLeft64(v13, 44) ^ v12
+	v14 += v15
+	v15 = bits.RotateLeft64(v15, 30) ^ v14
+
+	// round 38
+	v0 += v9
+	v9 = bits.RotateLeft64(v9, 16) ^ v0
+	v2 += v13
+	v13 = bits.RotateLeft64(v13, 34) ^ v2
+	v6 += v11
+	v11 = bits.RotateLeft64(v11, 56) ^ v6
+	v4 += v15
+	v15 = bits.RotateLeft64(v15, 51) ^ v4
+	v10 += v7
+	v7 = bits.RotateLeft64(v7, 4) ^ v10
+	v12 += v3
+	v3 = bits.RotateLeft64(v3, 53) ^ v12
+	v14 += v5
+	v5 = bits.RotateLeft64(v5, 42) ^ v14
+	v8 += v1
+	v1 = bits.RotateLeft64(v1, 41) ^ v8
+
+	// round 39
+	v0 += v7
+	v7 = bits.RotateLeft64(v7, 31) ^ v0
+	v2 += v5
+	v5 = bits.RotateLeft64(v5, 44) ^ v2
+	v4 += v3
+	v3 = bits.RotateLeft64(v3, 47) ^ v4
+	v6 += v1
+	v1 = bits.RotateLeft64(v1, 46) ^ v6
+	v12 += v15
+	v15 = bits.RotateLeft64(v15, 19) ^ v12
+	v14 += v13
+	v13 = bits.RotateLeft64(v13, 42) ^ v14
+	v8 += v11
+	v11 = bits.RotateLeft64(v11, 44) ^ v8
+	v10 += v9
+	v9 = bits.RotateLeft64(v9, 25) ^ v10
+
+	// round 40
+	v0 += v15
+	v15 = bits.RotateLeft64(v15, 9) ^ v0
+	v2 += v11
+	v11 = bits.RotateLeft64(v11, 48) ^ v2
+	v6 += v13
+	v13 = bits.RotateLeft64(v13, 35) ^ v6
+	v4 += v9
+	v9 = bits.RotateLeft64(v9, 52) ^ v4
+	v14 += v1
+	v1 = bits.RotateLeft64(v1, 23) ^ v14
+	v8 += v5
+	v5 = bits.RotateLeft64(v5, 31) ^ v8
+	v10 += v3
+	v3 = bits.RotateLeft64(v3, 37) ^ v10
+	v12 += v7
+	v7 = bits.RotateLeft64(v7, 20) ^ v12
+
+	// sub key 10
+	v0 += k10
+	v1 += k11
+	v2 += k12
+	v3 += k13
+	v4 += k14
+	v5 += k15
+	v6 += k16
+	v7 += k0
+	v8 += k1
+	v9 += k2
+	v10 += k3
+	v11 += k4
+	v12 += k5
+	v13 += k6 + t1
+	v14 += k7 + t2
+	v15 += k8 + 10
+
+	// round 41
+	v0 += v1
+	v1 = bits.RotateLeft64(v1, 24) ^ v0
+	v2 += v3
+	v3 = bits.RotateLeft64(v3, 13) ^ v2
+	v4 += v5
+	v5 = bits.RotateLeft64(v5, 8) ^ v4
+	v6 += v7
+	v7 = bits.RotateLeft64(v7, 47) ^ v6
+	v8 += v9
+	v9 = bits.RotateLeft64(v9, 8) ^ v8
+	v10 += v11
+	v11 = bits.RotateLeft64(v11, 17) ^ v10
+	v12 += v13
+	v13 = bits.RotateLeft64(v13, 22) ^ v12
+	v14 += v15
+	v15 = bits.RotateLeft64(v15, 37) ^ v14
+
+	// round 42
+	v0 += v9
+	v9 = bits.RotateLeft64(v9, 38) ^ v0
+	v2 += v13
+	v13 = bits.RotateLeft64(v13, 19) ^ v2
+	v6 += v11
+	v11 = bits.RotateLeft64(v11, 10) ^ v6
+	v4 += v15
+	v15 = bits.RotateLeft64(v15, 55) ^ v4
+	v10 += v7
+	v7 = bits.RotateLeft64(v7, 49) ^ v10
+	v12 += v3
+	v3 = bits.RotateLeft64(v3, 18) ^ v12
+	v14 += v5
+	v5 = bits.RotateLeft64(v5, 23) ^ v14
+	v8 += v1
+	v1 = bits.RotateLeft64(v1, 52) ^ v8
+
+	// round 43
+	v0 += v7
+	v7 = bits.RotateLeft64(v7, 33) ^ v0
+	v2 += v5
+	v5 = bits.RotateLeft64(v5, 4) ^ v2
+	v4 += v3
+	v3 = bits.RotateLeft64(v3, 51) ^ v4
+	v6 += v1
+	v1 = bits.RotateLeft64(v1, 13) ^ v6
+	v12 += v15
+	v15 = bits.RotateLeft64(v15, 34) ^ v12
+	v14 += v13
+	v13 = bits.RotateLeft64(v13, 41) ^ v14
+	v8 += v11
+	v11 = bits.RotateLeft64(v11, 59) ^ v8
+	v10 += v9
+	v9 = bits.RotateLeft64(v9, 17) ^ v10
+
+	// round 44
+	v0 += v15
+	v15 = bits.RotateLeft64(v15, 5) ^ v0
+	v2 += v11
+	v11 = bits.RotateLeft64(v11, 20) ^ v2
+	v6 += v13
+	v13 = bits.RotateLeft64(v13, 48) ^ v6
+	v4 += v9
+	v9 = bits.RotateLeft64(v9, 41) ^ v4
+	v14 += v1
+	v1 = bits.RotateLeft64(v1, 47) ^ v14
+	v8 += v5
+	v5 = bits.RotateLeft64(v5, 28) ^ v8
+	v10 += v3
+	v3 = bits.RotateLeft64(v3, 16) ^ v10
+	v12 += v7
+	v7 = bits.RotateLeft64(v7, 25) ^ v12
+
+	// sub key 11
+	v0 += k11
+	v1 += k12
+	v2 += k13
+	v3 += k14
+	v4 += k15
+	v5 += k16
+	v6 += k0
+	v7 += k1
+	v8 += k2
+	v9 += k3
+	v10 += k4
+	v11 += k5
+	v12 += k6
+	v13 += k7 + t2
+	v14 += k8 + t0
+	v15 += k9 + 11
+
+	// round 45
+	v0 += v1
+	v1 = bits.RotateLeft64(v1, 41) ^ v0
+	v2 += v3
+	v3 = bits.RotateLeft64(v3, 9) ^ v2
+	v4 += v5
+	v5 = bits.RotateLeft64(v5, 37) ^ v4
+	v6 += v7
+	v7 = bits.RotateLeft64(v7, 31) ^ v6
+	v8 += v9
+	v9 = bits.RotateLeft64(v9, 12) ^ v8
+	v10 += v11
+	v11 = bits.RotateLeft64(v11, 47) ^ v10
+	v12 += v13
+	v13 = bits.RotateLeft64(v13, 44) ^ v12
+	v14 += v15
+	v15 = bits.RotateLeft64(v15, 30) ^ v14
+
+	// round 46
+	v0 += v9
+	v9 = bits.RotateLeft64(v9, 16) ^ v0
+	v2 += v13
+	v13 = bits.RotateLeft64(v13, 34) ^ v2
+	v6 += v11
+	v11 = bits.RotateLeft64(v11, 56) ^ v6
+	v4 += v15
+	v15 = bits.RotateLeft64(v15, 51) ^ v4
+	v10 += v7
+	v7 = bits.RotateLeft64(v7, 4) ^ v10
+	v12 += v3
+	v3 = bits.RotateLeft64(v3, 53) ^ v12
+	v14 += v5
+	v5 = bits.RotateLeft64(v5, 42) ^ v14
+	v8 += v1
+	v1 = bits.RotateLeft64(v1, 41) ^ v8
+
+	// round 47
+	v0 += v7
+	v7 = bits.RotateLeft64(v7, 31) ^ v0
+	v2 += v5
+	v5 = bits.RotateLeft64(v5, 44) ^ v2
+	v4 += v3
+	v3 = bits.RotateLeft64(v3, 47) ^ v4
+	v6 += v1
+	v1 = bits.RotateLeft64(v1, 46) ^ v6
+	v12 += v15
+	v15 = bits.RotateLeft64(v15, 19) ^ v12
+	v14 += v13
+	v13 = bits.RotateLeft64(v13, 42) ^ v14
+	v8 += v11
+	v11 = bits.RotateLeft64(v11, 44) ^ v8
+	v10 += v9
+	v9 = bits.RotateLeft64(v9, 25) ^ v10
+
+	// round 48
+	v0 += v15
+	v15 = bits.RotateLeft64(v15, 9) ^ v0
+	v2 += v11
+	v11 = bits.RotateLeft64(v11, 48) ^ v2
+	v6 += v13
+	v13 = bits.RotateLeft64(v13, 35) ^ v6
+	v4 += v9
+	v9 = bits.RotateLeft64(v9, 52) ^ v4
+	v14 += v1
+	v1 = bits.RotateLeft64(v1, 23) ^ v14
+	v8 += v5
+	v5 = bits.RotateLeft64(v5, 31) ^ v8
+	v10 += v3
+	v3 = bits.RotateLeft64(v3, 37) ^ v10
+	v12 += v7
+	v7 = bits.RotateLeft64(v7, 20) ^ v12
+
+	// sub key 12
+	v0 += k12
+	v1 += k13
+	v2 += k14
+	v3 += k15
+	v4 += k16
+	v5 += k0
+	v6 += k1
+	v7 += k2
+	v8 += k3
+	v9 += k4
+	v10 += k5
+	v11 += k6
+	v12 += k7
+	v13 += k8 + t0
+	v14 += k9 + t1
+	v15 += k10 + 12
+
+	// round 49
+	v0 += v1
+	v1 = bits.RotateLeft64(v1, 24) ^ v0
+	v2 += v3
+	v3 = bits.RotateLeft64(v3, 13) ^ v2
+	v4 += v5
+	v5 = bits.RotateLeft64(v5, 8) ^ v4
+	v6 += v7
+	v7 = bits.RotateLeft64(v7, 47) ^ v6
+	v8 += v9
+	v9 = bits.RotateLeft64(v9, 8) ^ v8
+	v10 += v11
+	v11 = bits.RotateLeft64(v11, 17) ^ v10
+	v12 += v13
+	v13 = bits.RotateLeft64(v13, 22) ^ v12
+	v14 += v15
+	v15 = bits.RotateLeft64(v15, 37) ^ v14
+
+	// round 50
+	v0 += v9
+	v9 = bits.RotateLeft64(v9, 38) ^ v0
+	v2 += v13
+	v13 = bits.RotateLeft64(v13, 19) ^ v2
+	v6 += v11
+	v11 = bits.RotateLeft64(v11, 10) ^ v6
+	v4 += v15
+	v15 = bits.RotateLeft64(v15, 55) ^ v4
+	v10 += v7
+	v7 = bits.RotateLeft64(v7, 49) ^ v10
+	v12 += v3
+	v3 = bits.RotateLeft64(v3, 18) ^ v12
+	v14 += v5
+	v5 = bits.RotateLeft64(v5, 23) ^ v14
+	v8 += v1
+	v1 = bits.RotateLeft64(v1, 52) ^ v8
+
+	// round 51
+	v0 += v7
+	v7 = bits.RotateLeft64(v7, 33) ^ v0
+	v2 += v5
+	v5 = bits.RotateLeft64(v5, 4) ^ v2
+	v4 += v3
+	v3 = bits.RotateLeft64(v3, 51) ^ v4
+	v6 += v1
+	v1 = bits.RotateLeft64(v1, 13) ^ v6
+	v12 += v15
+	v15 = bits.RotateLeft64(v15, 34) ^ v12
+	v14 += v13
+	v13 = bits.RotateLeft64(v13, 41) ^ v14
+	v8 += v11
+	v11 = bits.RotateLeft64(v11, 59) ^ v8
+	v10 += v9
+	v9 = bits.RotateLeft64(v9, 17) ^ v10
+
+	// round 52
+	v0 += v15
+	v15 = bits.RotateLeft64(v15, 5) ^ v0
+	v2 += v11
+	v11 = bits.RotateLeft64(v11, 20) ^ v2
+	v6 += v13
+	v13 = bits.RotateLeft64(v13, 48) ^ v6
+	v4 += v9
+	v9 = bits.RotateLeft64(v9, 41) ^ v4
+	v14 += v1
+	v1 = bits.RotateLeft64(v1, 47) ^ v14
+	v8 += v5
+	v5 = bits.RotateLeft64(v5, 28) ^ v8
+	v10 += v3
+	v3 = bits.RotateLeft64(v3, 16) ^ v10
+	v12 += v7
+	v7 = bits.RotateLeft64(v7, 25) ^ v12
+
+	// sub key 13
+	v0 += k13
+	v1 += k14
+	v2 += k15
+	v3 += k16
+	v4 += k0
+	v5 += k1
+	v6 += k2
+	v7 += k3
+	v8 += k4
+	v9 += k5
+	v10 += k6
+	v11 += k7
+	v12 += k8
+	v13 += k9 + t1
+	v14 += k10 + t2
+	v15 += k11 + 13
+
+	// round 53
+	v0 += v1
+	v1 = bits.RotateLeft64(v1, 41) ^ v0
+	v2 += v3
+	v3 = bits.RotateLeft64(v3, 9) ^ v2
+	v4 += v5
+	v5 = bits.RotateLeft64(v5, 37) ^ v4
+	v6 += v7
+	v7 = bits.RotateLeft64(v7, 31) ^ v6
+	v8 += v9
+	v9 = bits.RotateLeft64(v9, 12) ^ v8
+	v10 += v11
+	v11 = bits.RotateLeft64(v11, 47) ^ v10
+	v12 += v13
+	v13 = bits.RotateLeft64(v13, 44) ^ v12
+	v14 += v15
+	v15 = bits.RotateLeft64(v15, 30) ^ v14
+
+	// round 54
+	v0 += v9
+	v9 = bits.RotateLeft64(v9, 16) ^ v0
+	v2 += v13
+	v13 = bits.RotateLeft64(v13, 34) ^ v2
+	v6 += v11
+	v11 = bits.RotateLeft64(v11, 56) ^ v6
+	v4 += v15
+	v15 = bits.RotateLeft64(v15, 51) ^ v4
+	v10 += v7
+	v7 = bits.RotateLeft64(v7, 4) ^ v10
+	v12 += v3
+	v3 = bits.RotateLeft64(v3, 53) ^ v12
+	v14 += v5
+	v5 = bits.RotateLeft64(v5, 42) ^ v14
+	v8 += v1
+	v1 = bits.RotateLeft64(v1, 41) ^ v8
+
+	// round 55
+	v0 += v7
+	v7 = bits.RotateLeft64(v7, 31) ^ v0
+	v2 += v5
+	v5 = bits.RotateLeft64(v5, 44) ^ v2
+	v4 += v3
+	v3 = bits.RotateLeft64(v3, 47) ^ v4
+	v6 += v1
+	v1 = bits.RotateLeft64(v1, 46) ^ v6
+	v12 += v15
+	v15 = bits.RotateLeft64(v15, 19) ^ v12
+	v14 += v13
+	v13 = bits.RotateLeft64(v13, 42) ^ v14
+	v8 += v11
+	v11 = bits.RotateLeft64(v11, 44) ^ v8
+	v10 += v9
+	v9 = bits.RotateLeft64(v9, 25) ^ v10
+
+	// round 56
+	v0 += v15
+	v15 = bits.RotateLeft64(v15, 9) ^ v0
+	v2 += v11
+	v11 = bits.RotateLeft64(v11, 48) ^ v2
+	v6 += v13
+	v13 = bits.RotateLeft64(v13, 35) ^ v6
+	v4 += v9
+	v9 = bits.RotateLeft64(v9, 52) ^ v4
+	v14 += v1
+	v1 = bits.RotateLeft64(v1, 23) ^ v14
+	v8 += v5
+	v5 = bits.RotateLeft64(v5, 31) ^ v8
+	v10 += v3
+	v3 = bits.RotateLeft64(v3, 37) ^ v10
+	v12 += v7
+	v7 = bits.RotateLeft64(v7, 20) ^ v12
+
+	// sub key 14
+	v0 += k14
+	v1 += k15
+	v2 += k16
+	v3 += k0
+	v4 += k1
+	v5 += k2
+	v6 += k3
+	v7 += k4
+	v8 += k5
+	v9 += k6
+	v10 += k7
+	v11 += k8
+	v12 += k9
+	v13 += k10 + t2
+	v14 += k11 + t0
+	v15 += k12 + 14
+
+	// round 57
+	v0 += v1
+	v1 = bits.RotateLeft64(v1, 24) ^ v0
+	v2 += v3
+	v3 = bits.RotateLeft64(v3, 13) ^ v2
+	v4 += v5
+	v5 = bits.RotateLeft64(v5, 8) ^ v4
+	v6 += v7
+	v7 = bits.RotateLeft64(v7, 47) ^ v6
+	v8 += v9
+	v9 = bits.RotateLeft64(v9, 8) ^ v8
+	v10 += v11
+	v11 = bits.RotateLeft64(v11, 17) ^ v10
+	v12 += v13
+	v13 = bits.RotateLeft64(v13, 22) ^ v12
+	v14 += v15
+	v15 = bits.RotateLeft64(v15, 37) ^ v14
+
+	// round 58
+	v0 += v9
+	v9 = bits.RotateLeft64(v9, 38) ^ v0
+	v2 += v13
+	v13 = bits.RotateLeft64(v13, 19) ^ v2
+	v6 += v11
+	v11 = bits.RotateLeft64(v11, 10) ^ v6
+	v4 += v15
+	v15 = bits.RotateLeft64(v15, 55) ^ v4
+	v10 += v7
+	v7 = bits.RotateLeft64(v7, 49) ^ v10
+	v12 += v3
+	v3 = bits.RotateLeft64(v3, 18) ^ v12
+	v14 += v5
+	v5 = bits.RotateLeft64(v5, 23) ^ v14
+	v8 += v1
+	v1 = bits.RotateLeft64(v1, 52) ^ v8
+
+	// round 59
+	v0 += v7
+	v7 = bits.RotateLeft64(v7, 33) ^ v0
+	v2 += v5
+	v5 = bits.RotateLeft64(v5, 4) ^ v2
+	v4 += v3
+	v3 = bits.RotateLeft64(v3, 51) ^ v4
+	v6 += v1
+	v1 = bits.RotateLeft64(v1, 13) ^ v6
+	v12 += v15
+	v15 = bits.RotateLeft64(v15, 34) ^ v12
+	v14 += v13
+	v13 = bits.RotateLeft64(v13, 41) ^ v14
+	v8 += v11
+	v11 = bits.RotateLeft64(v11, 59) ^ v8
+	v10 += v9
+	v9 = bits.RotateLeft64(v9, 17) ^ v10
+
+	// round 60
+	v0 += v15
+	v15 = bits.RotateLeft64(v15, 5) ^ v0
+	v2 += v11
+	v11 = bits.RotateLeft64(v11, 20) ^ v2
+	v6 += v13
+	v13 = bits.RotateLeft64(v13, 48) ^ v6
+	v4 += v9
+	v9 = bits.RotateLeft64(v9, 41) ^ v4
+	v14 += v1
+	v1 = bits.RotateLeft64(v1, 47) ^ v14
+	v8 += v5
+	v5 = bits.RotateLeft64(v5, 28) ^ v8
+	v10 += v3
+	v3 = bits.RotateLeft64(v3, 16) ^ v10
+	v12 += v7
+	v7 = bits.RotateLeft64(v7, 25) ^ v12
+
+	// sub key 15
+	v0 += k15
+	v1 += k16
+	v2 += k0
+	v3 += k1
+	v4 += k2
+	v5 += k3
+	v6 += k4
+	v7 += k5
+	v8 += k6
+	v9 += k7
+	v10 += k8
+	v11 += k9
+	v12 += k10
+	v13 += k11 + t0
+	v14 += k12 + t1
+	v15 += k13 + 15
+
+	// round 61
+	v0 += v1
+	v1 = bits.RotateLeft64(v1, 41) ^ v0
+	v2 += v3
+	v3 = bits.RotateLeft64(v3, 9) ^ v2
+	v4 += v5
+	v5 = bits.RotateLeft64(v5, 37) ^ v4
+	v6 += v7
+	v7 = bits.RotateLeft64(v7, 31) ^ v6
+	v8 += v9
+	v9 = bits.RotateLeft64(v9, 12) ^ v8
+	v10 += v11
+	v11 = bits.RotateLeft64(v11, 47) ^ v10
+	v12 += v13
+	v13 = bits.RotateLeft64(v13, 44) ^ v12
+	v14 += v15
+	v15 = bits.RotateLeft64(v15, 30) ^ v14
+
+	// round 62
+	v0 += v9
+	v9 = bits.RotateLeft64(v9, 16) ^ v0
+	v2 += v13
+	v13 = bits.RotateLeft64(v13, 34) ^ v2
+	v6 += v11
+	v11 = bits.RotateLeft64(v11, 56) ^ v6
+	v4 += v15
+	v15 = bits.RotateLeft64(v15, 51) ^ v4
+	v10 += v7
+	v7 = bits.RotateLeft64(v7, 4) ^ v10
+	v12 += v3
+	v3 = bits.RotateLeft64(v3, 53) ^ v12
+	v14 += v5
+	v5 = bits.RotateLeft64(v5, 42) ^ v14
+	v8 += v1
+	v1 = bits.RotateLeft64(v1, 41) ^ v8
+
+	// round 63
+	v0 += v7
+	v7 = bits.RotateLeft64(v7, 31) ^ v0
+	v2 += v5
+	v5 = bits.RotateLeft64(v5, 44) ^ v2
+	v4 += v3
+	v3 = bits.RotateLeft64(v3, 47) ^ v4
+	v6 += v1
+	v1 = bits.RotateLeft64(v1, 46) ^ v6
+	v12 += v15
+	v15 = bits.RotateLeft64(v15, 19) ^ v12
+	v14 += v13
+	v13 = bits.RotateLeft64(v13, 42) ^ v14
+	v8 += v11
+	v11 = bits.RotateLeft64(v11, 44) ^ v8
+	v10 += v9
+	v9 = bits.RotateLeft64(v9, 25) ^ v10
+
+	// round 64
+	v0 += v15
+	v15 = bits.RotateLeft64(v15, 9) ^ v0
+	v2 += v11
+	v11 = bits.RotateLeft64(v11, 48) ^ v2
+	v6 += v13
+	v13 = bits.RotateLeft64(v13, 35) ^ v6
+	v4 += v9
+	v9 = bits.RotateLeft64(v9, 52) ^ v4
+	v14 += v1
+	v1 = bits.RotateLeft64(v1, 23) ^ v14
+	v8 += v5
+	v5 = bits.RotateLeft64(v5, 31) ^ v8
+	v10 += v3
+	v3 = bits.RotateLeft64(v3, 37) ^ v10
+	v12 += v7
+	v7 = bits.RotateLeft64(v7, 20) ^ v12
+
+	// sub key 16
+	v0 += k16
+	v1 += k0
+	v2 += k1
+	v3 += k2
+	v4 += k3
+	v5 += k4
+	v6 += k5
+	v7 += k6
+	v8 += k7
+	v9 += k8
+	v10 += k9
+	v11 += k10
+	v12 += k11
+	v13 += k12 + t1
+	v14 += k13 + t2
+	v15 += k14 + 16
+
+	// round 65
+	v0 += v1
+	v1 = bits.RotateLeft64(v1, 24) ^ v0
+	v2 += v3
+	v3 = bits.RotateLeft64(v3, 13) ^ v2
+	v4 += v5
+	v5 = bits.RotateLeft64(v5, 8) ^ v4
+	v6 += v7
+	v7 = bits.RotateLeft64(v7, 47) ^ v6
+	v8 += v9
+	v9 = bits.RotateLeft64(v9, 8) ^ v8
+	v10 += v11
+	v11 = bits.RotateLeft64(v11, 17) ^ v10
+	v12 += v13
+	v13 = bits.RotateLeft64(v13, 22) ^ v12
+	v14 += v15
+	v15 = bits.RotateLeft64(v15, 37) ^ v14
+
+	// round 66
+	v0 += v9
+	v9 = bits.RotateLeft64(v9, 38) ^ v0
+	v2 += v13
+	v13 = bits.RotateLeft64(v13, 19) ^ v2
+	v6 += v11
+	v11 = bits.RotateLeft64(v11, 10) ^ v6
+	v4 += v15
+	v15 = bits.RotateLeft64(v15, 55) ^ v4
+	v10 += v7
+	v7 = bits.RotateLeft64(v7, 49) ^ v10
+	v12 += v3
+	v3 = bits.RotateLeft64(v3, 18) ^ v12
+	v14 += v5
+	v5 = bits.RotateLeft64(v5, 23) ^ v14
+	v8 += v1
+	v1 = bits.RotateLeft64(v1, 52) ^ v8
+
+	// round 67
+	v0 += v7
+	v7 = bits.RotateLeft64(v7, 33) ^ v0
+	v2 += v5
+	v5 = bits.RotateLeft64(v5, 4) ^ v2
+	v4 += v3
+	v3 = bits.RotateLeft64(v3, 51) ^ v4
+	v6 += v1
+	v1 = bits.RotateLeft64(v1, 13) ^ v6
+	v12 += v15
+	v15 = bits.RotateLeft64(v15, 34) ^ v12
+	v14 += v13
+	v13 = bits.RotateLeft64(v13, 41) ^ v14
+	v8 += v11
+	v11 = bits.RotateLeft64(v11, 59) ^ v8
+	v10 += v9
+	v9 = bits.RotateLeft64(v9, 17) ^ v10
+
+	// round 68
+	v0 += v15
+	v15 = bits.RotateLeft64(v15, 5) ^ v0
+	v2 += v11
+	v11 = bits.RotateLeft64(v11, 20) ^ v2
+	v6 += v13
+	v13 = bits.RotateLeft64(v13, 48) ^ v6
+	v4 += v9
+	v9 = bits.RotateLeft64(v9, 41) ^ v4
+	v14 += v1
+	v1 = bits.RotateLeft64(v1, 47) ^ v14
+	v8 += v5
+	v5 = bits.RotateLeft64(v5, 28) ^ v8
+	v10 += v3
+	v3 = bits.RotateLeft64(v3, 16) ^ v10
+	v12 += v7
+	v7 = bits.RotateLeft64(v7, 25) ^ v12
+
+	// sub key 17
+	v0 += k0
+	v1 += k1
+	v2 += k2
+	v3 += k3
+	v4 += k4
+	v5 += k5
+	v6 += k6
+	v7 += k7
+	v8 += k8
+	v9 += k9
+	v10 += k10
+	v11 += k11
+	v12 += k12
+	v13 += k13 + t2
+	v14 += k14 + t0
+	v15 += k15 + 17
+
+	// round 69
+	v0 += v1
+	v1 = bits.RotateLeft64(v1, 41) ^ v0
+	v2 += v3
+	v3 = bits.RotateLeft64(v3, 9) ^ v2
+	v4 += v5
+	v5 = bits.RotateLeft64(v5, 37) ^ v4
+	v6 += v7
+	v7 = bits.RotateLeft64(v7, 31) ^ v6
+	v8 += v9
+	v9 = bits.RotateLeft64(v9, 12) ^ v8
+	v10 += v11
+	v11 = bits.RotateLeft64(v11, 47) ^ v10
+	v12 += v13
+	v13 = bits.RotateLeft64(v13, 44) ^ v12
+	v14 += v15
+	v15 = bits.RotateLeft64(v15, 30) ^ v14
+
+	// round 70
+	v0 += v9
+	v9 = bits.RotateLeft64(v9, 16) ^ v0
+	v2 += v13
+	v13 = bits.RotateLeft64(v13, 34) ^ v2
+	v6 += v11
+	v11 = bits.RotateLeft64(v11, 56) ^ v6
+	v4 += v15
+	v15 = bits.RotateLeft64(v15, 51) ^ v4
+	v10 += v7
+	v7 = bits.RotateLeft64(v7, 4) ^ v10
+	v12 += v3
+	v3 = bits.RotateLeft64(v3, 53) ^ v12
+	v14 += v5
+	v5 = bits.RotateLeft64(v5, 42) ^ v14
+	v8 += v1
+	v1 = bits.RotateLeft64(v1, 41) ^ v8
+
+	// round 71
+	v0 += v7
+	v7 = bits.RotateLeft64(v7, 31) ^ v0
+	v2 += v5
+	v5 = bits.RotateLeft64(v5, 44) ^ v2
+	v4 += v3
+	v3 = bits.RotateLeft64(v3, 47) ^ v4
+	v6 += v1
+	v1 = bits.RotateLeft64(v1, 46) ^ v6
+	v12 += v15
+	v15 = bits.RotateLeft64(v15, 19) ^ v12
+	v14 += v13
+	v13 = bits.RotateLeft64(v13, 42) ^ v14
+	v8 += v11
+	v11 = bits.RotateLeft64(v11, 44) ^ v8
+	v10 += v9
+	v9 = bits.RotateLeft64(v9, 25) ^ v10
+
+	// round 72
+	v0 += v15
+	v15 = bits.RotateLeft64(v15, 9) ^ v0
+	v2 += v11
+	v11 = bits.RotateLeft64(v11, 48) ^ v2
+	v6 += v13
+	v13 = bits.RotateLeft64(v13, 35) ^ v6
+	v4 += v9
+	v9 = bits.RotateLeft64(v9, 52) ^ v4
+	v14 += v1
+	v1 = bits.RotateLeft64(v1, 23) ^ v14
+	v8 += v5
+	v5 = bits.RotateLeft64(v5, 31) ^ v8
+	v10 += v3
+	v3 = bits.RotateLeft64(v3, 37) ^ v10
+	v12 += v7
+	v7 = bits.RotateLeft64(v7, 20) ^ v12
+
+	// sub key 18
+	v0 += k1
+	v1 += k2
+	v2 += k3
+	v3 += k4
+	v4 += k5
+	v5 += k6
+	v6 += k7
+	v7 += k8
+	v8 += k9
+	v9 += k10
+	v10 += k11
+	v11 += k12
+	v12 += k13
+	v13 += k14 + t0
+	v14 += k15 + t1
+	v15 += k16 + 18
+
+	// round 73
+	v0 += v1
+	v1 = bits.RotateLeft64(v1, 24) ^ v0
+	v2 += v3
+	v3 = bits.RotateLeft64(v3, 13) ^ v2
+	v4 += v5
+	v5 = bits.RotateLeft64(v5, 8) ^ v4
+	v6 += v7
+	v7 = bits.RotateLeft64(v7, 47) ^ v6
+	v8 += v9
+	v9 = bits.RotateLeft64(v9, 8) ^ v8
+	v10 += v11
+	v11 = bits.RotateLeft64(v11, 17) ^ v10
+	v12 += v13
+	v13 = bits.RotateLeft64(v13, 22) ^ v12
+	v14 += v15
+	v15 = bits.RotateLeft64(v15, 37) ^ v14
+
+	// round 74
+	v0 += v9
+	v9 = bits.RotateLeft64(v9, 38) ^ v0
+	v2 += v13
+	v13 = bits.RotateLeft64(v13, 19) ^ v2
+	v6 += v11
+	v11 = bits.RotateLeft64(v11, 10) ^ v6
+	v4 += v15
+	v15 = bits.RotateLeft64(v15, 55) ^ v4
+	v10 += v7
+	v7 = bits.RotateLeft64(v7, 49) ^ v10
+	v12 += v3
+	v3 = bits.RotateLeft64(v3, 18) ^ v12
+	v14 += v5
+	v5 = bits.RotateLeft64(v5, 23) ^ v14
+	v8 += v1
+	v1 = bits.RotateLeft64(v1, 52) ^ v8
+
+	// round 75
+	v0 += v7
+	v7 = bits.RotateLeft64(v7, 33) ^ v0
+	v2 += v5
+	v5 = bits.RotateLeft64(v5, 4) ^ v2
+	v4 += v3
+	v3 = bits.RotateLeft64(v3, 51) ^ v4
+	v6 += v1
+	v1 = bits.RotateLeft64(v1, 13) ^ v6
+	v12 += v15
+	v15 = bits.RotateLeft64(v15, 34) ^ v12
+	v14 += v13
+	v13 = bits.RotateLeft64(v13, 41) ^ v14
+	v8 += v11
+	v11 = bits.RotateLeft64(v11, 59) ^ v8
+	v10 += v9
+	v9 = bits.RotateLeft64(v9, 17) ^ v10
+
+	// round 76
+	v0 += v15
+	v15 = bits.RotateLeft64(v15, 5) ^ v0
+	v2 += v11
+	v11 = bits.RotateLeft64(v11, 20) ^ v2
+	v6 += v13
+	v13 = bits.RotateLeft64(v13, 48) ^ v6
+	v4 += v9
+	v9 = bits.RotateLeft64(v9, 41) ^ v4
+	v14 += v1
+	v1 = bits.RotateLeft64(v1, 47) ^ v14
+	v8 += v5
+	v5 = bits.RotateLeft64(v5, 28) ^ v8
+	v10 += v3
+	v3 = bits.RotateLeft64(v3, 16) ^ v10
+	v12 += v7
+	v7 = bits.RotateLeft64(v7, 25) ^ v12
+
+	// sub key 19
+	v0 += k2
+	v1 += k3
+	v2 += k4
+	v3 += k5
+	v4 += k6
+	v5 += k7
+	v6 += k8
+	v7 += k9
+	v8 += k10
+	v9 += k11
+	v10 += k12
+	v11 += k13
+	v12 += k14
+	v13 += k15 + t1
+	v14 += k16 + t2
+	v15 += k0 + 19
+
+	// round 77
+	v0 += v1
+	v1 = bits.RotateLeft64(v1, 41) ^ v0
+	v2 += v3
+	v3 = bits.RotateLeft64(v3, 9) ^ v2
+	v4 += v5
+	v5 = bits.RotateLeft64(v5, 37) ^ v4
+	v6 += v7
+	v7 = bits.RotateLeft64(v7, 31) ^ v6
+	v8 += v9
+	v9 = bits.RotateLeft64(v9, 12) ^ v8
+	v10 += v11
+	v11 = bits.RotateLeft64(v11, 47) ^ v10
+	v12 += v13
+	v13 = bits.RotateLeft64(v13, 44) ^ v12
+	v14 += v15
+	v15 = bits.RotateLeft64(v15, 30) ^ v14
+
+	// round 78
+	v0 += v9
+	v9 = bits.RotateLeft64(v9, 16) ^ v0
+	v2 += v13
+	v13 = bits.RotateLeft64(v13, 34) ^ v2
+	v6 += v11
+	v11 = bits.RotateLeft64(v11, 56) ^ v6
+	v4 += v15
+	v15 = bits.RotateLeft64(v15, 51) ^ v4
+	v10 += v7
+	v7 = bits.RotateLeft64(v7, 4) ^ v10
+	v12 += v3
+	v3 = bits.RotateLeft64(v3, 53) ^ v12
+	v14 += v5
+	v5 = bits.RotateLeft64(v5, 42) ^ v14
+	v8 += v1
+	v1 = bits.RotateLeft64(v1, 41) ^ v8
+
+	// round 79
+	v0 += v7
+	v7 = bits.RotateLeft64(v7, 31) ^ v0
+	v2 += v5
+	v5 = bits.RotateLeft64(v5, 44) ^ v2
+	v4 += v3
+	v3 = bits.RotateLeft64(v3, 47) ^ v4
+	v6 += v1
+	v1 = bits.RotateLeft64(v1, 46) ^ v6
+	v12 += v15
+	v15 = bits.RotateLeft64(v15, 19) ^ v12
+	v14 += v13
+	v13 = bits.RotateLeft64(v13, 42) ^ v14
+	v8 += v11
+	v11 = bits.RotateLeft64(v11, 44) ^ v8
+	v10 += v9
+	v9 = bits.RotateLeft64(v9, 25) ^ v10
+
+	// round 80
+	v0 += v15
+	v15 = bits.RotateLeft64(v15, 9) ^ v0
+	v2 += v11
+	v11 = bits.RotateLeft64(v11, 48) ^ v2
+	v6 += v13
+	v13 = bits.RotateLeft64(v13, 35) ^ v6
+	v4 += v9
+	v9 = bits.RotateLeft64(v9, 52) ^ v4
+	v14 += v1
+	v1 = bits.RotateLeft64(v1, 23) ^ v14
+	v8 += v5
+	v5 = bits.RotateLeft64(v5, 31) ^ v8
+	v10 += v3
+	v3 = bits.RotateLeft64(v3, 37) ^ v10
+	v12 += v7
+	v7 = bits.RotateLeft64(v7, 20) ^ v12
+
+	// sub key 20
+	v0 += k3
+	v1 += k4
+	v2 += k5
+	v3 += k6
+	v4 += k7
+	v5 += k8
+	v6 += k9
+	v7 += k10
+	v8 += k11
+	v9 += k12
+	v10 += k13
+	v11 += k14
+	v12 += k15
+	v13 += k16 + t2
+	v14 += k0 + t0
+	v15 += k1 + 20
+
+	block[0] = v0
+	block[1] = v1
+	block[2] = v2
+	block[3] = v3
+	block[4] = v4
+	block[5] = v5
+	block[6] = v6
+	block[7] = v7
+	block[8] = v8
+	block[9] = v9
+	block[10] = v10
+	block[11] = v11
+	block[12] = v12
+	block[13] = v13
+	block[14] = v14
+	block[15] = v15
+}
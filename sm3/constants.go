@@ -0,0 +1,15 @@
+package sm3
+
+const (
+	// Size is the size of an SM3 checksum in bytes.
+	Size = 32
+	// BlockSize the block size of SM3 in bytes.
+	BlockSize = 64
+
+	// t0 and t1 are the round constants of the first 16 and the last 48 rounds.
+	t0 = 0x79cc4519
+	t1 = 0x7a879d8a
+)
+
+// initial is the initial state of SM3.
+var initial = [8]uint32{0x7380166f, 0x4914b2b9, 0x172442d7, 0xda8a0600, 0xa96f30bc, 0x163138aa, 0xe38dee4d, 0xb0fb0e4e}
@@ -0,0 +1,12 @@
+// Package sm3 implements the SM3 hash algorithm as defined in GB/T 32905-2016.
+package sm3
+
+import "hash"
+
+// New creates a new SM3 hash.Hash.
+func New() hash.Hash {
+	h := new(model)
+	h.Reset()
+
+	return h
+}
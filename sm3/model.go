@@ -0,0 +1,141 @@
+package sm3
+
+import (
+	"encoding/binary"
+	"math/bits"
+)
+
+// model represents a structure for the SM3 hash.Hash.
+type model struct {
+	state          [8]uint32
+	buffer         [BlockSize]byte
+	bufferIndex    int
+	processedBytes uint64
+}
+
+// implementation of the hash.Hash
+
+// Reset resets the hash.Hash to its initial state.
+func (r *model) Reset() {
+	r.state = initial
+	r.bufferIndex = 0
+	r.processedBytes = 0
+}
+
+// Size returns the number of bytes Sum will return.
+func (r *model) Size() int { return Size }
+
+// BlockSize returns the hash's underlying block size.
+// The Write method must be able to accept any amount of data,
+// but it may operate more efficiently if all writes are a multiple of the block size.
+func (r *model) BlockSize() int { return BlockSize }
+
+// Write appends the data to the digest.
+func (r *model) Write(p []byte) (int, error) {
+	n := len(p)
+	r.processedBytes += uint64(n)
+
+	if r.bufferIndex > 0 {
+		x := copy(r.buffer[r.bufferIndex:], p)
+		r.bufferIndex += x
+		p = p[x:]
+
+		if r.bufferIndex < BlockSize {
+			return n, nil
+		}
+
+		compress(&r.state, r.buffer[:])
+		r.bufferIndex = 0
+	}
+
+	for ; len(p) >= BlockSize; p = p[BlockSize:] {
+		compress(&r.state, p)
+	}
+
+	r.bufferIndex = copy(r.buffer[:], p)
+
+	return n, nil
+}
+
+// Sum appends the current hash to b and returns the resulting slice.
+// It does not change the underlying hash state.
+func (r *model) Sum(b []byte) []byte {
+	// copy the model to allow other writes to continue and to prevent change of the state
+	s := *r
+
+	var padding [2 * BlockSize]byte
+	padding[0] = 0x80
+
+	n := BlockSize - 8 - int(s.processedBytes%BlockSize)
+	if n < 1 {
+		n += BlockSize
+	}
+
+	binary.BigEndian.PutUint64(padding[n:], s.processedBytes<<3)
+	_, _ = s.Write(padding[:n+8])
+
+	var digest [Size]byte
+	for i, v := range s.state {
+		binary.BigEndian.PutUint32(digest[4*i:], v)
+	}
+
+	return append(b, digest[:]...)
+}
+
+// private
+
+// p0 is the permutation of the compression function.
+func p0(x uint32) uint32 { return x ^ bits.RotateLeft32(x, 9) ^ bits.RotateLeft32(x, 17) }
+
+// p1 is the permutation of the message expansion.
+func p1(x uint32) uint32 { return x ^ bits.RotateLeft32(x, 15) ^ bits.RotateLeft32(x, 23) }
+
+// compress compresses a block into the state.
+func compress(state *[8]uint32, block []byte) {
+	var w [68]uint32
+	for i := 0; i < 16; i++ {
+		w[i] = binary.BigEndian.Uint32(block[4*i:])
+	}
+	for i := 16; i < 68; i++ {
+		w[i] = p1(w[i-16]^w[i-9]^bits.RotateLeft32(w[i-3], 15)) ^ bits.RotateLeft32(w[i-13], 7) ^ w[i-6]
+	}
+
+	a, b, c, d, e, f, g, h := state[0], state[1], state[2], state[3], state[4], state[5], state[6], state[7]
+
+	for i := 0; i < 64; i++ {
+		var t, ff, gg uint32
+		if i < 16 {
+			t = t0
+			ff = a ^ b ^ c
+			gg = e ^ f ^ g
+		} else {
+			t = t1
+			ff = a&b | a&c | b&c
+			gg = e&f | ^e&g
+		}
+
+		a12 := bits.RotateLeft32(a, 12)
+		ss1 := bits.RotateLeft32(a12+e+bits.RotateLeft32(t, i%32), 7)
+		ss2 := ss1 ^ a12
+		tt1 := ff + d + ss2 + (w[i] ^ w[i+4])
+		tt2 := gg + h + ss1 + w[i]
+
+		d = c
+		c = bits.RotateLeft32(b, 9)
+		b = a
+		a = tt1
+		h = g
+		g = bits.RotateLeft32(f, 19)
+		f = e
+		e = p0(tt2)
+	}
+
+	state[0] ^= a
+	state[1] ^= b
+	state[2] ^= c
+	state[3] ^= d
+	state[4] ^= e
+	state[5] ^= f
+	state[6] ^= g
+	state[7] ^= h
+}
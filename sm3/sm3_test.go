@@ -0,0 +1,39 @@
+package sm3
+
+import (
+	"encoding/hex"
+	"strings"
+	"testing"
+)
+
+func TestStandardExamples(t *testing.T) {
+	// the examples of the appendix A of GB/T 32905-2016
+	cases := []struct {
+		input    string
+		expected string
+	}{
+		{"abc", "66c7f0f462eeedd9d1f2d46bdc10e4e24167c4875cf2f7a2297da02b8f4ba8e0"},
+		{strings.Repeat("abcd", 16), "debe9ff92275b8a138604889c18e5a4d6fdb70e5387e5765293dcba39c0c5732"},
+	}
+
+	for _, c := range cases {
+		h := New()
+		h.Write([]byte(c.input))
+
+		if got := hex.EncodeToString(h.Sum(nil)); got != c.expected {
+			t.Errorf("sm3 of \"%s\" is wrong:\n\texpected \"%s\"\n\tgot \"%s\"", c.input, c.expected, got)
+		}
+	}
+}
+
+func BenchmarkSm3(b *testing.B) {
+	h := New()
+	data := make([]byte, 8192)
+
+	b.SetBytes(int64(len(data)))
+	for i := 0; i < b.N; i++ {
+		h.Reset()
+		h.Write(data)
+		h.Sum(nil)
+	}
+}
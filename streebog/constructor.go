@@ -0,0 +1,23 @@
+// Package streebog implements the Streebog hash algorithm as defined in GOST R 34.11-2012 and RFC 6986.
+//
+// The vectors of 512 bits are kept as little-endian words, so the bytes of the messages are
+// processed in their order and the checksum is the state written in little-endian.
+package streebog
+
+import "hash"
+
+//go:generate go run gen.go
+
+// New256 creates a new Streebog-256 hash.Hash.
+func New256() hash.Hash { return newModel(Size256) }
+
+// New512 creates a new Streebog-512 hash.Hash.
+func New512() hash.Hash { return newModel(Size512) }
+
+// newModel creates a new Streebog model of the size.
+func newModel(size int) *model {
+	h := &model{size: size}
+	h.Reset()
+
+	return h
+}
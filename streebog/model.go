@@ -0,0 +1,167 @@
+package streebog
+
+import (
+	"encoding/binary"
+	"math/bits"
+)
+
+// model represents a structure for the Streebog hash.Hash.
+type model struct {
+	size        int
+	state       [8]uint64
+	length      [8]uint64 // the number of processed bits, N in the standard
+	sum         [8]uint64 // the sum of the processed blocks, Σ in the standard
+	buffer      [BlockSize]byte
+	bufferIndex int
+}
+
+// implementation of the hash.Hash
+
+// Reset resets the hash.Hash to its initial state.
+func (r *model) Reset() {
+	// the initial vector is the zero vector for 512 bits and the vector of 0x01 bytes for 256 bits
+	var iv uint64
+	if r.size == Size256 {
+		iv = 0x0101010101010101
+	}
+
+	r.state = [8]uint64{iv, iv, iv, iv, iv, iv, iv, iv}
+	r.length = [8]uint64{}
+	r.sum = [8]uint64{}
+	r.bufferIndex = 0
+}
+
+// Size returns the number of bytes Sum will return.
+func (r *model) Size() int { return r.size }
+
+// BlockSize returns the hash's underlying block size.
+// The Write method must be able to accept any amount of data,
+// but it may operate more efficiently if all writes are a multiple of the block size.
+func (r *model) BlockSize() int { return BlockSize }
+
+// Write appends the data to the digest.
+func (r *model) Write(p []byte) (int, error) {
+	n := len(p)
+
+	if r.bufferIndex > 0 {
+		x := copy(r.buffer[r.bufferIndex:], p)
+		r.bufferIndex += x
+		p = p[x:]
+
+		if r.bufferIndex < BlockSize {
+			return n, nil
+		}
+
+		r.block(r.buffer[:])
+		r.bufferIndex = 0
+	}
+
+	for ; len(p) >= BlockSize; p = p[BlockSize:] {
+		r.block(p)
+	}
+
+	r.bufferIndex = copy(r.buffer[:], p)
+
+	return n, nil
+}
+
+// Sum appends the current hash to b and returns the resulting slice.
+// It does not change the underlying hash state.
+func (r *model) Sum(b []byte) []byte {
+	// copy the model to allow other writes to continue and to prevent change of the state
+	s := *r
+
+	// the remaining bytes are padded by a single one bit and zeros
+	var m [8]uint64
+	padded := s.buffer
+	padded[s.bufferIndex] = 0x01
+	for i := s.bufferIndex + 1; i < BlockSize; i++ {
+		padded[i] = 0
+	}
+	words(&m, padded[:])
+
+	compress(&s.state, &s.length, &m)
+	add(&s.length, &[8]uint64{uint64(s.bufferIndex) << 3})
+	add(&s.sum, &m)
+
+	var zero [8]uint64
+	compress(&s.state, &zero, &s.length)
+	compress(&s.state, &zero, &s.sum)
+
+	var digest [Size512]byte
+	for i, v := range s.state {
+		binary.LittleEndian.PutUint64(digest[8*i:], v)
+	}
+
+	// the 256 bits checksum is the most significant half of the state
+	return append(b, digest[Size512-s.size:]...)
+}
+
+// private
+
+// block processes a full block of the message.
+func (r *model) block(p []byte) {
+	var m [8]uint64
+	words(&m, p)
+
+	compress(&r.state, &r.length, &m)
+	add(&r.length, &[8]uint64{BlockSize << 3})
+	add(&r.sum, &m)
+}
+
+// words reads the block as little-endian words.
+func words(m *[8]uint64, p []byte) {
+	for i := range m {
+		m[i] = binary.LittleEndian.Uint64(p[8*i:])
+	}
+}
+
+// add adds y to x modulo 2^512.
+func add(x, y *[8]uint64) {
+	var carry uint64
+	for i := range x {
+		x[i], carry = bits.Add64(x[i], y[i], carry)
+	}
+}
+
+// lps applies the S, P and L transformations to x.
+func lps(x *[8]uint64) {
+	var v [8]uint64
+	for i := range v {
+		shift := 8 * i
+		v[i] = tables[0][byte(x[0]>>shift)] ^ tables[1][byte(x[1]>>shift)] ^
+			tables[2][byte(x[2]>>shift)] ^ tables[3][byte(x[3]>>shift)] ^
+			tables[4][byte(x[4]>>shift)] ^ tables[5][byte(x[5]>>shift)] ^
+			tables[6][byte(x[6]>>shift)] ^ tables[7][byte(x[7]>>shift)]
+	}
+
+	*x = v
+}
+
+// xor sets x to x ^ y.
+func xor(x, y *[8]uint64) {
+	for i := range x {
+		x[i] ^= y[i]
+	}
+}
+
+// compress is the compression function g, it mixes the block m into the state with the length n.
+func compress(state, n, m *[8]uint64) {
+	// the key of the E block cipher
+	k := *state
+	xor(&k, n)
+	lps(&k)
+
+	v := *m
+	for i := 0; i < rounds; i++ {
+		xor(&v, &k)
+		lps(&v)
+
+		xor(&k, &iterationConstants[i])
+		lps(&k)
+	}
+
+	xor(&v, &k)
+	xor(state, &v)
+	xor(state, m)
+}
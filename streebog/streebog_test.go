@@ -0,0 +1,59 @@
+package streebog
+
+import (
+	"bytes"
+	"encoding/hex"
+	"hash"
+	"testing"
+)
+
+// reversed decodes a vector written with the most significant byte first, as in the standard.
+func reversed(s string) []byte {
+	v, _ := hex.DecodeString(s)
+	for i, j := 0, len(v)-1; i < j; i, j = i+1, j-1 {
+		v[i], v[j] = v[j], v[i]
+	}
+
+	return v
+}
+
+func TestStandardExamples(t *testing.T) {
+	// the messages M1 and M2 of the appendix A of GOST R 34.11-2012
+	m1 := reversed("323130393837363534333231303938373635343332313039383736353433323130393837363534333231303938373635343332313039383736353433323130")
+	m2 := reversed("fbe2e5f0eee3c820fbeafaebef20fffbf0e1e0f0f520e0ed20e8ece0ebe5f0f2f120fff0eeec20f120faf2fee5e2202ce8f6f3ede220e8e6eee1e8f0f2d1202ce8f0f2e5e220e5d1")
+
+	cases := []struct {
+		newHash  func() hash.Hash
+		input    []byte
+		expected []byte
+	}{
+		{New512, m1, reversed("486f64c1917879417fef082b3381a4e211c324f074654c38823a7b76f830ad00fa1fbae42b1285c0352f227524bc9ab16254288dd6863dccd5b9f54a1ad0541b")},
+		{New256, m1, reversed("00557be5e584fd52a449b16b0251d05d27f94ab76cbaa6da890b59d8ef1e159d")},
+		{New512, m2, reversed("28fbc9bada033b1460642bdcddb90c3fb3e56c497ccd0f62b8a2ad4935e85f037613966de4ee00531ae60f3b5a47f8dae06915d5f2f194996fcabf2622e6881e")},
+		{New256, m2, reversed("508f7e553c06501d749a66fc28c6cac0b005746d97537fa85d9e40904efed29d")},
+	}
+
+	for _, c := range cases {
+		h := c.newHash()
+		h.Write(c.input)
+
+		if got := h.Sum(nil); !bytes.Equal(got, c.expected) {
+			t.Errorf("sum of size %d is wrong:\n\texpected \"%x\"\n\tgot \"%x\"", h.Size(), c.expected, got)
+		}
+	}
+}
+
+func benchmarkStreebog(b *testing.B, h hash.Hash) {
+	data := make([]byte, 8192)
+
+	b.SetBytes(int64(len(data)))
+	for i := 0; i < b.N; i++ {
+		h.Reset()
+		h.Write(data)
+		h.Sum(nil)
+	}
+}
+
+func BenchmarkStreebog256(b *testing.B) { benchmarkStreebog(b, New256()) }
+
+func BenchmarkStreebog512(b *testing.B) { benchmarkStreebog(b, New512()) }
@@ -0,0 +1,335 @@
+//go:build ignore
+
+// This program generates KeccakP200.rsp, KeccakP400.rsp and KeccakP800.rsp, run it with "go run gen.go" in this
+// directory.
+//
+// The sponge is a literal transcription of FIPS 202 on bit strings, independent of the keccak package: the state
+// array and the step mappings of section 3.1 and 3.2, the round constants of Algorithm 5, KECCAK-p[b, nr] of
+// Algorithm 7, SPONGE of Algorithm 8 and pad10*1 of Algorithm 9, with the conversion of bytes to bit strings of
+// section B.1. The message is padded without the SHA-3 suffix, as KECCAK[c] of section 5.2 and the original
+// submission. Before writing the files, the program checks itself against the official records of KeccakP1600.rsp.
+package main
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"math/rand"
+	"os"
+)
+
+// section represents the sponge parameters of a section of a response file, all in bits.
+type section struct {
+	width, capacity, rounds, length int
+}
+
+// files are the response files and their sections.
+var files = []struct {
+	name     string
+	sections []section
+}{
+	{"KeccakP200.rsp", []section{{200, 64, 18, 128}, {200, 32, 18, 128}}},
+	{"KeccakP400.rsp", []section{{400, 128, 20, 256}, {400, 256, 12, 256}}},
+	{"KeccakP800.rsp", []section{{800, 256, 22, 256}, {800, 512, 22, 512}, {800, 256, 12, 256}}},
+}
+
+// lengths are the message lengths in bits of every section, around the rates of the widths.
+var lengths = []int{0, 8, 16, 24, 56, 64, 120, 128, 136, 264, 272, 280, 536, 544, 552, 800, 1088, 1600, 2040}
+
+// official are the records of KeccakP1600.rsp, of the Keccak team ShortMsgKAT_256 and ShortMsgKAT_512 files.
+var official = []struct {
+	capacity, length int
+	msg, md          string
+}{
+	{512, 256, "", "c5d2460186f7233c927e7db2dcc703c0e500b653ca82273b7bfad8045d85a470"},
+	{512, 256, "cc", "eead6dbfc7340a56caedc044696a168870549a6a7f6f56961e84a54bd9970b8a"},
+	{1024, 512, "", "0eab42de4c3ceb9235fc91acffe746b29c29a8c366b7c60e4e67c466f36a4304c00fa9caf9d87976ba469bcbe06713b435f091ef2769fb160cdab33d3670680e"},
+	{1024, 512, "cc", "8630c13cbd066ea74bbe7fe468fec1dee10edc1254fb4c1b7c5fd69b646e44160b8ce01d05a0908ca790dfb080f4b513bc3b6225ece7a810371441a5ac666eb9"},
+}
+
+// state is the state array A[x, y, z] of section 3.1.1.
+type state [5][5][]byte
+
+// newState converts the string S of b bits to a state array, section 3.1.2.
+func newState(s []byte) state {
+	w := len(s) / 25
+
+	var a state
+	for x := 0; x < 5; x++ {
+		for y := 0; y < 5; y++ {
+			a[x][y] = make([]byte, w)
+			for z := 0; z < w; z++ {
+				a[x][y][z] = s[w*(5*y+x)+z]
+			}
+		}
+	}
+
+	return a
+}
+
+// bits converts the state array to a string of b bits, section 3.1.3.
+func (a state) bits() []byte {
+	w := len(a[0][0])
+
+	s := make([]byte, 25*w)
+	for x := 0; x < 5; x++ {
+		for y := 0; y < 5; y++ {
+			for z := 0; z < w; z++ {
+				s[w*(5*y+x)+z] = a[x][y][z]
+			}
+		}
+	}
+
+	return s
+}
+
+// clone returns a copy of the state array.
+func (a state) clone() state {
+	var c state
+	for x := 0; x < 5; x++ {
+		for y := 0; y < 5; y++ {
+			c[x][y] = append([]byte(nil), a[x][y]...)
+		}
+	}
+
+	return c
+}
+
+// mod returns a mod m, which is never negative.
+func mod(a, m int) int {
+	return (a%m + m) % m
+}
+
+// theta is Algorithm 1.
+func theta(a state) state {
+	w := len(a[0][0])
+
+	var c, d [5][]byte
+	for x := 0; x < 5; x++ {
+		c[x] = make([]byte, w)
+		for z := 0; z < w; z++ {
+			c[x][z] = a[x][0][z] ^ a[x][1][z] ^ a[x][2][z] ^ a[x][3][z] ^ a[x][4][z]
+		}
+	}
+
+	for x := 0; x < 5; x++ {
+		d[x] = make([]byte, w)
+		for z := 0; z < w; z++ {
+			d[x][z] = c[mod(x-1, 5)][z] ^ c[mod(x+1, 5)][mod(z-1, w)]
+		}
+	}
+
+	b := a.clone()
+	for x := 0; x < 5; x++ {
+		for y := 0; y < 5; y++ {
+			for z := 0; z < w; z++ {
+				b[x][y][z] = a[x][y][z] ^ d[x][z]
+			}
+		}
+	}
+
+	return b
+}
+
+// rho is Algorithm 2.
+func rho(a state) state {
+	w := len(a[0][0])
+
+	b := a.clone()
+	x, y := 1, 0
+	for t := 0; t < 24; t++ {
+		for z := 0; z < w; z++ {
+			b[x][y][z] = a[x][y][mod(z-(t+1)*(t+2)/2, w)]
+		}
+		x, y = y, mod(2*x+3*y, 5)
+	}
+
+	return b
+}
+
+// pi is Algorithm 3.
+func pi(a state) state {
+	w := len(a[0][0])
+
+	b := a.clone()
+	for x := 0; x < 5; x++ {
+		for y := 0; y < 5; y++ {
+			for z := 0; z < w; z++ {
+				b[x][y][z] = a[mod(x+3*y, 5)][x][z]
+			}
+		}
+	}
+
+	return b
+}
+
+// chi is Algorithm 4.
+func chi(a state) state {
+	w := len(a[0][0])
+
+	b := a.clone()
+	for x := 0; x < 5; x++ {
+		for y := 0; y < 5; y++ {
+			for z := 0; z < w; z++ {
+				b[x][y][z] = a[x][y][z] ^ (a[mod(x+1, 5)][y][z]^1)&a[mod(x+2, 5)][y][z]
+			}
+		}
+	}
+
+	return b
+}
+
+// rc is Algorithm 5.
+func rc(t int) byte {
+	if mod(t, 255) == 0 {
+		return 1
+	}
+
+	r := []byte{1, 0, 0, 0, 0, 0, 0, 0}
+	for i := 1; i <= mod(t, 255); i++ {
+		r = append([]byte{0}, r...)
+		r[0] ^= r[8]
+		r[4] ^= r[8]
+		r[5] ^= r[8]
+		r[6] ^= r[8]
+		r = r[:8]
+	}
+
+	return r[0]
+}
+
+// iotaStep is Algorithm 6.
+func iotaStep(a state, ir int) state {
+	w := len(a[0][0])
+
+	l := 0
+	for 1<<l < w {
+		l++
+	}
+
+	rcs := make([]byte, w)
+	for j := 0; j <= l; j++ {
+		rcs[1<<j-1] = rc(j + 7*ir)
+	}
+
+	b := a.clone()
+	for z := 0; z < w; z++ {
+		b[0][0][z] ^= rcs[z]
+	}
+
+	return b
+}
+
+// keccakP is KECCAK-p[b, nr] of Algorithm 7.
+func keccakP(s []byte, nr int) []byte {
+	a := newState(s)
+
+	l := 0
+	for 1<<l < len(s)/25 {
+		l++
+	}
+
+	for ir := 12 + 2*l - nr; ir <= 12+2*l-1; ir++ {
+		a = iotaStep(chi(pi(rho(theta(a)))), ir)
+	}
+
+	return a.bits()
+}
+
+// pad is pad10*1 of Algorithm 9.
+func pad(x, m int) []byte {
+	j := mod(-m-2, x)
+
+	p := make([]byte, j+2)
+	p[0], p[j+1] = 1, 1
+
+	return p
+}
+
+// sponge is SPONGE[KECCAK-p[b, nr], pad10*1, r] of Algorithm 8.
+func sponge(b, nr, r int, n []byte, d int) []byte {
+	p := append(append([]byte(nil), n...), pad(r, len(n))...)
+
+	s := make([]byte, b)
+	for i := 0; i < len(p)/r; i++ {
+		for j := 0; j < r; j++ {
+			s[j] ^= p[i*r+j]
+		}
+		s = keccakP(s, nr)
+	}
+
+	var z []byte
+	for {
+		z = append(z, s[:r]...)
+		if d <= len(z) {
+			return z[:d]
+		}
+		s = keccakP(s, nr)
+	}
+}
+
+// fromBytes converts bytes to a bit string, section B.1.
+func fromBytes(data []byte) []byte {
+	s := make([]byte, 8*len(data))
+	for i := range s {
+		s[i] = data[i/8] >> (i % 8) & 1
+	}
+
+	return s
+}
+
+// toBytes converts a bit string whose length is a multiple of 8 to bytes, section B.1.
+func toBytes(s []byte) []byte {
+	data := make([]byte, len(s)/8)
+	for i := range s {
+		data[i/8] |= s[i] << (i % 8)
+	}
+
+	return data
+}
+
+// keccak returns the L bits output of KECCAK[c] on Keccak-p[b, nr] of the message.
+func keccak(c section, msg []byte) []byte {
+	return toBytes(sponge(c.width, c.rounds, c.width-c.capacity, fromBytes(msg), c.length))
+}
+
+func main() {
+	for _, v := range official {
+		msg, _ := hex.DecodeString(v.msg)
+		if md := hex.EncodeToString(keccak(section{1600, v.capacity, 24, v.length}, msg)); md != v.md {
+			panic(fmt.Sprintf("keccak[%d] of \"%s\" is %s, expected %s", v.capacity, v.msg, md, v.md))
+		}
+	}
+
+	for _, f := range files {
+		width := f.sections[0].width
+		rnd := rand.New(rand.NewSource(int64(width)))
+
+		var buf bytes.Buffer
+		fmt.Fprintf(&buf, "#  Sponge hashing on Keccak-p[%d] with the original padding of the submission (domain byte 0x01),\n", width)
+		fmt.Fprintf(&buf, "#  byte-oriented in the format of the Keccak team ShortMsgKAT files with the sponge parameters as sections.\n")
+		fmt.Fprintf(&buf, "#  Code generated by gen.go, a transcription of the KECCAK-p, SPONGE and pad10*1 algorithms of FIPS 202 on\n")
+		fmt.Fprintf(&buf, "#  bit strings, which gives the official vectors of KeccakP1600.rsp. DO NOT EDIT.\n")
+
+		for _, s := range f.sections {
+			fmt.Fprintf(&buf, "\n[Width = %d]\n[Capacity = %d]\n[Rounds = %d]\n[L = %d]\n", s.width, s.capacity, s.rounds, s.length)
+
+			for _, length := range lengths {
+				msg := make([]byte, length/8)
+				rnd.Read(msg)
+
+				// the response files give the empty message as a single zero byte
+				text := hex.EncodeToString(msg)
+				if length == 0 {
+					text = "00"
+				}
+
+				fmt.Fprintf(&buf, "\nLen = %d\nMsg = %s\nMD = %x\n", length, text, keccak(s, msg))
+			}
+		}
+
+		if err := os.WriteFile(f.name, buf.Bytes(), 0644); err != nil {
+			panic(err)
+		}
+	}
+}
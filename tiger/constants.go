@@ -0,0 +1,19 @@
+package tiger
+
+const (
+	// Size is the size of a Tiger/192 checksum in bytes.
+	Size = 24
+	// Size160 is the size of a Tiger/160 checksum in bytes.
+	Size160 = 20
+	// Size128 is the size of a Tiger/128 checksum in bytes.
+	Size128 = 16
+	// BlockSize the block size of Tiger in bytes.
+	BlockSize = 64
+
+	// padTiger and padTiger2 are the first padding bytes of Tiger and Tiger2.
+	padTiger  = 0x01
+	padTiger2 = 0x80
+)
+
+// initial is the initial state of Tiger.
+var initial = [3]uint64{0x0123456789abcdef, 0xfedcba9876543210, 0xf096a5b4c3b2e187}
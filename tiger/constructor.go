@@ -0,0 +1,34 @@
+// Package tiger implements the Tiger and Tiger2 hash algorithms, with the truncated sizes of 160 and 128 bits.
+//
+// Tiger2 differs from Tiger only by its padding, which is the one of MD4.
+package tiger
+
+import "hash"
+
+//go:generate go run gen.go
+
+// New creates a new Tiger/192 hash.Hash.
+func New() hash.Hash { return newModel(Size, padTiger) }
+
+// NewSize160 creates a new Tiger/160 hash.Hash.
+func NewSize160() hash.Hash { return newModel(Size160, padTiger) }
+
+// NewSize128 creates a new Tiger/128 hash.Hash.
+func NewSize128() hash.Hash { return newModel(Size128, padTiger) }
+
+// New2 creates a new Tiger2/192 hash.Hash.
+func New2() hash.Hash { return newModel(Size, padTiger2) }
+
+// New2Size160 creates a new Tiger2/160 hash.Hash.
+func New2Size160() hash.Hash { return newModel(Size160, padTiger2) }
+
+// New2Size128 creates a new Tiger2/128 hash.Hash.
+func New2Size128() hash.Hash { return newModel(Size128, padTiger2) }
+
+// newModel creates a new Tiger model of the size with the first padding byte.
+func newModel(size int, pad byte) *model {
+	h := &model{size: size, pad: pad}
+	h.Reset()
+
+	return h
+}
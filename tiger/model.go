@@ -0,0 +1,148 @@
+package tiger
+
+import "encoding/binary"
+
+// model represents a structure for the Tiger hash.Hash.
+type model struct {
+	size           int
+	pad            byte
+	state          [3]uint64
+	buffer         [BlockSize]byte
+	bufferIndex    int
+	processedBytes uint64
+}
+
+// implementation of the hash.Hash
+
+// Reset resets the hash.Hash to its initial state.
+func (r *model) Reset() {
+	r.state = initial
+	r.bufferIndex = 0
+	r.processedBytes = 0
+}
+
+// Size returns the number of bytes Sum will return.
+func (r *model) Size() int { return r.size }
+
+// BlockSize returns the hash's underlying block size.
+// The Write method must be able to accept any amount of data,
+// but it may operate more efficiently if all writes are a multiple of the block size.
+func (r *model) BlockSize() int { return BlockSize }
+
+// Write appends the data to the digest.
+func (r *model) Write(p []byte) (int, error) {
+	n := len(p)
+	r.processedBytes += uint64(n)
+
+	if r.bufferIndex > 0 {
+		x := copy(r.buffer[r.bufferIndex:], p)
+		r.bufferIndex += x
+		p = p[x:]
+
+		if r.bufferIndex < BlockSize {
+			return n, nil
+		}
+
+		compress(&r.state, r.buffer[:])
+		r.bufferIndex = 0
+	}
+
+	for ; len(p) >= BlockSize; p = p[BlockSize:] {
+		compress(&r.state, p)
+	}
+
+	r.bufferIndex = copy(r.buffer[:], p)
+
+	return n, nil
+}
+
+// Sum appends the current hash to b and returns the resulting slice.
+// It does not change the underlying hash state.
+func (r *model) Sum(b []byte) []byte {
+	// copy the model to allow other writes to continue and to prevent change of the state
+	s := *r
+
+	var padding [2 * BlockSize]byte
+	padding[0] = s.pad
+
+	n := BlockSize - 8 - int(s.processedBytes%BlockSize)
+	if n < 1 {
+		n += BlockSize
+	}
+
+	binary.LittleEndian.PutUint64(padding[n:], s.processedBytes<<3)
+	_, _ = s.Write(padding[:n+8])
+
+	var digest [Size]byte
+	for i, v := range s.state {
+		binary.LittleEndian.PutUint64(digest[8*i:], v)
+	}
+
+	return append(b, digest[:s.size]...)
+}
+
+// private
+
+// round mixes the word x into the state words.
+func round(a, b, c, x, mul uint64) (uint64, uint64, uint64) {
+	c ^= x
+	a -= sboxes[0][c&0xff] ^ sboxes[1][c>>16&0xff] ^ sboxes[2][c>>32&0xff] ^ sboxes[3][c>>48&0xff]
+	b += sboxes[3][c>>8&0xff] ^ sboxes[2][c>>24&0xff] ^ sboxes[1][c>>40&0xff] ^ sboxes[0][c>>56]
+	b *= mul
+
+	return a, b, c
+}
+
+// pass runs the eight rounds of a pass with the multiplier.
+func pass(a, b, c uint64, x *[8]uint64, mul uint64) (uint64, uint64, uint64) {
+	a, b, c = round(a, b, c, x[0], mul)
+	b, c, a = round(b, c, a, x[1], mul)
+	c, a, b = round(c, a, b, x[2], mul)
+	a, b, c = round(a, b, c, x[3], mul)
+	b, c, a = round(b, c, a, x[4], mul)
+	c, a, b = round(c, a, b, x[5], mul)
+	a, b, c = round(a, b, c, x[6], mul)
+	b, c, a = round(b, c, a, x[7], mul)
+
+	return a, b, c
+}
+
+// keySchedule mixes the message words between the passes.
+func keySchedule(x *[8]uint64) {
+	x[0] -= x[7] ^ 0xa5a5a5a5a5a5a5a5
+	x[1] ^= x[0]
+	x[2] += x[1]
+	x[3] -= x[2] ^ (^x[1] << 19)
+	x[4] ^= x[3]
+	x[5] += x[4]
+	x[6] -= x[5] ^ (^x[4] >> 23)
+	x[7] ^= x[6]
+	x[0] += x[7]
+	x[1] -= x[0] ^ (^x[7] << 19)
+	x[2] ^= x[1]
+	x[3] += x[2]
+	x[4] -= x[3] ^ (^x[2] >> 23)
+	x[5] ^= x[4]
+	x[6] += x[5]
+	x[7] -= x[6] ^ 0x0123456789abcdef
+}
+
+// compress compresses a block into the state.
+func compress(state *[3]uint64, block []byte) {
+	var x [8]uint64
+	for i := range x {
+		x[i] = binary.LittleEndian.Uint64(block[8*i:])
+	}
+
+	a, b, c := state[0], state[1], state[2]
+
+	a, b, c = pass(a, b, c, &x, 5)
+	keySchedule(&x)
+	c, a, b = pass(c, a, b, &x, 7)
+	keySchedule(&x)
+	b, c, a = pass(b, c, a, &x, 9)
+
+	state[0] ^= a
+	state[1] = b - state[1]
+	state[2] += c
+}
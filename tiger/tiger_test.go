@@ -0,0 +1,72 @@
+package tiger
+
+import (
+	"bytes"
+	"encoding/hex"
+	"hash"
+	"strings"
+	"testing"
+)
+
+func TestTiger2(t *testing.T) {
+	cases := []struct {
+		input    string
+		expected string
+	}{
+		{"", "4441be75f6018773c206c22745374b924aa8313fef919f41"},
+		{"a", "67e6ae8e9e968999f70a23e72aeaa9251cbc7c78a7916636"},
+		{"abc", "f68d7bc5af4b43a06e048d7829560d4a9415658bb0b1f3bf"},
+		{"message digest", "e29419a1b5fa259de8005e7de75078ea81a542ef2552462d"},
+		{"Tiger", "fe40798b8eb937fd977608930548d6a894c20b04cbef7a42"},
+		{strings.Repeat("a", 1000000), "e068281f060f551628cc5715b9d0226796914d45f7717cf4"},
+	}
+
+	for _, c := range cases {
+		h := New2()
+		h.Write([]byte(c.input))
+
+		if got := hex.EncodeToString(h.Sum(nil)); got != c.expected {
+			t.Errorf("tiger2 of %d bytes is wrong:\n\texpected \"%s\"\n\tgot \"%s\"", len(c.input), c.expected, got)
+		}
+	}
+}
+
+func TestTruncated(t *testing.T) {
+	data := []byte("The quick brown fox jumps over the lazy dog")
+
+	for _, c := range []struct {
+		full      func() hash.Hash
+		truncated []func() hash.Hash
+	}{
+		{New, []func() hash.Hash{NewSize160, NewSize128}},
+		{New2, []func() hash.Hash{New2Size160, New2Size128}},
+	} {
+		h := c.full()
+		h.Write(data)
+		full := h.Sum(nil)
+
+		for _, newHash := range c.truncated {
+			h = newHash()
+			h.Write(data)
+
+			if got := h.Sum(nil); !bytes.Equal(got, full[:h.Size()]) {
+				t.Errorf("truncated sum of size %d is wrong:\n\texpected \"%x\"\n\tgot \"%x\"", h.Size(), full[:h.Size()], got)
+			}
+		}
+	}
+}
+
+func benchmarkTiger(b *testing.B, h hash.Hash) {
+	data := make([]byte, 8192)
+
+	b.SetBytes(int64(len(data)))
+	for i := 0; i < b.N; i++ {
+		h.Reset()
+		h.Write(data)
+		h.Sum(nil)
+	}
+}
+
+func BenchmarkTiger(b *testing.B) { benchmarkTiger(b, New()) }
+
+func BenchmarkTiger2(b *testing.B) { benchmarkTiger(b, New2()) }
@@ -0,0 +1,13 @@
+package unixsum
+
+const (
+	// Size is the size of a BSD or System V sum checksum in bytes.
+	Size = 2
+	// BlockSize the block size of the BSD and System V sums in bytes.
+	BlockSize = 1
+
+	// BlockUnitBSD is the size of the blocks counted by the BSD sum, like sum -r.
+	BlockUnitBSD = 1024
+	// BlockUnitSysV is the size of the blocks counted by the System V sum, like sum -s.
+	BlockUnitSysV = 512
+)
@@ -0,0 +1,23 @@
+// Package unixsum implements the BSD and System V checksums of the Unix sum command.
+package unixsum
+
+import "hash"
+
+// Hash represents a unixsum.Hash.
+type Hash interface {
+	hash.Hash
+	Sum16() uint16
+	// Blocks returns the number of blocks of the written data, rounded up, as printed by sum.
+	Blocks() int64
+}
+
+// NewBSD creates a new BSD sum Hash, the algorithm of sum -r.
+func NewBSD() Hash { return &modelBSD{} }
+
+// NewSysV creates a new System V sum Hash, the algorithm of sum -s.
+func NewSysV() Hash { return &modelSysV{} }
+
+// blocks returns the number of blocks of the unit in size bytes, rounded up.
+func blocks(size uint64, unit uint64) int64 {
+	return int64((size + unit - 1) / unit)
+}
@@ -0,0 +1,102 @@
+package unixsum
+
+import "encoding/binary"
+
+// modelBSD represents a structure for the BSD sum Hash.
+type modelBSD struct {
+	sum   uint16
+	total uint64
+}
+
+// implementation of the hash.Hash
+
+// Reset resets the Hash to its initial state.
+func (r *modelBSD) Reset() {
+	r.sum = 0
+	r.total = 0
+}
+
+// Size returns the number of bytes Sum will return.
+func (r *modelBSD) Size() int { return Size }
+
+// BlockSize returns the hash's underlying block size.
+func (r *modelBSD) BlockSize() int { return BlockSize }
+
+// Write appends the data to the digest.
+func (r *modelBSD) Write(p []byte) (int, error) {
+	sum := r.sum
+	for _, c := range p {
+		sum = (sum>>1 | sum<<15) + uint16(c)
+	}
+
+	r.sum = sum
+	r.total += uint64(len(p))
+
+	return len(p), nil
+}
+
+// Sum appends the current hash to b and returns the resulting slice.
+// It does not change the underlying hash state.
+func (r *modelBSD) Sum(b []byte) []byte {
+	return binary.BigEndian.AppendUint16(b, r.sum)
+}
+
+// implementation of the unixsum.Hash
+
+// Sum16 returns the Hash checksum.
+func (r *modelBSD) Sum16() uint16 { return r.sum }
+
+// Blocks returns the number of 1024-byte blocks of the written data.
+func (r *modelBSD) Blocks() int64 { return blocks(r.total, BlockUnitBSD) }
+
+// modelSysV represents a structure for the System V sum Hash.
+type modelSysV struct {
+	sum   uint32
+	total uint64
+}
+
+// implementation of the hash.Hash
+
+// Reset resets the Hash to its initial state.
+func (r *modelSysV) Reset() {
+	r.sum = 0
+	r.total = 0
+}
+
+// Size returns the number of bytes Sum will return.
+func (r *modelSysV) Size() int { return Size }
+
+// BlockSize returns the hash's underlying block size.
+func (r *modelSysV) BlockSize() int { return BlockSize }
+
+// Write appends the data to the digest.
+func (r *modelSysV) Write(p []byte) (int, error) {
+	sum := r.sum
+	for _, c := range p {
+		sum += uint32(c)
+	}
+
+	r.sum = sum
+	r.total += uint64(len(p))
+
+	return len(p), nil
+}
+
+// Sum appends the current hash to b and returns the resulting slice.
+// It does not change the underlying hash state.
+func (r *modelSysV) Sum(b []byte) []byte {
+	return binary.BigEndian.AppendUint16(b, r.Sum16())
+}
+
+// implementation of the unixsum.Hash
+
+// Sum16 returns the Hash checksum, the wrapping 32-bit sum of the bytes folded into 16 bits.
+func (r *modelSysV) Sum16() uint16 {
+	s := r.sum&0xffff + r.sum>>16
+	s = s&0xffff + s>>16
+
+	return uint16(s)
+}
+
+// Blocks returns the number of 512-byte blocks of the written data.
+func (r *modelSysV) Blocks() int64 { return blocks(r.total, BlockUnitSysV) }
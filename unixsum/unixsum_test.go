@@ -0,0 +1,37 @@
+package unixsum
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestSum(t *testing.T) {
+	// outputs of GNU sum -r and sum -s
+	vectors := []struct {
+		input      []byte
+		bsd        uint16
+		bsdBlocks  int64
+		sysv       uint16
+		sysvBlocks int64
+	}{
+		{nil, 0, 0, 0, 0},
+		{[]byte("hello world\n"), 3762, 1, 1126, 1},
+		{bytes.Repeat([]byte{0xff}, 20000000), 20861, 19532, 764, 39063},
+	}
+
+	for _, v := range vectors {
+		bsd := NewBSD()
+		bsd.Write(v.input)
+
+		if bsd.Sum16() != v.bsd || bsd.Blocks() != v.bsdBlocks {
+			t.Errorf("BSD sum of %d bytes is wrong:\n\texpected \"%05d %d\"\n\tgot \"%05d %d\"", len(v.input), v.bsd, v.bsdBlocks, bsd.Sum16(), bsd.Blocks())
+		}
+
+		sysv := NewSysV()
+		sysv.Write(v.input)
+
+		if sysv.Sum16() != v.sysv || sysv.Blocks() != v.sysvBlocks {
+			t.Errorf("System V sum of %d bytes is wrong:\n\texpected \"%d %d\"\n\tgot \"%d %d\"", len(v.input), v.sysv, v.sysvBlocks, sysv.Sum16(), sysv.Blocks())
+		}
+	}
+}
@@ -0,0 +1,13 @@
+package whirlpool
+
+const (
+	// Size is the size of a Whirlpool checksum in bytes.
+	Size = 64
+	// BlockSize the block size of Whirlpool in bytes.
+	BlockSize = 64
+
+	// rounds is the number of rounds of the W block cipher.
+	rounds = 10
+	// lengthSize is the size of the message length appended by the padding, 256 bits.
+	lengthSize = 32
+)
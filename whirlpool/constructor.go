@@ -0,0 +1,14 @@
+// Package whirlpool implements the Whirlpool hash algorithm, the final version of ISO/IEC 10118-3.
+package whirlpool
+
+import "hash"
+
+//go:generate go run gen.go
+
+// New creates a new Whirlpool hash.Hash.
+func New() hash.Hash {
+	h := new(model)
+	h.Reset()
+
+	return h
+}
@@ -0,0 +1,123 @@
+package whirlpool
+
+import "encoding/binary"
+
+// model represents a structure for the Whirlpool hash.Hash.
+type model struct {
+	state          [8]uint64
+	buffer         [BlockSize]byte
+	bufferIndex    int
+	processedBytes uint64
+}
+
+// implementation of the hash.Hash
+
+// Reset resets the hash.Hash to its initial state.
+func (r *model) Reset() {
+	r.state = [8]uint64{}
+	r.bufferIndex = 0
+	r.processedBytes = 0
+}
+
+// Size returns the number of bytes Sum will return.
+func (r *model) Size() int { return Size }
+
+// BlockSize returns the hash's underlying block size.
+// The Write method must be able to accept any amount of data,
+// but it may operate more efficiently if all writes are a multiple of the block size.
+func (r *model) BlockSize() int { return BlockSize }
+
+// Write appends the data to the digest.
+func (r *model) Write(p []byte) (int, error) {
+	n := len(p)
+	r.processedBytes += uint64(n)
+
+	if r.bufferIndex > 0 {
+		x := copy(r.buffer[r.bufferIndex:], p)
+		r.bufferIndex += x
+		p = p[x:]
+
+		if r.bufferIndex < BlockSize {
+			return n, nil
+		}
+
+		compress(&r.state, r.buffer[:])
+		r.bufferIndex = 0
+	}
+
+	for ; len(p) >= BlockSize; p = p[BlockSize:] {
+		compress(&r.state, p)
+	}
+
+	r.bufferIndex = copy(r.buffer[:], p)
+
+	return n, nil
+}
+
+// Sum appends the current hash to b and returns the resulting slice.
+// It does not change the underlying hash state.
+func (r *model) Sum(b []byte) []byte {
+	// copy the model to allow other writes to continue and to prevent change of the state
+	s := *r
+
+	// the padding is a one bit, zeros and the 256-bit big-endian length in bits, of which the high bits are zero here
+	var padding [2 * BlockSize]byte
+	padding[0] = 0x80
+
+	n := BlockSize - lengthSize - int(s.processedBytes%BlockSize)
+	if n < 1 {
+		n += BlockSize
+	}
+
+	binary.BigEndian.PutUint64(padding[n+lengthSize-16:], s.processedBytes>>61)
+	binary.BigEndian.PutUint64(padding[n+lengthSize-8:], s.processedBytes<<3)
+	_, _ = s.Write(padding[:n+lengthSize])
+
+	for _, v := range s.state {
+		b = binary.BigEndian.AppendUint64(b, v)
+	}
+
+	return b
+}
+
+// private
+
+// transform applies the substitution, the cyclic permutation and the diffusion layers to the rows of in.
+func transform(in *[8]uint64) (out [8]uint64) {
+	for i := range out {
+		out[i] = tables[0][in[i]>>56] ^
+			tables[1][in[(i+7)&7]>>48&0xff] ^
+			tables[2][in[(i+6)&7]>>40&0xff] ^
+			tables[3][in[(i+5)&7]>>32&0xff] ^
+			tables[4][in[(i+4)&7]>>24&0xff] ^
+			tables[5][in[(i+3)&7]>>16&0xff] ^
+			tables[6][in[(i+2)&7]>>8&0xff] ^
+			tables[7][in[(i+1)&7]&0xff]
+	}
+
+	return out
+}
+
+// compress compresses a block into the state with the Miyaguchi-Preneel construction over the W block cipher.
+func compress(state *[8]uint64, block []byte) {
+	var message, cipher [8]uint64
+	for i := range message {
+		message[i] = binary.BigEndian.Uint64(block[8*i:])
+		cipher[i] = message[i] ^ state[i]
+	}
+
+	key := *state
+	for r := 0; r < rounds; r++ {
+		key = transform(&key)
+		key[0] ^= roundConstants[r]
+
+		cipher = transform(&cipher)
+		for i := range cipher {
+			cipher[i] ^= key[i]
+		}
+	}
+
+	for i := range state {
+		state[i] ^= cipher[i] ^ message[i]
+	}
+}
@@ -0,0 +1,33 @@
+package whirlpool
+
+import (
+	"encoding/hex"
+	"strings"
+	"testing"
+)
+
+func TestMillionA(t *testing.T) {
+	// the last message of the NESSIE set 1 vectors
+	expected := "0c99005beb57eff50a7cf005560ddf5d29057fd86b20bfd62deca0f1ccea4af51fc15490eddc47af32bb2b66c34ff9ad8c6008ad677f77126953b226e4ed8b01"
+
+	h := New()
+	for i := 0; i < 1000; i++ {
+		h.Write([]byte(strings.Repeat("a", 1000)))
+	}
+
+	if got := hex.EncodeToString(h.Sum(nil)); got != expected {
+		t.Errorf("whirlpool of one million 'a' is wrong:\n\texpected \"%s\"\n\tgot \"%s\"", expected, got)
+	}
+}
+
+func BenchmarkWhirlpool(b *testing.B) {
+	h := New()
+	data := make([]byte, 8192)
+
+	b.SetBytes(int64(len(data)))
+	for i := 0; i < b.N; i++ {
+		h.Reset()
+		h.Write(data)
+		h.Sum(nil)
+	}
+}
@@ -0,0 +1,173 @@
+package hashed
+
+import (
+	"bytes"
+	"hash"
+	"math/rand"
+	"testing"
+)
+
+// customHashTypes are the hash types backed by the models implemented in this module.
+var customHashTypes = []string{
+	"crc-16",
+	"adler-32",
+	"fletcher-16",
+	"fletcher-32",
+	"fletcher-64",
+	"bsd-sum",
+	"sysv-sum",
+	"md2",
+	"keccak-224",
+	"keccak-384",
+	"ascon-hash256",
+	"ascon-xof128",
+	"ascon-cxof128",
+	"xoodyak",
+	"kmac-128",
+	"kmac-256",
+	"ripemd-128",
+	"ripemd-160",
+	"ripemd-256",
+	"ripemd-320",
+	"whirlpool",
+	"tiger-192",
+	"tiger-160",
+	"tiger-128",
+	"sm3",
+	"streebog-256",
+	"streebog-512",
+	"gost-94",
+	"skein-256",
+	"skein-512",
+	"skein-1024",
+	"groestl-224",
+	"groestl-256",
+	"groestl-384",
+	"groestl-512",
+	"jh-224",
+	"jh-256",
+	"jh-384",
+	"jh-512",
+	"blake-256",
+	"blake-512",
+	"blake2b",
+	"blake2s",
+	"blake2bp",
+	"blake2sp",
+	"blake2xb",
+	"blake2xs",
+	"blake2s-128",
+	"blake2s-256",
+	"blake2b-256",
+	"blake2b-384",
+	"blake2b-512",
+	"blake2b-mac",
+	"blake2s-mac",
+	"blake2bp-mac",
+	"blake2sp-mac",
+	"blake2xb-mac",
+	"blake2xs-mac",
+	"hash160",
+	"sha256d",
+	"keccak256-of-pubkey",
+	"xxh32",
+	"xxh64",
+	"xxh3-64",
+	"xxh3-128",
+	"murmur3-x86-32",
+	"murmur3-x86-128",
+	"murmur3-x64-128",
+	"cityhash-64",
+	"cityhash-128",
+	"siphash-2-4-64",
+	"siphash-2-4-128",
+	"siphash-1-3-64",
+	"siphash-1-3-128",
+	"cmac-aes128",
+	"cmac-tdes",
+	"gmac-aes128",
+	"cbcmac-aes128",
+	"retail-mac-des",
+	"poly1305",
+}
+
+// newCustomHash creates a new hash.Hash of the given type with the same options as TestSums.
+func newCustomHash(hashType string) hash.Hash {
+	options := DefaultOptions(hashType).
+		SetKey(testKey(hashType)).
+		SetCustomization([]byte("8df75ae53e4bdf7b5ae9c09bd0baffb1")).
+		SetNonce(testNonce)
+
+	return getHashFunc(options)()
+}
+
+// chunkedSum writes the data to h split at random points and returns the sum.
+// It also checks that every write reports the full length and Sum does not change the state.
+func chunkedSum(t testing.TB, h hash.Hash, data []byte, rnd *rand.Rand) []byte {
+	h.Reset()
+
+	for len(data) > 0 {
+		n := rnd.Intn(len(data) + 1)
+		if rnd.Intn(4) == 0 {
+			n = rnd.Intn(h.BlockSize() + 1)
+			if n > len(data) {
+				n = len(data)
+			}
+		}
+
+		written, err := h.Write(data[:n])
+		if err != nil {
+			t.Fatalf("write failed: %s", err)
+		}
+		if written != n {
+			t.Fatalf("short write: expected %d, got %d", n, written)
+		}
+
+		if rnd.Intn(8) == 0 {
+			_ = h.Sum(nil)
+		}
+
+		data = data[n:]
+	}
+
+	return h.Sum(nil)
+}
+
+// checkChunkedWrites compares the one-shot sum of data with sums of randomly chunked writes for every custom model.
+func checkChunkedWrites(t testing.TB, data []byte, seed int64) {
+	rnd := rand.New(rand.NewSource(seed))
+
+	for _, hashType := range customHashTypes {
+		h := newCustomHash(hashType)
+		_, _ = h.Write(data)
+		expected := h.Sum(nil)
+
+		for i := 0; i < 4; i++ {
+			output := chunkedSum(t, h, data, rnd)
+			if !bytes.Equal(expected, output) {
+				t.Fatalf("'%s' chunked sum of %d bytes is wrong (seed %d):\n\texpected \"%x\"\n\tgot \"%x\"", hashType, len(data), seed, expected, output)
+			}
+		}
+	}
+}
+
+func TestChunkedWrites(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+
+	for _, size := range []int{0, 1, 15, 16, 17, 63, 64, 65, 135, 136, 137, 255, 256, 257, 300, 1000, 4099} {
+		data := make([]byte, size)
+		rnd.Read(data)
+
+		checkChunkedWrites(t, data, rnd.Int63())
+	}
+}
+
+func FuzzChunkedWrites(f *testing.F) {
+	f.Add([]byte(""), int64(0))
+	f.Add([]byte("0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz"), int64(1))
+	f.Add(bytes.Repeat([]byte{0xa5}, 300), int64(2))
+
+	f.Fuzz(func(t *testing.T, data []byte, seed int64) {
+		checkChunkedWrites(t, data, seed)
+	})
+}
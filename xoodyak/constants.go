@@ -0,0 +1,22 @@
+package xoodyak
+
+const (
+	// Size is the size of a Xoodyak checksum in bytes, which is also the default output size of the XOF.
+	Size = 32
+	// BlockSize the block size of Xoodyak in hash mode in bytes, which is the rate of absorbing and squeezing.
+	BlockSize = 16
+
+	// lanes is the number of 32-bit lanes of the state, three planes of four lanes.
+	lanes = 12
+	// rounds is the number of rounds of the permutation Xoodoo[12].
+	rounds = 12
+
+	// colorAbsorb is the domain separation of the first block absorbed, only its last bit is used in hash mode.
+	colorAbsorb = 0x01
+)
+
+// roundConstants are the constants of the rounds.
+var roundConstants = [rounds]uint32{
+	0x00000058, 0x00000038, 0x000003c0, 0x000000d0, 0x00000120, 0x00000014,
+	0x00000060, 0x0000002c, 0x00000380, 0x000000f0, 0x000001a0, 0x00000012,
+}
@@ -0,0 +1,34 @@
+// Package xoodyak implements the hash mode of Xoodyak, a finalist of the NIST lightweight cryptography competition,
+// which is the Cyclist construction on the permutation Xoodoo[12] without a key.
+package xoodyak
+
+import (
+	"errors"
+	"hash"
+	"io"
+)
+
+var (
+	// ErrSize is returned when the output size of the XOF is not positive.
+	ErrSize = errors.New("xoodyak: size must be at least 1 byte")
+	// ErrWriteAfterRead is returned when the XOF is written to after it started squeezing.
+	ErrWriteAfterRead = errors.New("xoodyak: write after read")
+)
+
+// XOF represents an extendable-output function, Sum returns Size bytes and Read squeezes any amount of output.
+type XOF interface {
+	hash.Hash
+	io.Reader
+}
+
+// New creates a new Xoodyak hash.Hash.
+func New() hash.Hash { return &model{size: Size} }
+
+// NewXOF creates a new Xoodyak XOF, whose Sum returns size bytes.
+func NewXOF(size int) (XOF, error) {
+	if size < 1 {
+		return nil, ErrSize
+	}
+
+	return &model{size: size}, nil
+}
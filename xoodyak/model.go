@@ -0,0 +1,179 @@
+package xoodyak
+
+import (
+	"encoding/binary"
+	"math/bits"
+)
+
+// model represents a structure for the Xoodyak hash.Hash and XOF.
+// Every absorbed block is padded, so a full buffer is only absorbed when more data follows,
+// the buffer holds the output block while squeezing.
+type model struct {
+	size        int
+	state       [lanes]uint32
+	buffer      [BlockSize]byte
+	bufferIndex int
+	absorbed    bool
+	squeezing   bool
+}
+
+// implementation of the hash.Hash
+
+// Reset resets the hash.Hash to its initial state.
+func (r *model) Reset() {
+	r.state = [lanes]uint32{}
+	r.bufferIndex = 0
+	r.absorbed = false
+	r.squeezing = false
+}
+
+// Size returns the number of bytes Sum will return.
+func (r *model) Size() int { return r.size }
+
+// BlockSize returns the hash's underlying block size.
+// The Write method must be able to accept any amount of data,
+// but it may operate more efficiently if all writes are a multiple of the block size.
+func (r *model) BlockSize() int { return BlockSize }
+
+// Write appends the data to the digest.
+// It returns ErrWriteAfterRead if the output has already been read.
+func (r *model) Write(p []byte) (int, error) {
+	if r.squeezing {
+		return 0, ErrWriteAfterRead
+	}
+
+	n := len(p)
+
+	for len(p) > 0 {
+		if r.bufferIndex == BlockSize {
+			r.absorb(r.buffer[:])
+			r.bufferIndex = 0
+		}
+
+		if r.bufferIndex == 0 {
+			for ; len(p) > BlockSize; p = p[BlockSize:] {
+				r.absorb(p[:BlockSize])
+			}
+		}
+
+		x := copy(r.buffer[r.bufferIndex:], p)
+		r.bufferIndex += x
+		p = p[x:]
+	}
+
+	return n, nil
+}
+
+// Sum appends the current hash to b and returns the resulting slice.
+// It does not change the underlying hash state.
+func (r *model) Sum(b []byte) []byte {
+	// copy the model to allow other writes to continue and to prevent change of the state
+	s := *r
+
+	n := len(b)
+	b = append(b, make([]byte, s.size)...)
+	_, _ = s.Read(b[n:])
+
+	return b
+}
+
+// implementation of the io.Reader
+
+// Read squeezes output from the XOF, the first call absorbs the last block, which is empty for an empty message.
+// It always fills p and never returns an error.
+func (r *model) Read(p []byte) (int, error) {
+	if !r.squeezing {
+		r.absorb(r.buffer[:r.bufferIndex])
+		r.squeezing = true
+		r.squeeze()
+	}
+
+	n := len(p)
+	for len(p) > 0 {
+		// the next output block follows an empty padded block
+		if r.bufferIndex == BlockSize {
+			r.state[0] ^= 0x01
+			r.squeeze()
+		}
+
+		x := copy(p, r.buffer[r.bufferIndex:])
+		r.bufferIndex += x
+		p = p[x:]
+	}
+
+	return n, nil
+}
+
+// private
+
+// absorb adds a block padded by a one byte to the state, the blocks after the first one follow the permutation.
+func (r *model) absorb(block []byte) {
+	if r.absorbed {
+		permute(&r.state)
+	}
+
+	var padded [BlockSize + 4]byte
+	copy(padded[:], block)
+	padded[len(block)] = 0x01
+
+	for i := 0; i < len(padded)/4; i++ {
+		r.state[i] ^= binary.LittleEndian.Uint32(padded[4*i:])
+	}
+
+	// the color of the first block is in the last byte of the state
+	if !r.absorbed {
+		r.state[lanes-1] ^= colorAbsorb << 24
+		r.absorbed = true
+	}
+}
+
+// squeeze permutes the state and puts the output block in the buffer.
+func (r *model) squeeze() {
+	permute(&r.state)
+
+	for i := 0; i < BlockSize/4; i++ {
+		binary.LittleEndian.PutUint32(r.buffer[4*i:], r.state[i])
+	}
+
+	r.bufferIndex = 0
+}
+
+// permute applies the permutation Xoodoo[12] to the state, whose lanes are in three planes of four lanes.
+func permute(state *[lanes]uint32) {
+	a0, a1, a2, a3 := state[0], state[1], state[2], state[3]
+	a4, a5, a6, a7 := state[4], state[5], state[6], state[7]
+	a8, a9, a10, a11 := state[8], state[9], state[10], state[11]
+
+	for _, c := range roundConstants {
+		// θ, the column parity of the previous column added to every plane
+		p0, p1, p2, p3 := a0^a4^a8, a1^a5^a9, a2^a6^a10, a3^a7^a11
+		e0 := bits.RotateLeft32(p3, 5) ^ bits.RotateLeft32(p3, 14)
+		e1 := bits.RotateLeft32(p0, 5) ^ bits.RotateLeft32(p0, 14)
+		e2 := bits.RotateLeft32(p1, 5) ^ bits.RotateLeft32(p1, 14)
+		e3 := bits.RotateLeft32(p2, 5) ^ bits.RotateLeft32(p2, 14)
+		a0, a1, a2, a3 = a0^e0, a1^e1, a2^e2, a3^e3
+		a4, a5, a6, a7 = a4^e0, a5^e1, a6^e2, a7^e3
+		a8, a9, a10, a11 = a8^e0, a9^e1, a10^e2, a11^e3
+
+		// ρ west, the plane 1 is shifted by one lane and the plane 2 rotated by 11 bits
+		a4, a5, a6, a7 = a7, a4, a5, a6
+		a8, a9, a10, a11 = bits.RotateLeft32(a8, 11), bits.RotateLeft32(a9, 11), bits.RotateLeft32(a10, 11), bits.RotateLeft32(a11, 11)
+
+		// ι
+		a0 ^= c
+
+		// χ
+		a0, a4, a8 = a0^^a4&a8, a4^^a8&a0, a8^^a0&a4
+		a1, a5, a9 = a1^^a5&a9, a5^^a9&a1, a9^^a1&a5
+		a2, a6, a10 = a2^^a6&a10, a6^^a10&a2, a10^^a2&a6
+		a3, a7, a11 = a3^^a7&a11, a7^^a11&a3, a11^^a3&a7
+
+		// ρ east, the plane 1 is rotated by one bit and the plane 2 shifted by two lanes and rotated by 8 bits
+		a4, a5, a6, a7 = bits.RotateLeft32(a4, 1), bits.RotateLeft32(a5, 1), bits.RotateLeft32(a6, 1), bits.RotateLeft32(a7, 1)
+		a8, a9, a10, a11 = bits.RotateLeft32(a10, 8), bits.RotateLeft32(a11, 8), bits.RotateLeft32(a8, 8), bits.RotateLeft32(a9, 8)
+	}
+
+	state[0], state[1], state[2], state[3] = a0, a1, a2, a3
+	state[4], state[5], state[6], state[7] = a4, a5, a6, a7
+	state[8], state[9], state[10], state[11] = a8, a9, a10, a11
+}
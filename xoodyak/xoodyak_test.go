@@ -0,0 +1,70 @@
+package xoodyak
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"testing"
+)
+
+func TestEmpty(t *testing.T) {
+	// the first message of the known answer tests of the submission
+	expected := "ea152f2b47bce24efb66c479d4adf17bd324d806e85ff75ee369ee50dc8f8bd1"
+
+	if got := hex.EncodeToString(New().Sum(nil)); got != expected {
+		t.Errorf("sum of the empty message is wrong:\n\texpected \"%s\"\n\tgot \"%s\"", expected, got)
+	}
+}
+
+func TestRead(t *testing.T) {
+	data := []byte("The quick brown fox jumps over the lazy dog")
+
+	h, _ := NewXOF(100)
+	h.Write(data)
+	expected := h.Sum(nil)
+
+	// the sum of the default size is the beginning of the longer output
+	short := New()
+	short.Write(data)
+
+	if got := short.Sum(nil); !bytes.Equal(expected[:Size], got) {
+		t.Errorf("sum is not the beginning of the longer output:\n\texpected \"%x\"\n\tgot \"%x\"", expected[:Size], got)
+	}
+
+	// the output read in pieces is the same as the sum of the whole size
+	for n := 1; n < 2*BlockSize; n++ {
+		h.Reset()
+		h.Write(data)
+
+		var output []byte
+		for len(output) < len(expected) {
+			piece := make([]byte, min(n, len(expected)-len(output)))
+			h.Read(piece)
+			output = append(output, piece...)
+		}
+
+		if !bytes.Equal(expected, output) {
+			t.Errorf("output read in pieces of %d bytes is wrong:\n\texpected \"%x\"\n\tgot \"%x\"", n, expected, output)
+		}
+	}
+
+	if _, err := h.Write(data); !errors.Is(err, ErrWriteAfterRead) {
+		t.Errorf("write after read returned %v, expected %v", err, ErrWriteAfterRead)
+	}
+
+	if _, err := NewXOF(0); !errors.Is(err, ErrSize) {
+		t.Errorf("size 0 returned %v, expected %v", err, ErrSize)
+	}
+}
+
+func BenchmarkXoodyak(b *testing.B) {
+	h := New()
+	data := make([]byte, 8192)
+
+	b.SetBytes(int64(len(data)))
+	for i := 0; i < b.N; i++ {
+		h.Reset()
+		h.Write(data)
+		h.Sum(nil)
+	}
+}
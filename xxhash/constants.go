@@ -0,0 +1,61 @@
+package xxhash
+
+const (
+	// Size32 is the size of an XXH32 checksum in bytes.
+	Size32 = 4
+	// Size64 is the size of an XXH64 checksum in bytes.
+	Size64 = 8
+	// Size3Type64 is the size of an XXH3-64 checksum in bytes.
+	Size3Type64 = 8
+	// Size3Type128 is the size of an XXH3-128 checksum in bytes.
+	Size3Type128 = 16
+	// BlockSize32 is the stripe size of XXH32 in bytes.
+	BlockSize32 = 16
+	// BlockSize64 is the stripe size of XXH64 in bytes.
+	BlockSize64 = 32
+	// BlockSize3 is the stripe size of XXH3 in bytes.
+	BlockSize3 = 64
+
+	prime32v1 = 0x9E3779B1
+	prime32v2 = 0x85EBCA77
+	prime32v3 = 0xC2B2AE3D
+	prime32v4 = 0x27D4EB2F
+	prime32v5 = 0x165667B1
+
+	prime64v1 = 0x9E3779B185EBCA87
+	prime64v2 = 0xC2B2AE3D27D4EB4F
+	prime64v3 = 0x165667B19E3779F9
+	prime64v4 = 0x85EBCA77C2B2AE63
+	prime64v5 = 0x27D4EB2F165667C5
+
+	primeMx1 = 0x165667919E3779F9
+	primeMx2 = 0x9FB21C651E98DF25
+
+	// secretSize is the size of the default secret of XXH3.
+	secretSize = 192
+	// stripesPerBlock is the number of stripes of XXH3 between two scrambles of the accumulators.
+	stripesPerBlock = (secretSize - BlockSize3) / 8
+	// midSizeMax is the longest input XXH3 hashes without the accumulators.
+	midSizeMax = 240
+	// midSizeStartOffset and midSizeLastOffset are the secret offsets of XXH3 for inputs of 129 to 240 bytes.
+	midSizeStartOffset = 3
+	midSizeLastOffset  = 136 - 17
+	// bufferSize is the size of the buffer of the streaming XXH3, 4 stripes.
+	bufferSize = 4 * BlockSize3
+)
+
+// defaultSecret is the default secret of XXH3.
+var defaultSecret = [secretSize]byte{
+	0xb8, 0xfe, 0x6c, 0x39, 0x23, 0xa4, 0x4b, 0xbe, 0x7c, 0x01, 0x81, 0x2c, 0xf7, 0x21, 0xad, 0x1c,
+	0xde, 0xd4, 0x6d, 0xe9, 0x83, 0x90, 0x97, 0xdb, 0x72, 0x40, 0xa4, 0xa4, 0xb7, 0xb3, 0x67, 0x1f,
+	0xcb, 0x79, 0xe6, 0x4e, 0xcc, 0xc0, 0xe5, 0x78, 0x82, 0x5a, 0xd0, 0x7d, 0xcc, 0xff, 0x72, 0x21,
+	0xb8, 0x08, 0x46, 0x74, 0xf7, 0x43, 0x24, 0x8e, 0xe0, 0x35, 0x90, 0xe6, 0x81, 0x3a, 0x26, 0x4c,
+	0x3c, 0x28, 0x52, 0xbb, 0x91, 0xc3, 0x00, 0xcb, 0x88, 0xd0, 0x65, 0x8b, 0x1b, 0x53, 0x2e, 0xa3,
+	0x71, 0x64, 0x48, 0x97, 0xa2, 0x0d, 0xf9, 0x4e, 0x38, 0x19, 0xef, 0x46, 0xa9, 0xde, 0xac, 0xd8,
+	0xa8, 0xfa, 0x76, 0x3f, 0xe3, 0x9c, 0x34, 0x3f, 0xf9, 0xdc, 0xbb, 0xc7, 0xc7, 0x0b, 0x4f, 0x1d,
+	0x8a, 0x51, 0xe0, 0x4b, 0xcd, 0xb4, 0x59, 0x31, 0xc8, 0x9f, 0x7e, 0xc9, 0xd9, 0x78, 0x73, 0x64,
+	0xea, 0xc5, 0xac, 0x83, 0x34, 0xd3, 0xeb, 0xc3, 0xc5, 0x81, 0xa0, 0xff, 0xfa, 0x13, 0x63, 0xeb,
+	0x17, 0x0d, 0xdd, 0x51, 0xb7, 0xf0, 0xda, 0x49, 0xd3, 0x16, 0x55, 0x26, 0x29, 0xd4, 0x68, 0x9e,
+	0x2b, 0x16, 0xbe, 0x58, 0x7d, 0x47, 0xa1, 0xfc, 0x8f, 0xf8, 0xb8, 0xd1, 0x7a, 0xd0, 0x31, 0xce,
+	0x45, 0xcb, 0x3a, 0x8f, 0x95, 0x16, 0x04, 0x28, 0xaf, 0xd7, 0xfb, 0xca, 0xbb, 0x4b, 0x40, 0x7e,
+}
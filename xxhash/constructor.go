@@ -0,0 +1,36 @@
+// Package xxhash implements the xxHash non-cryptographic hash algorithms XXH32, XXH64, XXH3-64 and XXH3-128.
+//
+// The checksums are in the canonical big-endian form printed by xxhsum.
+package xxhash
+
+import "hash"
+
+// New32 creates a new XXH32 hash.Hash with the 32-bit seed.
+func New32(seed uint32) hash.Hash {
+	r := &model32{seed: seed}
+	r.Reset()
+
+	return r
+}
+
+// New64 creates a new XXH64 hash.Hash with the seed.
+func New64(seed uint64) hash.Hash {
+	r := &model64{seed: seed}
+	r.Reset()
+
+	return r
+}
+
+// New3Type64 creates a new XXH3-64 hash.Hash with the seed.
+func New3Type64(seed uint64) hash.Hash { return newModel3(seed, Size3Type64) }
+
+// New3Type128 creates a new XXH3-128 hash.Hash with the seed.
+func New3Type128(seed uint64) hash.Hash { return newModel3(seed, Size3Type128) }
+
+// newModel3 creates a new XXH3 hash.Hash of the size.
+func newModel3(seed uint64, size int) hash.Hash {
+	r := &model3{seed: seed, size: size, secret: deriveSecret(seed)}
+	r.Reset()
+
+	return r
+}
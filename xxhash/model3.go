@@ -0,0 +1,121 @@
+package xxhash
+
+import "encoding/binary"
+
+// model3 represents a structure for the XXH3 hash.Hash of 64 or 128 bits.
+// Stripes are consumed only when more input follows them, since the last stripe of a long input is mixed differently.
+type model3 struct {
+	seed    uint64
+	size    int
+	secret  [secretSize]byte
+	acc     [8]uint64
+	stripes int
+	buf     [bufferSize]byte
+	bufLen  int
+	last    [BlockSize3]byte
+	total   uint64
+}
+
+// implementation of the hash.Hash
+
+// Reset resets the hash.Hash to its initial state.
+func (r *model3) Reset() {
+	r.acc = [8]uint64{prime32v3, prime64v1, prime64v2, prime64v3, prime64v4, prime32v2, prime64v5, prime32v1}
+	r.stripes = 0
+	r.bufLen = 0
+	r.total = 0
+}
+
+// Size returns the number of bytes Sum will return.
+func (r *model3) Size() int { return r.size }
+
+// BlockSize returns the hash's underlying block size.
+func (r *model3) BlockSize() int { return BlockSize3 }
+
+// Write appends the data to the digest.
+func (r *model3) Write(p []byte) (int, error) {
+	n := len(p)
+	r.total += uint64(n)
+
+	if r.bufLen+len(p) <= bufferSize {
+		r.bufLen += copy(r.buf[r.bufLen:], p)
+		return n, nil
+	}
+
+	if r.bufLen > 0 {
+		x := copy(r.buf[r.bufLen:], p)
+		p = p[x:]
+		r.consume(r.buf[:])
+		r.bufLen = 0
+	}
+
+	if len(p) > bufferSize {
+		k := (len(p) - 1) / BlockSize3 * BlockSize3
+		r.consume(p[:k])
+		p = p[k:]
+	}
+
+	r.bufLen = copy(r.buf[:], p)
+
+	return n, nil
+}
+
+// Sum appends the current hash to b and returns the resulting slice.
+// It does not change the underlying hash state.
+func (r *model3) Sum(b []byte) []byte {
+	if r.total <= midSizeMax {
+		p := r.buf[:r.bufLen]
+		if r.size == Size3Type64 {
+			return binary.BigEndian.AppendUint64(b, hashShort64(p, r.seed))
+		}
+
+		hi, lo := hashShort128(p, r.seed)
+
+		return binary.BigEndian.AppendUint64(binary.BigEndian.AppendUint64(b, hi), lo)
+	}
+
+	// consume the remaining stripes on a copy to keep the state
+	s := *r
+	k := (s.bufLen - 1) / BlockSize3 * BlockSize3
+	s.consume(r.buf[:k])
+
+	var last [BlockSize3]byte
+	if r.bufLen >= BlockSize3 {
+		copy(last[:], r.buf[r.bufLen-BlockSize3:r.bufLen])
+	} else {
+		x := copy(last[:], r.last[r.bufLen:])
+		copy(last[x:], r.buf[:r.bufLen])
+	}
+
+	accumulate(&s.acc, last[:], s.secret[secretSize-BlockSize3-7:])
+
+	lo := mergeAccumulators(&s.acc, s.secret[11:], r.total*prime64v1)
+	if r.size == Size3Type64 {
+		return binary.BigEndian.AppendUint64(b, lo)
+	}
+
+	hi := mergeAccumulators(&s.acc, s.secret[secretSize-BlockSize3-11:], ^(r.total * prime64v2))
+
+	return binary.BigEndian.AppendUint64(binary.BigEndian.AppendUint64(b, hi), lo)
+}
+
+// private
+
+// consume consumes the stripes of p, whose length is a multiple of the stripe size,
+// scrambling the accumulators at the end of each block.
+func (r *model3) consume(p []byte) {
+	if len(p) == 0 {
+		return
+	}
+
+	copy(r.last[:], p[len(p)-BlockSize3:])
+
+	for ; len(p) > 0; p = p[BlockSize3:] {
+		accumulate(&r.acc, p, r.secret[8*r.stripes:])
+
+		if r.stripes++; r.stripes == stripesPerBlock {
+			scramble(&r.acc, r.secret[secretSize-BlockSize3:])
+			r.stripes = 0
+		}
+	}
+}
@@ -0,0 +1,113 @@
+package xxhash
+
+import (
+	"encoding/binary"
+	"math/bits"
+)
+
+// model32 represents a structure for the XXH32 hash.Hash.
+type model32 struct {
+	seed   uint32
+	v      [4]uint32
+	buf    [BlockSize32]byte
+	bufLen int
+	total  uint64
+}
+
+// implementation of the hash.Hash
+
+// Reset resets the hash.Hash to its initial state.
+func (r *model32) Reset() {
+	r.v = [4]uint32{r.seed + prime32v1 + prime32v2, r.seed + prime32v2, r.seed, r.seed - prime32v1}
+	r.bufLen = 0
+	r.total = 0
+}
+
+// Size returns the number of bytes Sum will return.
+func (r *model32) Size() int { return Size32 }
+
+// BlockSize returns the hash's underlying block size.
+func (r *model32) BlockSize() int { return BlockSize32 }
+
+// Write appends the data to the digest.
+func (r *model32) Write(p []byte) (int, error) {
+	n := len(p)
+	r.total += uint64(n)
+
+	if r.bufLen > 0 {
+		x := copy(r.buf[r.bufLen:], p)
+		r.bufLen += x
+		p = p[x:]
+
+		if r.bufLen < BlockSize32 {
+			return n, nil
+		}
+
+		r.stripes(r.buf[:])
+		r.bufLen = 0
+	}
+
+	if len(p) >= BlockSize32 {
+		k := len(p) - len(p)%BlockSize32
+		r.stripes(p[:k])
+		p = p[k:]
+	}
+
+	r.bufLen = copy(r.buf[:], p)
+
+	return n, nil
+}
+
+// Sum appends the current hash to b and returns the resulting slice.
+// It does not change the underlying hash state.
+func (r *model32) Sum(b []byte) []byte {
+	var h uint32
+	if r.total >= BlockSize32 {
+		h = bits.RotateLeft32(r.v[0], 1) + bits.RotateLeft32(r.v[1], 7) +
+			bits.RotateLeft32(r.v[2], 12) + bits.RotateLeft32(r.v[3], 18)
+	} else {
+		h = r.seed + prime32v5
+	}
+
+	h += uint32(r.total)
+
+	p := r.buf[:r.bufLen]
+	for ; len(p) >= 4; p = p[4:] {
+		h += binary.LittleEndian.Uint32(p) * prime32v3
+		h = bits.RotateLeft32(h, 17) * prime32v4
+	}
+
+	for _, c := range p {
+		h += uint32(c) * prime32v5
+		h = bits.RotateLeft32(h, 11) * prime32v1
+	}
+
+	h ^= h >> 15
+	h *= prime32v2
+	h ^= h >> 13
+	h *= prime32v3
+	h ^= h >> 16
+
+	return binary.BigEndian.AppendUint32(b, h)
+}
+
+// private
+
+// stripes consumes the stripes of p, whose length is a multiple of the stripe size.
+func (r *model32) stripes(p []byte) {
+	v0, v1, v2, v3 := r.v[0], r.v[1], r.v[2], r.v[3]
+
+	for ; len(p) >= BlockSize32; p = p[BlockSize32:] {
+		v0 = round32(v0, binary.LittleEndian.Uint32(p[0:]))
+		v1 = round32(v1, binary.LittleEndian.Uint32(p[4:]))
+		v2 = round32(v2, binary.LittleEndian.Uint32(p[8:]))
+		v3 = round32(v3, binary.LittleEndian.Uint32(p[12:]))
+	}
+
+	r.v = [4]uint32{v0, v1, v2, v3}
+}
+
+// round32 mixes the lane of the input into the accumulator.
+func round32(acc, lane uint32) uint32 {
+	return bits.RotateLeft32(acc+lane*prime32v2, 13) * prime32v1
+}
@@ -0,0 +1,134 @@
+package xxhash
+
+import (
+	"encoding/binary"
+	"math/bits"
+)
+
+// model64 represents a structure for the XXH64 hash.Hash.
+type model64 struct {
+	seed   uint64
+	v      [4]uint64
+	buf    [BlockSize64]byte
+	bufLen int
+	total  uint64
+}
+
+// implementation of the hash.Hash
+
+// Reset resets the hash.Hash to its initial state.
+func (r *model64) Reset() {
+	r.v = [4]uint64{r.seed + prime64v1 + prime64v2, r.seed + prime64v2, r.seed, r.seed - prime64v1}
+	r.bufLen = 0
+	r.total = 0
+}
+
+// Size returns the number of bytes Sum will return.
+func (r *model64) Size() int { return Size64 }
+
+// BlockSize returns the hash's underlying block size.
+func (r *model64) BlockSize() int { return BlockSize64 }
+
+// Write appends the data to the digest.
+func (r *model64) Write(p []byte) (int, error) {
+	n := len(p)
+	r.total += uint64(n)
+
+	if r.bufLen > 0 {
+		x := copy(r.buf[r.bufLen:], p)
+		r.bufLen += x
+		p = p[x:]
+
+		if r.bufLen < BlockSize64 {
+			return n, nil
+		}
+
+		r.stripes(r.buf[:])
+		r.bufLen = 0
+	}
+
+	if len(p) >= BlockSize64 {
+		k := len(p) - len(p)%BlockSize64
+		r.stripes(p[:k])
+		p = p[k:]
+	}
+
+	r.bufLen = copy(r.buf[:], p)
+
+	return n, nil
+}
+
+// Sum appends the current hash to b and returns the resulting slice.
+// It does not change the underlying hash state.
+func (r *model64) Sum(b []byte) []byte {
+	var h uint64
+	if r.total >= BlockSize64 {
+		h = bits.RotateLeft64(r.v[0], 1) + bits.RotateLeft64(r.v[1], 7) +
+			bits.RotateLeft64(r.v[2], 12) + bits.RotateLeft64(r.v[3], 18)
+
+		for _, v := range r.v {
+			h = mergeRound64(h, v)
+		}
+	} else {
+		h = r.seed + prime64v5
+	}
+
+	h += r.total
+
+	p := r.buf[:r.bufLen]
+	for ; len(p) >= 8; p = p[8:] {
+		h ^= round64(0, binary.LittleEndian.Uint64(p))
+		h = bits.RotateLeft64(h, 27)*prime64v1 + prime64v4
+	}
+
+	if len(p) >= 4 {
+		h ^= uint64(binary.LittleEndian.Uint32(p)) * prime64v1
+		h = bits.RotateLeft64(h, 23)*prime64v2 + prime64v3
+		p = p[4:]
+	}
+
+	for _, c := range p {
+		h ^= uint64(c) * prime64v5
+		h = bits.RotateLeft64(h, 11) * prime64v1
+	}
+
+	return binary.BigEndian.AppendUint64(b, avalanche64(h))
+}
+
+// private
+
+// stripes consumes the stripes of p, whose length is a multiple of the stripe size.
+func (r *model64) stripes(p []byte) {
+	v0, v1, v2, v3 := r.v[0], r.v[1], r.v[2], r.v[3]
+
+	for ; len(p) >= BlockSize64; p = p[BlockSize64:] {
+		v0 = round64(v0, binary.LittleEndian.Uint64(p[0:]))
+		v1 = round64(v1, binary.LittleEndian.Uint64(p[8:]))
+		v2 = round64(v2, binary.LittleEndian.Uint64(p[16:]))
+		v3 = round64(v3, binary.LittleEndian.Uint64(p[24:]))
+	}
+
+	r.v = [4]uint64{v0, v1, v2, v3}
+}
+
+// round64 mixes the lane of the input into the accumulator.
+func round64(acc, lane uint64) uint64 {
+	return bits.RotateLeft64(acc+lane*prime64v2, 31) * prime64v1
+}
+
+// mergeRound64 merges the accumulator into the hash.
+func mergeRound64(h, acc uint64) uint64 {
+	h ^= round64(0, acc)
+	return h*prime64v1 + prime64v4
+}
+
+// avalanche64 is the final mix of XXH64, also used by XXH3.
+func avalanche64(h uint64) uint64 {
+	h ^= h >> 33
+	h *= prime64v2
+	h ^= h >> 29
+	h *= prime64v3
+	h ^= h >> 32
+
+	return h
+}
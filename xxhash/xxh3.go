@@ -0,0 +1,235 @@
+package xxhash
+
+import (
+	"encoding/binary"
+	"math/bits"
+)
+
+// u32 reads a little-endian 32-bit word.
+func u32(p []byte) uint32 { return binary.LittleEndian.Uint32(p) }
+
+// u64 reads a little-endian 64-bit word.
+func u64(p []byte) uint64 { return binary.LittleEndian.Uint64(p) }
+
+// mulFold64 returns the xor of the halves of the 128-bit product.
+func mulFold64(a, b uint64) uint64 {
+	hi, lo := bits.Mul64(a, b)
+	return hi ^ lo
+}
+
+// avalanche3 is the final mix of XXH3.
+func avalanche3(h uint64) uint64 {
+	h ^= h >> 37
+	h *= primeMx1
+	h ^= h >> 32
+
+	return h
+}
+
+// rrmxmx is the final mix of XXH3-64 for inputs of 4 to 8 bytes.
+func rrmxmx(h, n uint64) uint64 {
+	h ^= bits.RotateLeft64(h, 49) ^ bits.RotateLeft64(h, 24)
+	h *= primeMx2
+	h ^= (h >> 35) + n
+	h *= primeMx2
+	h ^= h >> 28
+
+	return h
+}
+
+// mix16 mixes 16 bytes of the input with 16 bytes of the secret.
+func mix16(p, secret []byte, seed uint64) uint64 {
+	return mulFold64(u64(p)^(u64(secret)+seed), u64(p[8:])^(u64(secret[8:])-seed))
+}
+
+// deriveSecret returns the secret of XXH3 for long inputs with the seed.
+func deriveSecret(seed uint64) (secret [secretSize]byte) {
+	if seed == 0 {
+		return defaultSecret
+	}
+
+	for i := 0; i < secretSize; i += 16 {
+		binary.LittleEndian.PutUint64(secret[i:], u64(defaultSecret[i:])+seed)
+		binary.LittleEndian.PutUint64(secret[i+8:], u64(defaultSecret[i+8:])-seed)
+	}
+
+	return secret
+}
+
+// hashShort64 returns the XXH3-64 hash of an input of at most midSizeMax bytes.
+func hashShort64(p []byte, seed uint64) uint64 {
+	s := defaultSecret[:]
+	n := uint64(len(p))
+
+	switch {
+	case n == 0:
+		return avalanche64(seed ^ u64(s[56:]) ^ u64(s[64:]))
+	case n <= 3:
+		combined := uint32(p[0])<<16 | uint32(p[n>>1])<<24 | uint32(p[n-1]) | uint32(n)<<8
+		return avalanche64(uint64(combined) ^ (uint64(u32(s)^u32(s[4:])) + seed))
+	case n <= 8:
+		seed ^= uint64(bits.ReverseBytes32(uint32(seed))) << 32
+		input := uint64(u32(p[n-4:])) + uint64(u32(p))<<32
+		return rrmxmx(input^((u64(s[8:])^u64(s[16:]))-seed), n)
+	case n <= 16:
+		lo := u64(p) ^ ((u64(s[24:]) ^ u64(s[32:])) + seed)
+		hi := u64(p[n-8:]) ^ ((u64(s[40:]) ^ u64(s[48:])) - seed)
+		return avalanche3(n + bits.ReverseBytes64(lo) + hi + mulFold64(lo, hi))
+	case n <= 128:
+		acc := n * prime64v1
+
+		if n > 32 {
+			if n > 64 {
+				if n > 96 {
+					acc += mix16(p[48:], s[96:], seed)
+					acc += mix16(p[n-64:], s[112:], seed)
+				}
+
+				acc += mix16(p[32:], s[64:], seed)
+				acc += mix16(p[n-48:], s[80:], seed)
+			}
+
+			acc += mix16(p[16:], s[32:], seed)
+			acc += mix16(p[n-32:], s[48:], seed)
+		}
+
+		acc += mix16(p, s, seed)
+		acc += mix16(p[n-16:], s[16:], seed)
+
+		return avalanche3(acc)
+	}
+
+	acc := n * prime64v1
+	for i := 0; i < 8; i++ {
+		acc += mix16(p[16*i:], s[16*i:], seed)
+	}
+
+	acc = avalanche3(acc)
+	for i := 8; i < len(p)/16; i++ {
+		acc += mix16(p[16*i:], s[16*(i-8)+3:], seed)
+	}
+
+	acc += mix16(p[n-16:], s[midSizeLastOffset:], seed)
+
+	return avalanche3(acc)
+}
+
+// mix32 mixes 32 bytes of the input with 32 bytes of the secret into the 128-bit accumulator.
+func mix32(lo, hi uint64, p1, p2, secret []byte, seed uint64) (uint64, uint64) {
+	lo += mix16(p1, secret, seed)
+	lo ^= u64(p2) + u64(p2[8:])
+	hi += mix16(p2, secret[16:], seed)
+	hi ^= u64(p1) + u64(p1[8:])
+
+	return lo, hi
+}
+
+// hashShort128 returns the XXH3-128 hash of an input of at most midSizeMax bytes as its high and low halves.
+func hashShort128(p []byte, seed uint64) (uint64, uint64) {
+	s := defaultSecret[:]
+	n := uint64(len(p))
+
+	switch {
+	case n == 0:
+		return avalanche64(seed ^ u64(s[80:]) ^ u64(s[88:])), avalanche64(seed ^ u64(s[64:]) ^ u64(s[72:]))
+	case n <= 3:
+		combinedLo := uint32(p[0])<<16 | uint32(p[n>>1])<<24 | uint32(p[n-1]) | uint32(n)<<8
+		combinedHi := bits.RotateLeft32(bits.ReverseBytes32(combinedLo), 13)
+		lo := uint64(combinedLo) ^ (uint64(u32(s)^u32(s[4:])) + seed)
+		hi := uint64(combinedHi) ^ (uint64(u32(s[8:])^u32(s[12:])) - seed)
+
+		return avalanche64(hi), avalanche64(lo)
+	case n <= 8:
+		seed ^= uint64(bits.ReverseBytes32(uint32(seed))) << 32
+		input := uint64(u32(p)) + uint64(u32(p[n-4:]))<<32
+		hi, lo := bits.Mul64(input^((u64(s[16:])^u64(s[24:]))+seed), prime64v1+n<<2)
+		hi += lo << 1
+		lo ^= hi >> 3
+		lo ^= lo >> 35
+		lo *= primeMx2
+		lo ^= lo >> 28
+
+		return avalanche3(hi), lo
+	case n <= 16:
+		flipLo := (u64(s[32:]) ^ u64(s[40:])) - seed
+		flipHi := (u64(s[48:]) ^ u64(s[56:])) + seed
+		inputLo := u64(p)
+		inputHi := u64(p[n-8:])
+		mHi, mLo := bits.Mul64(inputLo^inputHi^flipLo, prime64v1)
+		mLo += (n - 1) << 54
+		inputHi ^= flipHi
+		mHi += inputHi + uint64(uint32(inputHi))*(prime32v2-1)
+		mLo ^= bits.ReverseBytes64(mHi)
+		hi, lo := bits.Mul64(mLo, prime64v2)
+		hi += mHi * prime64v2
+
+		return avalanche3(hi), avalanche3(lo)
+	}
+
+	lo, hi := n*prime64v1, uint64(0)
+
+	if n <= 128 {
+		if n > 32 {
+			if n > 64 {
+				if n > 96 {
+					lo, hi = mix32(lo, hi, p[48:], p[n-64:], s[96:], seed)
+				}
+
+				lo, hi = mix32(lo, hi, p[32:], p[n-48:], s[64:], seed)
+			}
+
+			lo, hi = mix32(lo, hi, p[16:], p[n-32:], s[32:], seed)
+		}
+
+		lo, hi = mix32(lo, hi, p, p[n-16:], s, seed)
+	} else {
+		for i := 0; i < 4; i++ {
+			lo, hi = mix32(lo, hi, p[32*i:], p[32*i+16:], s[32*i:], seed)
+		}
+
+		lo, hi = avalanche3(lo), avalanche3(hi)
+		for i := 4; i < len(p)/32; i++ {
+			lo, hi = mix32(lo, hi, p[32*i:], p[32*i+16:], s[32*(i-4)+midSizeStartOffset:], seed)
+		}
+
+		lo, hi = mix32(lo, hi, p[n-16:], p[n-32:], s[midSizeLastOffset-16:], -seed)
+	}
+
+	h := lo*prime64v1 + hi*prime64v4 + (n-seed)*prime64v2
+
+	return -avalanche3(h), avalanche3(lo + hi)
+}
+
+// accumulate mixes a stripe of the input into the accumulators.
+func accumulate(acc *[8]uint64, p, secret []byte) {
+	p = p[:BlockSize3]
+	secret = secret[:BlockSize3]
+
+	for i := 0; i < 8; i += 2 {
+		v0, v1 := u64(p[8*i:]), u64(p[8*i+8:])
+		k0, k1 := v0^u64(secret[8*i:]), v1^u64(secret[8*i+8:])
+		acc[i] += v1 + uint64(uint32(k0))*(k0>>32)
+		acc[i+1] += v0 + uint64(uint32(k1))*(k1>>32)
+	}
+}
+
+// scramble scrambles the accumulators at the end of a block.
+func scramble(acc *[8]uint64, secret []byte) {
+	secret = secret[:BlockSize3]
+
+	for i := range acc {
+		a := acc[i]
+		a ^= a >> 47
+		a ^= u64(secret[8*i:])
+		acc[i] = a * prime32v1
+	}
+}
+
+// mergeAccumulators merges the accumulators into a 64-bit hash.
+func mergeAccumulators(acc *[8]uint64, secret []byte, start uint64) uint64 {
+	for i := 0; i < 4; i++ {
+		start += mulFold64(acc[2*i]^u64(secret[16*i:]), acc[2*i+1]^u64(secret[16*i+8:]))
+	}
+
+	return avalanche3(start)
+}
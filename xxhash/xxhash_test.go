@@ -0,0 +1,144 @@
+package xxhash
+
+import (
+	"bytes"
+	"encoding/hex"
+	"hash"
+	"math/rand"
+	"testing"
+)
+
+// primes of the sanity checks of xxhsum, the 64-bit one differs from prime64v1
+const (
+	sanityPrime32 = 2654435761
+	sanityPrime64 = 11400714785074694797
+)
+
+// sanityBuffer returns the input of the sanity checks of xxhsum.
+func sanityBuffer(size int) []byte {
+	buf := make([]byte, size)
+	gen := uint64(sanityPrime32)
+
+	for i := range buf {
+		buf[i] = byte(gen >> 56)
+		gen *= sanityPrime64
+	}
+
+	return buf
+}
+
+func TestSanity(t *testing.T) {
+	const seed = sanityPrime64
+
+	buf := sanityBuffer(4096 + 64 + 1)
+	constructors := map[string]func(uint64) hash.Hash{
+		"xxh32":    func(seed uint64) hash.Hash { return New32(uint32(seed)) },
+		"xxh64":    New64,
+		"xxh3-64":  New3Type64,
+		"xxh3-128": New3Type128,
+	}
+	vectors := []struct {
+		hashType string
+		length   int
+		seed     uint64
+		expected string
+	}{
+		{"xxh32", 0, 0, "02cc5d05"},
+		{"xxh32", 0, sanityPrime32, "36b78ae7"},
+		{"xxh32", 1, 0, "cf65b03e"},
+		{"xxh32", 1, sanityPrime32, "b4545aa4"},
+		{"xxh32", 14, 0, "1208e7e2"},
+		{"xxh32", 222, 0, "5bd11dbd"},
+		{"xxh32", 222, sanityPrime32, "58803c5f"},
+		{"xxh64", 0, 0, "ef46db3751d8e999"},
+		{"xxh64", 0, sanityPrime32, "ac75fda2929b17ef"},
+		{"xxh64", 1, 0, "e934a84adb052768"},
+		{"xxh64", 1, sanityPrime32, "5014607643a9b4c3"},
+		{"xxh64", 4, 0, "9136a0dca57457ee"},
+		{"xxh64", 14, 0, "8282dcc4994e35c8"},
+		{"xxh64", 14, sanityPrime32, "c3bd6bf63deb6df0"},
+		{"xxh64", 222, 0, "b641ae8cb691c174"},
+		{"xxh64", 222, sanityPrime32, "20cb8ab7ae10c14a"},
+		{"xxh3-64", 0, 0, "2d06800538d394c2"},
+		{"xxh3-64", 0, seed, "a8a6b918b2f0364a"},
+		{"xxh3-64", 1, 0, "c44bdff4074eecdb"},
+		{"xxh3-64", 1, seed, "032be332dd766ef8"},
+		{"xxh3-64", 4, 0, "e5dc74bc51848a51"},
+		{"xxh3-64", 16, seed, "663f29333b4db6b1"},
+		{"xxh3-64", 17, 0, "796f5acd3a60f862"},
+		{"xxh3-64", 129, seed, "21fffdbca099c844"},
+		{"xxh3-64", 240, 0, "81c3c2b67f568ccf"},
+		{"xxh3-64", 241, seed, "dda9b0a161d4829a"},
+		{"xxh3-64", 2367, 0, "cb37aeb9e5d361ed"},
+		{"xxh3-64", 2367, seed, "d2db3415b942b42a"},
+		{"xxh3-64", 4161, 0, "efb6ccb06c0b206a"},
+		{"xxh3-128", 0, 0, "99aa06d3014798d86001c324468d497f"},
+		{"xxh3-128", 0, seed, "00feaa732a3ce25ea986dfc5d7605bfe"},
+		{"xxh3-128", 1, 0, "a6cd5e9392000f6ac44bdff4074eecdb"},
+		{"xxh3-128", 4, seed, "3d53e5dfd837d927bfaf51f1e67e0b0f"},
+		{"xxh3-128", 9, 0, "564ef6078950d457ed7ccbc501eb7501"},
+		{"xxh3-128", 17, seed, "d77681219e464828980a14119985a7df"},
+		{"xxh3-128", 129, 0, "03815fc91f1b30b686c9e3bc8f0a3b5c"},
+		{"xxh3-128", 240, seed, "29d2133d6ea58c5b604e98db085c1864"},
+		{"xxh3-128", 2367, 0, "e89c0f6ff369b427cb37aeb9e5d361ed"},
+		{"xxh3-128", 4161, seed, "e65c436b63ed1423a7e2d104da48b297"},
+	}
+
+	for _, v := range vectors {
+		h := constructors[v.hashType](v.seed)
+		h.Write(buf[:v.length])
+
+		if got := hex.EncodeToString(h.Sum(nil)); got != v.expected {
+			t.Errorf("'%s' of %d bytes with seed %#x is wrong:\n\texpected \"%s\"\n\tgot \"%s\"", v.hashType, v.length, v.seed, v.expected, got)
+		}
+	}
+}
+
+func TestStreaming(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	data := make([]byte, 3000)
+	rnd.Read(data)
+
+	new32 := func(seed uint64) hash.Hash { return New32(uint32(seed)) }
+
+	for _, newHash := range []func(uint64) hash.Hash{new32, New64, New3Type64, New3Type128} {
+		for n := 0; n <= len(data); n += 1 + n/8 {
+			seed := rnd.Uint64()
+
+			expected := newHash(seed)
+			expected.Write(data[:n])
+
+			h := newHash(seed)
+			for p := data[:n]; len(p) > 0; {
+				k := min(1+rnd.Intn(300), len(p))
+				h.Write(p[:k])
+				p = p[k:]
+			}
+
+			if !bytes.Equal(expected.Sum(nil), h.Sum(nil)) {
+				t.Fatalf("chunked sum of %d bytes is wrong:\n\texpected \"%x\"\n\tgot \"%x\"", n, expected.Sum(nil), h.Sum(nil))
+			}
+		}
+	}
+}
+
+func benchmarkXxHash(b *testing.B, h hash.Hash, size int) {
+	data := make([]byte, size)
+
+	b.SetBytes(int64(len(data)))
+	for i := 0; i < b.N; i++ {
+		h.Reset()
+		h.Write(data)
+		h.Sum(nil)
+	}
+}
+
+func BenchmarkXxHash32(b *testing.B) { benchmarkXxHash(b, New32(0), 8192) }
+
+func BenchmarkXxHash64(b *testing.B) { benchmarkXxHash(b, New64(0), 8192) }
+
+func BenchmarkXxHash3Type64(b *testing.B) { benchmarkXxHash(b, New3Type64(0), 8192) }
+
+func BenchmarkXxHash3Type128(b *testing.B) { benchmarkXxHash(b, New3Type128(0), 8192) }
+
+func BenchmarkXxHash3Type64Short(b *testing.B) { benchmarkXxHash(b, New3Type64(0), 64) }
@@ -0,0 +1,27 @@
+package yescrypt
+
+// BlockSize is the size of a block of the memory of yescrypt for r = 1 in bytes, as in scrypt.
+const BlockSize = 128
+
+// the parameters of pwxform of the supported flavor, 2 simple lanes, 4 gathers, 6 rounds and 12 KiB S-boxes
+const (
+	pwxSimple = 2
+	pwxGather = 4
+	pwxRounds = 6
+	sWidth    = 8
+	// pwxBytes is the size of the block of pwxform in bytes.
+	pwxBytes = pwxGather * pwxSimple * 8
+	// sBytes is the size of the three S-boxes in bytes.
+	sBytes = 3 * (1 << sWidth) * pwxSimple * 8
+	// sWords is the size of the three S-boxes in 32-bit words.
+	sWords = sBytes / 4
+	// sMask selects the offset of a group of simple lanes in an S-box from a word.
+	sMask = ((1 << sWidth) - 1) * pwxSimple * 8
+)
+
+const (
+	// modeRW is the flag of the read-write mode in the flags.
+	modeRW = 0x002
+	// flavorRW are the flags of the rounds, the gathers, the simple lanes and the S-boxes of the supported flavor.
+	flavorRW = 0x004 | 0x010 | 0x020 | 0x080
+)
@@ -0,0 +1,281 @@
+package yescrypt
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"math/bits"
+)
+
+// pwxform represents the state of pwxform of a thread, the three S-boxes and the write position in S2.
+type pwxform struct {
+	s0, s1, s2 []uint32
+	w          int
+}
+
+// smix computes the blocks of the p threads with the memory v, the S-boxes are initialized first and the password
+// is updated by the first thread in the read-write mode.
+func smix(b []uint32, r int, n uint64, p int, t uint32, flags Flags, v, xy, sBoxes []uint32, password []byte) {
+	s := 32 * r
+
+	nChunk := n / uint64(p)
+	nLoopAll := nChunk
+	if flags&modeRW != 0 {
+		if t <= 1 {
+			if t == 1 {
+				nLoopAll *= 2
+			}
+
+			nLoopAll = (nLoopAll + 2) / 3
+		} else {
+			nLoopAll *= uint64(t - 1)
+		}
+	} else if t != 0 {
+		if t == 1 {
+			nLoopAll += (nLoopAll + 1) / 2
+		}
+
+		nLoopAll *= uint64(t)
+	}
+
+	nLoopRW := uint64(0)
+	if flags&modeRW != 0 {
+		nLoopRW = nLoopAll / uint64(p)
+	}
+
+	// the chunks are even and the loops are rounded up to even
+	nChunk &^= 1
+	nLoopAll = (nLoopAll + 1) &^ 1
+	nLoopRW = (nLoopRW + 1) &^ 1
+
+	ctx := make([]*pwxform, p)
+	for i, vChunk := 0, uint64(0); i < p; i, vChunk = i+1, vChunk+nChunk {
+		np := nChunk
+		if i == p-1 {
+			np = n - vChunk
+		}
+
+		bp := b[s*i : s*(i+1)]
+		vp := v[uint64(s)*vChunk : uint64(s)*(vChunk+np)]
+
+		if flags&modeRW != 0 {
+			si := sBoxes[sWords*i : sWords*(i+1)]
+			smix1(bp, 1, sBytes/BlockSize, Scrypt, si, xy, nil)
+			ctx[i] = &pwxform{s2: si[:sWords/3], s1: si[sWords/3 : 2*sWords/3], s0: si[2*sWords/3:]}
+
+			if i == 0 {
+				mac := hmac.New(sha256.New, encode(bp[s-16:]))
+				_, _ = mac.Write(password)
+				copy(password, mac.Sum(nil))
+			}
+		}
+
+		smix1(bp, r, np, flags, vp, xy, ctx[i])
+		smix2(bp, r, p2floor(np), nLoopRW, flags, vp, xy, ctx[i])
+	}
+
+	for i := 0; i < p; i++ {
+		smix2(b[s*i:s*(i+1)], r, n, nLoopAll-nLoopRW, flags&^modeRW, v, xy, ctx[i])
+	}
+}
+
+// smix1 fills the memory v of n blocks from the block, which is replaced by the next one. The blocks of the memory
+// are in the shuffled order of the words of the SIMD implementations, as they are seen by pwxform.
+func smix1(b []uint32, r int, n uint64, flags Flags, v, xy []uint32, ctx *pwxform) {
+	s := 32 * r
+	x, y := xy[:s], xy[s:2*s]
+
+	shuffle(x, b[:s])
+
+	for i := uint64(0); i < n; i++ {
+		copy(v[uint64(s)*i:], x)
+
+		if flags&modeRW != 0 && i > 1 {
+			j := wrap(integerify(x, r), i)
+			xor(x, v[uint64(s)*j:])
+		}
+
+		blockMix(x, y, r, ctx)
+	}
+
+	unshuffle(b[:s], x)
+}
+
+// smix2 mixes the block with nLoop blocks of the memory v of n blocks, which are replaced by the result in the
+// read-write mode.
+func smix2(b []uint32, r int, n, nLoop uint64, flags Flags, v, xy []uint32, ctx *pwxform) {
+	if nLoop == 0 {
+		return
+	}
+
+	s := 32 * r
+	x, y := xy[:s], xy[s:2*s]
+
+	shuffle(x, b)
+
+	for i := uint64(0); i < nLoop; i++ {
+		j := integerify(x, r) & (n - 1)
+		xor(x, v[uint64(s)*j:])
+
+		if flags&modeRW != 0 {
+			copy(v[uint64(s)*j:], x[:s])
+		}
+
+		blockMix(x, y, r, ctx)
+	}
+
+	unshuffle(b, x)
+}
+
+// blockMix is BlockMix of scrypt with Salsa20/8 without pwxform, or BlockMix of yescrypt with it.
+func blockMix(b, y []uint32, r int, ctx *pwxform) {
+	if ctx == nil {
+		blockMixSalsa8(b, y, r)
+	} else {
+		blockMixPwxform(b, r, ctx)
+	}
+}
+
+// blockMixSalsa8 is BlockMix of scrypt, the 64-byte sub-blocks are chained by Salsa20/8 and interleaved.
+func blockMixSalsa8(b, y []uint32, r int) {
+	var x [16]uint32
+	copy(x[:], b[(2*r-1)*16:])
+
+	for i := 0; i < 2*r; i++ {
+		xor(x[:], b[i*16:])
+		salsa20(x[:], 8)
+		copy(y[i*16:], x[:])
+	}
+
+	for i := 0; i < r; i++ {
+		copy(b[i*16:(i+1)*16], y[2*i*16:])
+		copy(b[(i+r)*16:(i+r+1)*16], y[(2*i+1)*16:])
+	}
+}
+
+// blockMixPwxform is BlockMix of yescrypt, the sub-blocks of pwxform are chained by pwxform and the last 64 bytes
+// are mixed by Salsa20/2.
+func blockMixPwxform(b []uint32, r int, ctx *pwxform) {
+	const words = pwxBytes / 4
+
+	var x [words]uint32
+	r1 := BlockSize * r / pwxBytes
+	copy(x[:], b[(r1-1)*words:])
+
+	// there are at least two sub-blocks of pwxform, so each one is always xored
+	for i := 0; i < r1; i++ {
+		xor(x[:], b[i*words:])
+		ctx.transform(x[:])
+		copy(b[i*words:], x[:])
+	}
+
+	i := (r1 - 1) * pwxBytes / 64
+	salsa20(b[i*16:(i+1)*16], 2)
+}
+
+// transform is pwxform of the block, the products of the words of the simple lanes are added to and xored with the
+// words of the S-boxes selected by the first lane, and the results of the middle rounds are written to S2.
+func (r *pwxform) transform(b []uint32) {
+	w := r.w
+
+	for i := 0; i < pwxRounds; i++ {
+		for j := 0; j < pwxGather; j++ {
+			x := b[j*pwxSimple*2 : (j+1)*pwxSimple*2]
+			p0 := int(x[0]&sMask) / 4
+			p1 := int(x[1]&sMask) / 4
+
+			for k := 0; k < pwxSimple; k++ {
+				s0 := uint64(r.s0[p0+2*k]) | uint64(r.s0[p0+2*k+1])<<32
+				s1 := uint64(r.s1[p1+2*k]) | uint64(r.s1[p1+2*k+1])<<32
+
+				v := (uint64(x[2*k+1])*uint64(x[2*k]) + s0) ^ s1
+				x[2*k], x[2*k+1] = uint32(v), uint32(v>>32)
+
+				if i != 0 && i != pwxRounds-1 {
+					r.s2[2*w], r.s2[2*w+1] = uint32(v), uint32(v>>32)
+					w++
+				}
+			}
+		}
+	}
+
+	r.s0, r.s1, r.s2 = r.s2, r.s0, r.s1
+	r.w = w & ((1<<sWidth)*pwxSimple - 1)
+}
+
+// salsa20 applies the Salsa20 core of the rounds to the block of 16 words in the shuffled order.
+func salsa20(b []uint32, rounds int) {
+	var x [16]uint32
+	for i := range x {
+		x[i*5%16] = b[i]
+	}
+
+	for i := 0; i < rounds; i += 2 {
+		// the columns
+		quarterRound(&x[0], &x[4], &x[8], &x[12])
+		quarterRound(&x[5], &x[9], &x[13], &x[1])
+		quarterRound(&x[10], &x[14], &x[2], &x[6])
+		quarterRound(&x[15], &x[3], &x[7], &x[11])
+		// the rows
+		quarterRound(&x[0], &x[1], &x[2], &x[3])
+		quarterRound(&x[5], &x[6], &x[7], &x[4])
+		quarterRound(&x[10], &x[11], &x[8], &x[9])
+		quarterRound(&x[15], &x[12], &x[13], &x[14])
+	}
+
+	for i := range x {
+		b[i] += x[i*5%16]
+	}
+}
+
+// quarterRound is the quarter-round of Salsa20.
+func quarterRound(a, b, c, d *uint32) {
+	*b ^= bits.RotateLeft32(*a+*d, 7)
+	*c ^= bits.RotateLeft32(*b+*a, 9)
+	*d ^= bits.RotateLeft32(*c+*b, 13)
+	*a ^= bits.RotateLeft32(*d+*c, 18)
+}
+
+// shuffle sets x to the blocks of b with the words of every 64 bytes in the order of the SIMD implementations.
+func shuffle(x, b []uint32) {
+	for k := 0; k < len(x); k += 16 {
+		for i := 0; i < 16; i++ {
+			x[k+i] = b[k+i*5%16]
+		}
+	}
+}
+
+// unshuffle sets b to the blocks of x with the words of every 64 bytes in their original order.
+func unshuffle(b, x []uint32) {
+	for k := 0; k < len(x); k += 16 {
+		for i := 0; i < 16; i++ {
+			b[k+i*5%16] = x[k+i]
+		}
+	}
+}
+
+// integerify returns the first 64 bits of the last 64 bytes of the block, the second word is the 13th of the
+// shuffled order.
+func integerify(b []uint32, r int) uint64 {
+	x := b[(2*r-1)*16:]
+
+	return uint64(x[13])<<32 | uint64(x[0])
+}
+
+// wrap returns an index of the blocks before i, from the last power of 2 of them.
+func wrap(x, i uint64) uint64 {
+	n := p2floor(i)
+
+	return x&(n-1) + i - n
+}
+
+// p2floor returns the largest power of 2 which is not greater than x.
+func p2floor(x uint64) uint64 {
+	return 1 << (bits.Len64(x) - 1)
+}
+
+// xor xors the words of x into b.
+func xor(b, x []uint32) {
+	for i := range b {
+		b[i] ^= x[i]
+	}
+}
@@ -0,0 +1,177 @@
+// Package yescrypt implements the yescrypt password-based key derivation function, the successor of scrypt used by
+// the $y$ hashes of libxcrypt.
+//
+// The read-write mode of the default flavor of yescrypt 1.1, the write-once mode and classic scrypt are supported,
+// the ROM and the hash upgrades are not.
+package yescrypt
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+
+	"golang.org/x/crypto/pbkdf2"
+)
+
+var (
+	// ErrFlags is returned when the flags are not one of Scrypt, WORM and RW.
+	ErrFlags = errors.New("yescrypt: unsupported flags")
+	// ErrN is returned when N is not a power of 2 greater than 1, or too small for the number of threads.
+	ErrN = errors.New("yescrypt: invalid N")
+	// ErrR is returned when the block size parameter r is zero.
+	ErrR = errors.New("yescrypt: invalid block size")
+	// ErrP is returned when the parallelization parameter p is zero, or p * r is 2^30 or more.
+	ErrP = errors.New("yescrypt: invalid parallelization")
+	// ErrT is returned when the time parameter t is not zero for classic scrypt.
+	ErrT = errors.New("yescrypt: invalid time")
+	// ErrKeyLength is returned when the key length is zero.
+	ErrKeyLength = errors.New("yescrypt: invalid key length")
+)
+
+// Flags select the mode and the flavor of yescrypt.
+type Flags uint32
+
+const (
+	// Scrypt is classic scrypt, which yescrypt is compatible with.
+	Scrypt Flags = 0
+	// WORM is the write-once read-many mode, scrypt with the time parameter and the final steps of yescrypt.
+	WORM Flags = 1
+	// RW is the read-write mode with pwxform of 6 rounds, 4 gathers, 2 simple lanes and 12 KiB S-boxes,
+	// which is the default flavor of yescrypt.
+	RW Flags = modeRW | flavorRW
+)
+
+// Params represents the parameters of yescrypt.
+type Params struct {
+	Flags Flags
+	// N is the number of blocks of the memory, a power of 2.
+	N uint64
+	// R is the block size parameter, the blocks are 128 * R bytes.
+	R uint32
+	// P is the parallelization parameter.
+	P uint32
+	// T is the time parameter, the additional work of the second loop.
+	T uint32
+	// KeyLength is the length of the derived key in bytes.
+	KeyLength int
+}
+
+// Key derives a key from the password and the salt with the parameters.
+func Key(password, salt []byte, params *Params) ([]byte, error) {
+	if err := params.validate(); err != nil {
+		return nil, err
+	}
+
+	n, r, p := params.N, int(params.R), int(params.P)
+
+	// the password of the large hashes is replaced by a hash of it with 1/64 of the memory first
+	if params.Flags&modeRW != 0 && n/uint64(p) >= 0x100 && n/uint64(p)*uint64(r) >= 0x20000 {
+		password = kdf(password, salt, params.Flags, true, n>>6, r, p, 0, sha256.Size)
+	}
+
+	return kdf(password, salt, params.Flags, false, n, r, p, params.T, params.KeyLength), nil
+}
+
+// private
+
+// validate returns an error if the parameters are out of range.
+func (r *Params) validate() error {
+	switch r.Flags {
+	case Scrypt:
+		if r.T != 0 {
+			return fmt.Errorf("%w: %d", ErrT, r.T)
+		}
+	case WORM, RW:
+	default:
+		return fmt.Errorf("%w: %#x", ErrFlags, uint32(r.Flags))
+	}
+
+	if r.R < 1 {
+		return fmt.Errorf("%w: %d", ErrR, r.R)
+	}
+
+	if r.P < 1 || uint64(r.R)*uint64(r.P) >= 1<<30 {
+		return fmt.Errorf("%w: %d", ErrP, r.P)
+	}
+
+	if r.N < 2 || r.N&(r.N-1) != 0 || r.Flags&modeRW != 0 && r.N/uint64(r.P) <= 1 {
+		return fmt.Errorf("%w: %d", ErrN, r.N)
+	}
+
+	if r.KeyLength < 1 {
+		return fmt.Errorf("%w: %d", ErrKeyLength, r.KeyLength)
+	}
+
+	return nil
+}
+
+// kdf derives the key with the memory of n blocks, the password is first replaced by its HMAC-SHA-256 for the modes
+// of yescrypt, and the key is replaced by the StoredKey of SCRAM of it if it is not the prehash.
+func kdf(password, salt []byte, flags Flags, prehash bool, n uint64, r, p int, t uint32, keyLength int) []byte {
+	s := 32 * r
+
+	if flags != Scrypt {
+		key := []byte("yescrypt-prehash")
+		if !prehash {
+			key = key[:8]
+		}
+
+		mac := hmac.New(sha256.New, key)
+		_, _ = mac.Write(password)
+		password = mac.Sum(nil)
+	}
+
+	b := make([]uint32, s*p)
+	decode(b, pbkdf2.Key(password, salt, 1, 4*len(b), sha256.New))
+
+	// the first bytes of the blocks are the password of the final PBKDF2, updated by smix in the read-write mode
+	if flags != Scrypt {
+		password = encode(b[:8])
+	}
+
+	v := make([]uint32, uint64(s)*n)
+	xy := make([]uint32, 2*s)
+
+	if p == 1 || flags&modeRW != 0 {
+		var sBoxes []uint32
+		if flags&modeRW != 0 {
+			sBoxes = make([]uint32, sWords*p)
+		}
+
+		smix(b, r, n, p, t, flags, v, xy, sBoxes, password)
+	} else {
+		for i := 0; i < p; i++ {
+			smix(b[s*i:s*(i+1)], r, n, 1, t, flags, v, xy, nil, nil)
+		}
+	}
+
+	dk := pbkdf2.Key(password, encode(b), 1, max(keyLength, sha256.Size), sha256.New)
+
+	if flags != Scrypt && !prehash {
+		mac := hmac.New(sha256.New, dk[:sha256.Size])
+		_, _ = mac.Write([]byte("Client Key"))
+		storedKey := sha256.Sum256(mac.Sum(nil))
+		copy(dk, storedKey[:])
+	}
+
+	return dk[:keyLength]
+}
+
+// decode sets the words to the little-endian words of b.
+func decode(words []uint32, b []byte) {
+	for i := range words {
+		words[i] = binary.LittleEndian.Uint32(b[4*i:])
+	}
+}
+
+// encode returns the words as little-endian bytes.
+func encode(words []uint32) []byte {
+	b := make([]byte, 4*len(words))
+	for i, w := range words {
+		binary.LittleEndian.PutUint32(b[4*i:], w)
+	}
+
+	return b
+}
@@ -0,0 +1,87 @@
+package yescrypt
+
+import (
+	"encoding/hex"
+	"errors"
+	"testing"
+)
+
+func TestExamples(t *testing.T) {
+	// the salt abcdefghijklmnop of the $y$ hashes decoded
+	salt, _ := hex.DecodeString("e689a6eacab6ee0bc7f24cd7")
+
+	cases := []struct {
+		params   Params
+		expected string
+	}{
+		// the keys of the $y$ hashes of libxcrypt, $y$j75$, $y$j9T$, $y$j750..$ and $y$/75/0$
+		{Params{Flags: RW, N: 1024, R: 8, P: 1}, "d912e65c336365b382d236e2503aaa07c887978fa9c869a126c35abf99a663b9"},
+		{Params{Flags: RW, N: 4096, R: 32, P: 1}, "89896b5f7f9c08d4af7121fb186209f5d5cc0045b438b4eb8924444986ada606"},
+		{Params{Flags: RW, N: 1024, R: 8, P: 2, T: 1}, "4ea1ae1c590b82aa1c528f5a65db02976359c764a91cbd2e2dc1a2e115da0917"},
+		{Params{Flags: WORM, N: 1024, R: 8, P: 1, T: 3}, "f9ca78e31dec2c4b400e40e95e7502d3bde25546abdf64f54ecbbf1d631da633"},
+	}
+
+	for _, c := range cases {
+		c.params.KeyLength = 32
+
+		key, err := Key([]byte("password"), salt, &c.params)
+		if err != nil {
+			t.Fatalf("%+v failed: %s", c.params, err)
+		}
+
+		if got := hex.EncodeToString(key); got != c.expected {
+			t.Errorf("%+v is wrong:\n\texpected \"%s\"\n\tgot \"%s\"", c.params, c.expected, got)
+		}
+	}
+}
+
+func TestScrypt(t *testing.T) {
+	// the test vector of RFC 7914
+	expected := "fdbabe1c9d3472007856e7190d01e9fe7c6ad7cbc8237830e77376634b373162" +
+		"2eaf30d92e22a3886ff109279d9830dac727afb94a83ee6d8360cbdfa2cc0640"
+
+	key, err := Key([]byte("password"), []byte("NaCl"), &Params{Flags: Scrypt, N: 1024, R: 8, P: 16, KeyLength: 64})
+	if err != nil {
+		t.Fatalf("scrypt failed: %s", err)
+	}
+
+	if got := hex.EncodeToString(key); got != expected {
+		t.Errorf("scrypt is wrong:\n\texpected \"%s\"\n\tgot \"%s\"", expected, got)
+	}
+}
+
+func TestErrors(t *testing.T) {
+	valid := Params{Flags: RW, N: 16, R: 1, P: 1, KeyLength: 32}
+
+	cases := []struct {
+		name     string
+		change   func(p *Params)
+		expected error
+	}{
+		{"flags", func(p *Params) { p.Flags = RW | 0x008 }, ErrFlags},
+		{"N", func(p *Params) { p.N = 24 }, ErrN},
+		{"N per thread", func(p *Params) { p.P = 16 }, ErrN},
+		{"r", func(p *Params) { p.R = 0 }, ErrR},
+		{"p", func(p *Params) { p.P = 0 }, ErrP},
+		{"t", func(p *Params) { p.Flags, p.T = Scrypt, 1 }, ErrT},
+		{"key length", func(p *Params) { p.KeyLength = 0 }, ErrKeyLength},
+	}
+
+	for _, c := range cases {
+		p := valid
+		c.change(&p)
+
+		if _, err := Key([]byte("password"), []byte("salt"), &p); !errors.Is(err, c.expected) {
+			t.Errorf("invalid %s returned %v, expected %v", c.name, err, c.expected)
+		}
+	}
+}
+
+func BenchmarkRW(b *testing.B) {
+	params := &Params{Flags: RW, N: 4096, R: 32, P: 1, KeyLength: 32}
+
+	b.SetBytes(int64(params.N) * int64(params.R) * BlockSize)
+	for i := 0; i < b.N; i++ {
+		_, _ = Key([]byte("password"), []byte("somesalt"), params)
+	}
+}